@@ -0,0 +1,130 @@
+// Package auth checks the tokens of the clients against the per keyspace access control list.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Permission is what a token is allowed to do with a keyspace
+type Permission int
+
+const (
+	// Read allows get and prefix queries, and following the binlog
+	Read Permission = iota + 1
+	// Write allows put, merge and delete
+	Write
+	// Admin allows creating, deleting, resizing the cluster, and changing the quota
+	Admin
+)
+
+// AllKeyspaces is the keyspace name in a grant that applies to all keyspaces
+const AllKeyspaces = "*"
+
+var (
+	// ErrUnauthenticated is returned when the token is missing or unknown
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied is returned when the token does not have the permission on the keyspace
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+func (p Permission) String() string {
+	switch p {
+	case Read:
+		return "read"
+	case Write:
+		return "write"
+	case Admin:
+		return "admin"
+	}
+	return fmt.Sprintf("permission(%d)", int(p))
+}
+
+// AccessControl checks the tokens. A nil AccessControl allows everything.
+type AccessControl struct {
+	users map[string]*pb.AclUser
+}
+
+// NewAccessControl indexes the users by their tokens. A nil list disables the access control.
+func NewAccessControl(acl *pb.AccessControlList) *AccessControl {
+	if acl == nil {
+		return nil
+	}
+	a := &AccessControl{
+		users: make(map[string]*pb.AclUser),
+	}
+	for _, user := range acl.Users {
+		if user.Token != "" {
+			a.users[user.Token] = user
+		}
+	}
+	return a
+}
+
+// LoadAccessControlList reads the access control list in protobuf text format, e.g.
+//
+//	users {
+//	  name: "app"
+//	  token: "secret"
+//	  grants { keyspace: "ks1" read: true write: true }
+//	}
+func LoadAccessControlList(fileName string) (*pb.AccessControlList, error) {
+	txt, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("read access control list %s: %v", fileName, err)
+	}
+	acl := &pb.AccessControlList{}
+	if err = proto.UnmarshalText(string(txt), acl); err != nil {
+		return nil, fmt.Errorf("parse access control list %s: %v", fileName, err)
+	}
+	return acl, nil
+}
+
+// Authenticate returns the user of the token.
+func (a *AccessControl) Authenticate(token string) (*pb.AclUser, error) {
+	if a == nil {
+		return nil, nil
+	}
+	user, found := a.users[token]
+	if !found || token == "" {
+		return nil, ErrUnauthenticated
+	}
+	return user, nil
+}
+
+// Authorize checks whether the token has the permission on the keyspace.
+func (a *AccessControl) Authorize(token, keyspace string, permission Permission) error {
+	if a == nil {
+		return nil
+	}
+	user, err := a.Authenticate(token)
+	if err != nil {
+		return err
+	}
+	for _, grant := range user.Grants {
+		if grant.Keyspace != keyspace && grant.Keyspace != AllKeyspaces {
+			continue
+		}
+		if grant.Admin {
+			return nil
+		}
+		switch permission {
+		case Read:
+			if grant.Read {
+				return nil
+			}
+		case Write:
+			if grant.Write {
+				return nil
+			}
+		}
+	}
+	if keyspace == AllKeyspaces {
+		return fmt.Errorf("%v: %s has no %s permission on all keyspaces", ErrPermissionDenied, user.Name, permission)
+	}
+	return fmt.Errorf("%v: %s has no %s permission on keyspace %s", ErrPermissionDenied, user.Name, permission, keyspace)
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAccessControl(t *testing.T) {
+
+	a := NewAccessControl(&pb.AccessControlList{
+		Users: []*pb.AclUser{
+			{
+				Name:  "reader",
+				Token: "r",
+				Grants: []*pb.AclGrant{
+					{Keyspace: "ks1", Read: true},
+				},
+			},
+			{
+				Name:  "writer",
+				Token: "w",
+				Grants: []*pb.AclGrant{
+					{Keyspace: "ks1", Read: true, Write: true},
+					{Keyspace: "ks2", Admin: true},
+				},
+			},
+			{
+				Name:  "root",
+				Token: "x",
+				Grants: []*pb.AclGrant{
+					{Keyspace: AllKeyspaces, Admin: true},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		token      string
+		keyspace   string
+		permission Permission
+		allowed    bool
+	}{
+		{"r", "ks1", Read, true},
+		{"r", "ks1", Write, false},
+		{"r", "ks2", Read, false},
+		{"w", "ks1", Write, true},
+		{"w", "ks1", Admin, false},
+		{"w", "ks2", Write, true},
+		{"w", "ks2", Admin, true},
+		{"w", AllKeyspaces, Read, false},
+		{"x", "any", Admin, true},
+		{"x", AllKeyspaces, Admin, true},
+		{"", "ks1", Read, false},
+		{"unknown", "ks1", Read, false},
+	}
+
+	for _, tt := range tests {
+		err := a.Authorize(tt.token, tt.keyspace, tt.permission)
+		if tt.allowed && err != nil {
+			t.Errorf("token %q %s on %s: unexpected %v", tt.token, tt.permission, tt.keyspace, err)
+		}
+		if !tt.allowed && err == nil {
+			t.Errorf("token %q %s on %s: unexpected allowed", tt.token, tt.permission, tt.keyspace)
+		}
+	}
+
+	if err := a.Authorize("", "ks1", Read); err != ErrUnauthenticated {
+		t.Errorf("missing token: %v", err)
+	}
+
+	var disabled *AccessControl
+	if err := disabled.Authorize("", "ks1", Admin); err != nil {
+		t.Errorf("nil access control should allow all: %v", err)
+	}
+	if NewAccessControl(nil) != nil {
+		t.Errorf("nil list should disable access control")
+	}
+
+}
+
+func TestLoadAccessControlList(t *testing.T) {
+
+	f, err := ioutil.TempFile("", "acl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`
+users {
+  name: "app"
+  token: "secret"
+  grants { keyspace: "ks1" read: true write: true }
+}
+`)
+	f.Close()
+
+	acl, err := LoadAccessControlList(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = NewAccessControl(acl).Authorize("secret", "ks1", Write); err != nil {
+		t.Errorf("loaded acl: %v", err)
+	}
+
+}
+
+func TestIncomingToken(t *testing.T) {
+
+	c := NewTokenCredentials("abc")
+	md, _ := c.GetRequestMetadata(context.Background())
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	if token := IncomingToken(ctx); token != "abc" {
+		t.Errorf("incoming token: %q", token)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		metadataKey, bearerPrefix+"abc",
+		metadataKey, bearerPrefix+"call",
+	))
+	if token := IncomingToken(ctx); token != "call" {
+		t.Errorf("call token should take precedence: %q", token)
+	}
+
+	c.SetToken("")
+	if md, _ := c.GetRequestMetadata(context.Background()); len(md) != 0 {
+		t.Errorf("empty token should send nothing: %v", md)
+	}
+
+}
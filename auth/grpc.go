@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authorizer checks the token of a grpc call against the request, or the first message of a stream
+type Authorizer func(token string, fullMethod string, req interface{}) error
+
+// UnaryServerInterceptor rejects the unary calls not allowed by the authorizer
+func UnaryServerInterceptor(authorizer Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorizer(IncomingToken(ctx), info.FullMethod, req); err != nil {
+			return nil, grpcError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the streams whose first message is not allowed by the authorizer
+func StreamServerInterceptor(authorizer Authorizer) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &authorizedStream{
+			ServerStream: stream,
+			authorize: func(m interface{}) error {
+				return authorizer(IncomingToken(stream.Context()), info.FullMethod, m)
+			},
+		})
+	}
+}
+
+// authorizedStream checks the first received message, which usually carries the keyspace
+type authorizedStream struct {
+	grpc.ServerStream
+	authorize    func(m interface{}) error
+	isAuthorized bool
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.isAuthorized {
+		if err := s.authorize(m); err != nil {
+			return grpcError(err)
+		}
+		s.isAuthorized = true
+	}
+	return nil
+}
+
+func grpcError(err error) error {
+	if err == ErrUnauthenticated {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return status.Error(codes.PermissionDenied, err.Error())
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	metadataKey  = "authorization"
+	bearerPrefix = "Bearer "
+)
+
+// TokenCredentials sends the token with each grpc call. The token can be changed, e.g., after login.
+type TokenCredentials struct {
+	sync.RWMutex
+	token string
+}
+
+// NewTokenCredentials creates the credentials. An empty token sends nothing.
+func NewTokenCredentials(token string) *TokenCredentials {
+	return &TokenCredentials{token: token}
+}
+
+// SetToken changes the token for the following calls
+func (c *TokenCredentials) SetToken(token string) {
+	c.Lock()
+	c.token = token
+	c.Unlock()
+}
+
+// Token returns the current token
+func (c *TokenCredentials) Token() string {
+	if c == nil {
+		return ""
+	}
+	c.RLock()
+	defer c.RUnlock()
+	return c.token
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token := c.Token()
+	if token == "" {
+		return nil, nil
+	}
+	return map[string]string{metadataKey: bearerPrefix + token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// IncomingToken returns the token sent with the grpc call.
+// The token of the call credentials is sent after the token of the connection, and takes precedence.
+func IncomingToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(metadataKey)
+	for i := len(values) - 1; i >= 0; i-- {
+		if strings.HasPrefix(values[i], bearerPrefix) {
+			return strings.TrimPrefix(values[i], bearerPrefix)
+		}
+	}
+	return ""
+}
+
+type tokenKey struct{}
+
+// WithToken returns a context to send the requests with the token, instead of the token of the client,
+// e.g., for the gateway to pass on the token of its caller.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// TokenFromContext returns the token set by WithToken
+func TokenFromContext(ctx context.Context) (token string, found bool) {
+	token, found = ctx.Value(tokenKey{}).(string)
+	return
+}
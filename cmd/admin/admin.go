@@ -2,13 +2,17 @@ package admin
 
 import (
 	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/security"
 	"google.golang.org/grpc"
 )
 
 // AdminOption has options to run admin shell
 type AdminOption struct {
 	Master *string
+	Token  *string
+	Tls    *security.TlsOption
 }
 
 type administer struct {
@@ -19,7 +23,7 @@ type administer struct {
 // RunAdmin starts the admin shell process
 func RunAdmin(option *AdminOption) {
 
-	conn, err := grpc.Dial(*option.Master, grpc.WithInsecure())
+	conn, err := grpc.Dial(*option.Master, security.MustNewTls(option.Tls).GrpcDialOption(), grpc.WithPerRPCCredentials(auth.NewTokenCredentials(*option.Token)))
 	if err != nil {
 		glog.Fatalf("fail to dial %v: %v", *option.Master, err)
 	}
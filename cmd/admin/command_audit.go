@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func init() {
+	commands = append(commands, &commandAudit{})
+}
+
+type commandAudit struct {
+	masterClient pb.VastoMasterClient
+}
+
+func (c *commandAudit) Name() string {
+	return "audit"
+}
+
+func (c *commandAudit) Help() string {
+	return "[<keyspace>] [limit=<n>] // list the admin operations on the master, oldest first"
+}
+
+func (c *commandAudit) SetMasterCilent(masterClient pb.VastoMasterClient) {
+	c.masterClient = masterClient
+}
+
+func (c *commandAudit) Do(args []string, out io.Writer) (err error) {
+
+	req := &pb.DescribeRequest_DescAuditLog{}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "limit=") {
+			limit, err := strconv.ParseUint(strings.TrimPrefix(arg, "limit="), 10, 32)
+			if err != nil {
+				return errInvalidArguments
+			}
+			req.Limit = uint32(limit)
+			continue
+		}
+		if req.Keyspace != "" {
+			return errInvalidArguments
+		}
+		req.Keyspace = arg
+	}
+
+	resp, err := c.masterClient.Describe(
+		context.Background(),
+		&pb.DescribeRequest{
+			DescAuditLog: req,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range resp.GetDescAuditLog().GetRecords() {
+		printAuditRecord(out, record)
+	}
+
+	return nil
+}
+
+func printAuditRecord(out io.Writer, record *pb.AuditRecord) {
+	startTime := time.Unix(0, record.StartTimeNs)
+	caller := record.Caller
+	if caller == "" {
+		caller = "-"
+	}
+	result := "ok"
+	if record.Error != "" {
+		result = "error: " + record.Error
+	}
+	fmt.Fprintf(out, "%s %s %s from %s took %v: %s\n",
+		startTime.Format("2006-01-02 15:04:05.000"),
+		strings.TrimPrefix(record.Method, "/pb.VastoMaster/"),
+		caller,
+		record.CallerAddress,
+		time.Duration(record.EndTimeNs-record.StartTimeNs),
+		result,
+	)
+	if record.Arguments != "" {
+		fmt.Fprintf(out, "    arguments: %s\n", record.Arguments)
+	}
+	if record.Cluster != nil {
+		fmt.Fprintf(out, "    cluster: %s size:%d replication:%d\n",
+			record.Cluster.Keyspace, record.Cluster.ExpectedClusterSize, record.Cluster.ReplicationFactor)
+		for _, node := range record.Cluster.Nodes {
+			fmt.Fprintf(out, "      %s %s\n", node.ShardInfo.IdentifierOnThisServer(), node.StoreResource.GetAddress())
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/security"
 	"github.com/gosuri/uiprogress"
 )
 
@@ -24,6 +25,8 @@ type BenchmarkOption struct {
 	BatchSize         *int32
 	Tests             *string
 	DisableUnixSocket *bool
+	Token             *string
+	Tls               *security.TlsOption
 }
 
 type benchmarker struct {
@@ -156,9 +159,11 @@ func (b *benchmarker) runBenchmarkerOnCluster(ctx context.Context, option *Bench
 func (b *benchmarker) startThreadsWithClient(ctx context.Context, name string, fn func(hist *histogram, c *vs.ClusterClient, start, stop, batchSize int)) {
 
 	requestCountEachClient := int(*b.option.RequestCount / *b.option.ClientCount)
+	tls := security.MustNewTls(b.option.Tls)
 
 	b.startThreads(name, requestCountEachClient, int(*b.option.RequestCountStart), func(hist *histogram, start, stop, batchSize int) {
-		vc := vs.NewVastoClient(ctx, "benchmarker", *b.option.Master)
+		vc := vs.NewVastoClient(ctx, "benchmarker", *b.option.Master, vs.WithTls(tls))
+		vc.SetToken(*b.option.Token)
 		if *b.option.DisableUnixSocket {
 			vc.ClusterListener.SetUnixSocket(false)
 		}
@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	"net/http"
+
+	"github.com/chrislusf/vasto/stats"
+)
+
+type gatewayReadiness struct {
+	Ready        bool   `json:"ready"`
+	Keyspace     string `json:"keyspace"`
+	CurrentSize  int    `json:"current_size"`
+	ExpectedSize int    `json:"expected_size"`
+}
+
+// handleReady reports whether the gateway has received the full cluster topology of its keyspace.
+func (gs *gatewayServer) handleReady(w http.ResponseWriter, r *http.Request) {
+
+	clusterListener := gs.vastoClient.ClusterListener
+	readiness := &gatewayReadiness{
+		Ready:    clusterListener.HasConnectedKeyspace(*gs.option.Keyspace),
+		Keyspace: *gs.option.Keyspace,
+	}
+	if cluster, found := clusterListener.GetCluster(*gs.option.Keyspace); found {
+		readiness.CurrentSize = cluster.CurrentSize()
+		readiness.ExpectedSize = cluster.ExpectedSize()
+	}
+
+	stats.WriteReadiness(w, readiness.Ready, readiness)
+}
@@ -0,0 +1,33 @@
+package gateway
+
+import (
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/stats"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	gatewayRequestHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "vasto",
+			Subsystem: "gateway",
+			Name:      "request_seconds",
+			Help:      "Request latency by op type and result.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 18),
+		}, []string{"op", "result"})
+)
+
+// newMetricsServer creates the http server of the gateway metrics
+func (gs *gatewayServer) newMetricsServer() *stats.HttpServer {
+	httpServer := stats.NewHttpServer("Vasto gateway")
+	httpServer.MustRegister(gatewayRequestHistogram)
+	return httpServer
+}
+
+// responseResult returns "ok" or "error", for metrics labels.
+func responseResult(response *pb.Response) string {
+	if response.StatusError() != nil {
+		return "error"
+	}
+	return "ok"
+}
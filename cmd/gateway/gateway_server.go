@@ -7,20 +7,27 @@ import (
 	"context"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/security"
+	"github.com/chrislusf/vasto/tracing"
 	"github.com/chrislusf/vasto/util/interrupt"
 	"os"
 )
 
 // GatewayOption has options to run gateway
 type GatewayOption struct {
-	TcpAddress *string
-	UnixSocket *string
-	Master     *string
-	Keyspace   *string
+	TcpAddress    *string
+	UnixSocket    *string
+	Master        *string
+	Keyspace      *string
+	HttpAddress   *string
+	TraceExporter *string
+	Tls           *security.TlsOption
 }
 
 type gatewayServer struct {
 	option *GatewayOption
+	// nil if TLS is not enabled, used for both the gateway clients and the stores
+	tls *security.Tls
 
 	vastoClient *vs.VastoClient
 }
@@ -28,9 +35,11 @@ type gatewayServer struct {
 // RunGateway starts a gateway process
 func RunGateway(option *GatewayOption) {
 
+	tls := security.MustNewTls(option.Tls)
 	var gs = &gatewayServer{
 		option:      option,
-		vastoClient: vs.NewVastoClient(context.Background(), "gateway", *option.Master),
+		tls:         tls,
+		vastoClient: vs.NewVastoClient(context.Background(), "gateway", *option.Master, vs.WithTls(tls)),
 	}
 
 	if *option.TcpAddress != "" {
@@ -55,8 +64,17 @@ func RunGateway(option *GatewayOption) {
 		go gs.serveTcp(unixSocketListener)
 	}
 
+	httpServer := gs.newMetricsServer()
+	httpServer.HandleFunc("/gateway/ready", gs.handleReady)
 	gs.vastoClient.NewClusterClient(*option.Keyspace)
 
+	httpServer.Start(*option.HttpAddress)
+	if shutdownTracing, err := tracing.Init("Vasto gateway", *option.TraceExporter); err != nil {
+		glog.Fatal(err)
+	} else {
+		interrupt.OnInterrupt(shutdownTracing, nil)
+	}
+
 	glog.V(0).Infof("Vasto gateway ready\n")
 	select {}
 
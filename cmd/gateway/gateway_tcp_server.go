@@ -2,17 +2,25 @@ package gateway
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/goclient/vs"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/tracing"
 	"github.com/chrislusf/vasto/util"
 	"github.com/golang/protobuf/proto"
 	"io"
 	"net"
+	"sync"
 	"time"
 )
 
+// maxInFlightRequestsPerConnection limits how many pipelined Requests are processed concurrently for one connection
+const maxInFlightRequestsPerConnection = 64
+
 // Run starts the heartbeating to master and starts accepting requests.
 func (ms *gatewayServer) serveTcp(listener net.Listener) {
 
@@ -34,7 +42,14 @@ func (ms *gatewayServer) serveTcp(listener net.Listener) {
 				c.SetNoDelay(true)
 				c.SetLinger(0)
 			}
-			ms.handleConnection(conn)
+			// only tcp connections are encrypted, unix sockets are local
+			tlsConn, err := ms.tls.ServerConn(conn)
+			if err != nil {
+				glog.V(1).Infof("tls handshake with %v: %v", conn.RemoteAddr(), err)
+				conn.Close()
+				return
+			}
+			ms.handleConnection(tlsConn)
 		}()
 	}
 }
@@ -43,48 +58,78 @@ func (ms *gatewayServer) handleConnection(conn net.Conn) {
 
 	reader := bufio.NewReader(conn)
 
+	var writeLock sync.Mutex
+	var wg sync.WaitGroup
+	inFlight := make(chan struct{}, maxInFlightRequestsPerConnection)
+
 	for {
-		if err := ms.handleRequest(reader, conn); err != nil {
+		input, err := util.ReadMessage(reader)
+		if err != nil {
 			if err != io.EOF {
-				glog.Errorf("handleRequest: %v", err)
+				glog.Errorf("read message: %v", err)
 			}
-			return
+			break
 		}
-	}
 
-}
+		receivedAt := time.Now()
 
-func (ms *gatewayServer) handleRequest(reader io.Reader, writer io.Writer) error {
+		inFlight <- struct{}{}
+		wg.Add(1)
+		go func(input []byte) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+			if err := ms.handleRequest(input, receivedAt, conn, &writeLock); err != nil {
+				glog.Errorf("handleRequest: %v", err)
+				// the client can not match the responses any more
+				conn.Close()
+			}
+		}(input)
+	}
 
-	var input, output []byte
-	var err error
+	wg.Wait()
 
-	input, err = util.ReadMessage(reader)
+}
 
-	if err == io.EOF {
-		return err
-	}
-	if err != nil {
-		return fmt.Errorf("read message: %v", err)
-	}
+// handleRequest processes one Requests message. Several of them can be processed concurrently
+// on one connection, and the responses are written back as they complete.
+func (ms *gatewayServer) handleRequest(input []byte, receivedAt time.Time, writer io.Writer, writeLock *sync.Mutex) error {
 
 	requests := &pb.Requests{}
-	if err = proto.Unmarshal(input, requests); err != nil {
+	if err := proto.Unmarshal(input, requests); err != nil {
 		return fmt.Errorf("unmarshal: %v", err)
 	}
 
-	responses := &pb.Responses{}
+	// pass the remaining time budget and the trace context on to the stores
+	ctx := tracing.Extract(context.Background(), requests.TraceContext)
+	ctx = auth.WithToken(ctx, requests.Token)
+	if requests.TimeoutNs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, receivedAt.Add(time.Duration(requests.TimeoutNs)))
+		defer cancel()
+	}
+
+	responses := &pb.Responses{
+		RequestId: requests.RequestId,
+	}
 	for _, request := range requests.Requests {
-		response := ms.processRequest(request)
+		startTime := time.Now()
+		spanCtx, span := tracing.Start(ctx, "vasto.gateway."+request.OpName())
+		response := ms.processRequest(spanCtx, request)
+		tracing.End(span, response.StatusError())
+		gatewayRequestHistogram.WithLabelValues(request.OpName(), responseResult(response)).Observe(time.Since(startTime).Seconds())
 		responses.Responses = append(responses.Responses, response)
 	}
 
-	output, err = proto.Marshal(responses)
+	output, err := proto.Marshal(responses)
 	if err != nil {
 		return fmt.Errorf("marshal: %v", err)
 	}
 
+	writeLock.Lock()
 	err = util.WriteMessage(writer, output)
+	writeLock.Unlock()
 	if err != nil {
 		return fmt.Errorf("write message: %v", err)
 	}
@@ -93,17 +138,18 @@ func (ms *gatewayServer) handleRequest(reader io.Reader, writer io.Writer) error
 
 }
 
-func (ms *gatewayServer) processRequest(command *pb.Request) *pb.Response {
+func (ms *gatewayServer) processRequest(ctx context.Context, command *pb.Request) *pb.Response {
 
 	client := ms.vastoClient.NewClusterClient(*ms.option.Keyspace)
 
 	if command.GetGet() != nil {
 		key := vs.Key(command.Get.Key).SetPartitionHash(command.Get.PartitionHash)
-		value, dt, err := client.Get(key)
+		value, dt, err := client.GetCtx(ctx, key)
 		if err != nil {
 			return &pb.Response{
 				Get: &pb.GetResponse{
-					Status: err.Error(),
+					Status:      err.Error(),
+					IsThrottled: errors.Is(err, vs.ErrorThrottled),
 				},
 			}
 		}
@@ -125,10 +171,11 @@ func (ms *gatewayServer) processRequest(command *pb.Request) *pb.Response {
 		resp := &pb.WriteResponse{
 			Ok: true,
 		}
-		err := client.Put(key, value)
+		err := client.PutCtx(ctx, key, value)
 		if err != nil {
 			resp.Ok = false
 			resp.Status = err.Error()
+			resp.IsThrottled = errors.Is(err, vs.ErrorThrottled)
 		}
 		return &pb.Response{
 			Write: resp,
@@ -139,10 +186,24 @@ func (ms *gatewayServer) processRequest(command *pb.Request) *pb.Response {
 		resp := &pb.WriteResponse{
 			Ok: true,
 		}
-		err := ms.vastoClient.NewClusterClient(*ms.option.Keyspace).Delete(key)
+		err := ms.vastoClient.NewClusterClient(*ms.option.Keyspace).DeleteCtx(ctx, key)
+		if err != nil {
+			resp.Ok = false
+			resp.Status = err.Error()
+			resp.IsThrottled = errors.Is(err, vs.ErrorThrottled)
+		}
+		return &pb.Response{
+			Write: resp,
+		}
+	} else if command.GetDeleteRange() != nil {
+		resp := &pb.WriteResponse{
+			Ok: true,
+		}
+		err := client.DeleteRangeCtx(ctx, command.DeleteRange.Start, command.DeleteRange.End)
 		if err != nil {
 			resp.Ok = false
 			resp.Status = err.Error()
+			resp.IsThrottled = errors.Is(err, vs.ErrorThrottled)
 		}
 		return &pb.Response{
 			Write: resp,
@@ -153,10 +214,11 @@ func (ms *gatewayServer) processRequest(command *pb.Request) *pb.Response {
 		lastSeenKey := command.GetByPrefix.LastSeenKey
 
 		resp := &pb.GetByPrefixResponse{}
-		keyValues, err := client.GetByPrefix(nil, prefix, limit, lastSeenKey)
+		keyValues, err := client.GetByPrefixCtx(ctx, nil, prefix, limit, lastSeenKey)
 		if err != nil {
 			resp.Ok = false
 			resp.Status = err.Error()
+			resp.IsThrottled = errors.Is(err, vs.ErrorThrottled)
 		} else {
 			for _, keyValue := range keyValues {
 				resp.KeyValues = append(resp.KeyValues, &pb.KeyTypeValue{
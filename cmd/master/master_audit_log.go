@@ -0,0 +1,222 @@
+package master
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// auditedMethods are the admin operations recorded in the audit log
+var auditedMethods = map[string]bool{
+	"/pb.VastoMaster/CreateCluster":    true,
+	"/pb.VastoMaster/DeleteCluster":    true,
+	"/pb.VastoMaster/CompactCluster":   true,
+	"/pb.VastoMaster/ResizeCluster":    true,
+	"/pb.VastoMaster/ReplaceNode":      true,
+	"/pb.VastoMaster/SetKeyspaceQuota": true,
+	"/pb.VastoMaster/DebugMaster":      true,
+}
+
+// auditLogMaxRecords is the number of the latest records kept in memory to query
+var auditLogMaxRecords = 10000
+
+// auditLog keeps the latest records of the admin operations in memory,
+// and appends them to a file if configured, to keep them across restarts.
+type auditLog struct {
+	sync.Mutex
+	file    *os.File
+	records []*pb.AuditRecord
+}
+
+// newAuditLog loads the existing records from the file, and opens it for appending.
+// A broken record at the end, e.g., partly written before a crash, is truncated.
+// The records are only kept in memory if the file name is empty.
+func newAuditLog(fileName string) (*auditLog, error) {
+	l := &auditLog{}
+	if fileName == "" {
+		return l, nil
+	}
+
+	file, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log %s: %v", fileName, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("stat audit log %s: %v", fileName, err)
+	}
+
+	var offset, count int64
+	reader := bufio.NewReader(file)
+	for offset < info.Size() {
+		record, size, err := readAuditRecord(reader, info.Size()-offset)
+		if err != nil {
+			glog.Warningf("audit log %s has a broken record after %d records: %v", fileName, count, err)
+			break
+		}
+		offset += size
+		count++
+		l.keep(record)
+	}
+
+	if offset < info.Size() {
+		glog.Warningf("audit log %s truncates %d bytes after the last good record", fileName, info.Size()-offset)
+		if err = file.Truncate(offset); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("truncate audit log %s: %v", fileName, err)
+		}
+	}
+
+	l.file = file
+	glog.V(0).Infof("audit log %s has %d records", fileName, count)
+
+	return l, nil
+}
+
+// readAuditRecord reads one record, and returns the bytes read, which are at most the remaining bytes of the file.
+func readAuditRecord(reader io.Reader, remaining int64) (*pb.AuditRecord, int64, error) {
+	var length int32
+	if err := binary.Read(reader, binary.LittleEndian, &length); err != nil {
+		return nil, 0, fmt.Errorf("read record length: %v", err)
+	}
+	if length < 0 || int64(length) > remaining-4 {
+		return nil, 0, fmt.Errorf("record length %d over the remaining %d bytes", length, remaining-4)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, 0, fmt.Errorf("read record: %v", err)
+	}
+	record := &pb.AuditRecord{}
+	if err := proto.Unmarshal(data, record); err != nil {
+		return nil, 0, err
+	}
+	return record, 4 + int64(length), nil
+}
+
+// keep adds the record to the memory, dropping the oldest one if over auditLogMaxRecords
+func (l *auditLog) keep(record *pb.AuditRecord) {
+	if len(l.records) >= auditLogMaxRecords {
+		n := copy(l.records, l.records[len(l.records)-auditLogMaxRecords+1:])
+		l.records = l.records[:n]
+	}
+	l.records = append(l.records, record)
+}
+
+func (l *auditLog) append(record *pb.AuditRecord) error {
+	l.Lock()
+	defer l.Unlock()
+
+	l.keep(record)
+
+	if l.file == nil {
+		return nil
+	}
+
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal audit record: %v", err)
+	}
+	var buf bytes.Buffer
+	if err = util.WriteMessage(&buf, data); err != nil {
+		return err
+	}
+	if _, err = l.file.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write audit log: %v", err)
+	}
+	return l.file.Sync()
+}
+
+func (l *auditLog) close() {
+	if l.file != nil {
+		l.file.Close()
+	}
+}
+
+// query returns the latest records, within the ones kept in memory, of the keyspace, or of all keyspaces if keyspace is empty.
+func (l *auditLog) query(keyspace string, limit int) (records []*pb.AuditRecord) {
+	l.Lock()
+	defer l.Unlock()
+
+	for i := len(l.records) - 1; i >= 0; i-- {
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+		if keyspace == "" || l.records[i].Keyspace == keyspace {
+			records = append(records, l.records[i])
+		}
+	}
+
+	// oldest first
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+
+	return records
+}
+
+// auditUnaryInterceptor records the admin operations, including the ones denied by the access control.
+func (ms *masterServer) auditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if !auditedMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	record := &pb.AuditRecord{
+		Method:      info.FullMethod,
+		Keyspace:    requestKeyspace(req),
+		StartTimeNs: time.Now().UnixNano(),
+	}
+	if m, ok := req.(proto.Message); ok {
+		record.Arguments = proto.CompactTextString(m)
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		record.CallerAddress = p.Addr.String()
+	}
+	if user, err := ms.accessControl.Authenticate(auth.IncomingToken(ctx)); err == nil && user != nil {
+		record.Caller = user.Name
+	}
+
+	resp, err := handler(ctx, req)
+
+	record.EndTimeNs = time.Now().UnixNano()
+	if err != nil {
+		record.Error = err.Error()
+	} else if r, ok := resp.(interface{ GetError() string }); ok {
+		record.Error = r.GetError()
+	}
+	if keyspace, found := ms.topo.keyspaces.getKeyspace(record.Keyspace); found && keyspace.cluster != nil {
+		record.Cluster = keyspace.cluster.ToCluster()
+	}
+
+	if appendErr := ms.auditLog.append(record); appendErr != nil {
+		glog.Errorf("audit %s: %v", record.String(), appendErr)
+	}
+
+	return resp, err
+}
+
+// requestKeyspace returns the keyspace of the admin request, empty if not for one keyspace.
+func requestKeyspace(req interface{}) string {
+	switch r := req.(type) {
+	case *pb.SetKeyspaceQuotaRequest:
+		return r.GetQuota().GetKeyspace()
+	case interface{ GetKeyspace() string }:
+		return r.GetKeyspace()
+	}
+	return ""
+}
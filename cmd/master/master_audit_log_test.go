@@ -0,0 +1,106 @@
+package master
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func openTestAuditLog(t *testing.T, fileName string) *auditLog {
+	l, err := newAuditLog(fileName)
+	if err != nil {
+		t.Fatalf("open audit log: %v", err)
+	}
+	return l
+}
+
+func appendTestRecords(t *testing.T, l *auditLog, keyspace string, count int) {
+	for i := 0; i < count; i++ {
+		if err := l.append(&pb.AuditRecord{
+			Method:   "/pb.VastoMaster/CreateCluster",
+			Keyspace: keyspace,
+			Caller:   fmt.Sprintf("user%d", i),
+		}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+}
+
+func TestAuditLogReopen(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "vasto-audit-log")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "audit.log")
+
+	l := openTestAuditLog(t, fileName)
+	appendTestRecords(t, l, "ks1", 2)
+	appendTestRecords(t, l, "ks2", 1)
+	l.close()
+
+	l = openTestAuditLog(t, fileName)
+	defer l.close()
+	if records := l.query("", 0); len(records) != 3 {
+		t.Fatalf("reopened audit log has %d records, expecting 3", len(records))
+	}
+	records := l.query("ks1", 1)
+	if len(records) != 1 || records[0].Caller != "user1" {
+		t.Errorf("latest ks1 record: %v", records)
+	}
+}
+
+func TestAuditLogTornTail(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "vasto-audit-log")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "audit.log")
+
+	l := openTestAuditLog(t, fileName)
+	appendTestRecords(t, l, "ks1", 2)
+	l.close()
+
+	// a record partly written before a crash
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	file.Write([]byte{100, 0, 0, 0, 1, 2})
+	file.Close()
+
+	l = openTestAuditLog(t, fileName)
+	if records := l.query("", 0); len(records) != 2 {
+		t.Fatalf("audit log with a torn tail has %d records, expecting 2", len(records))
+	}
+	appendTestRecords(t, l, "ks2", 1)
+	l.close()
+
+	// the new record is readable after the torn tail is truncated
+	l = openTestAuditLog(t, fileName)
+	defer l.close()
+	if records := l.query("", 0); len(records) != 3 || records[2].Keyspace != "ks2" {
+		t.Errorf("records after the torn tail: %v", records)
+	}
+}
+
+func TestAuditLogMaxRecords(t *testing.T) {
+
+	defer func(max int) { auditLogMaxRecords = max }(auditLogMaxRecords)
+	auditLogMaxRecords = 3
+
+	l := openTestAuditLog(t, "")
+	appendTestRecords(t, l, "ks1", 5)
+
+	records := l.query("", 0)
+	if len(records) != 3 || records[0].Caller != "user2" || records[2].Caller != "user4" {
+		t.Errorf("kept records: %v", records)
+	}
+}
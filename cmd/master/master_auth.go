@@ -0,0 +1,99 @@
+package master
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// loadAccessControl loads the access control list, and adds a master user with a random token
+// for the master to call the stores.
+func (ms *masterServer) loadAccessControl(aclFile string) error {
+	if aclFile == "" {
+		return nil
+	}
+
+	acl, err := auth.LoadAccessControlList(aclFile)
+	if err != nil {
+		return err
+	}
+
+	internalToken := make([]byte, 16)
+	if _, err = rand.Read(internalToken); err != nil {
+		return err
+	}
+	ms.storeCredentials.SetToken(hex.EncodeToString(internalToken))
+
+	storeAcl := proto.Clone(acl).(*pb.AccessControlList)
+	storeAcl.Users = append(storeAcl.Users, &pb.AclUser{
+		Name:  "master",
+		Token: ms.storeCredentials.Token(),
+		Grants: []*pb.AclGrant{
+			{Keyspace: auth.AllKeyspaces, Admin: true},
+		},
+	})
+
+	ms.accessControl = auth.NewAccessControl(acl)
+	ms.storeAccessControlList = storeAcl
+	glog.V(0).Infof("access control enabled with %d users from %s", len(acl.Users), aclFile)
+	if ms.tls == nil {
+		glog.Warningf("TLS is not enabled, the user tokens are sent to the stores in plaintext")
+	}
+
+	return nil
+}
+
+// authorizeGrpc checks the token of the calls to the master
+func (ms *masterServer) authorizeGrpc(token string, fullMethod string, req interface{}) error {
+
+	switch fullMethod {
+	case "/pb.VastoMaster/RegisterClient", "/pb.VastoMaster/Login":
+		// the cluster topology is not protected, the data is protected by the stores
+		return nil
+	}
+
+	keyspace := auth.AllKeyspaces
+	permission := auth.Admin
+	switch r := req.(type) {
+	case *pb.DescribeRequest:
+		permission = auth.Read
+		if r.GetDescCluster() != nil {
+			keyspace = r.DescCluster.Keyspace
+		}
+		if r.GetDescAuditLog() != nil {
+			permission = auth.Admin
+			if r.DescAuditLog.Keyspace != "" {
+				keyspace = r.DescAuditLog.Keyspace
+			}
+		}
+	case *pb.SetKeyspaceQuotaRequest:
+		keyspace = r.GetQuota().GetKeyspace()
+	case interface{ GetKeyspace() string }:
+		keyspace = r.GetKeyspace()
+	}
+
+	return ms.accessControl.Authorize(token, keyspace, permission)
+}
+
+// Login returns the user and the grants of the token.
+func (ms *masterServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+
+	resp := &pb.LoginResponse{}
+
+	user, err := ms.accessControl.Authenticate(auth.IncomingToken(ctx))
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	if user != nil {
+		resp.User = user.Name
+		resp.Grants = user.Grants
+	}
+
+	return resp, nil
+}
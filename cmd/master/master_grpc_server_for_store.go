@@ -31,8 +31,19 @@ func (ms *masterServer) RegisterStore(stream pb.VastoMaster_RegisterStoreServer)
 	}
 	defer ms.topo.dataCenter.deleteServer(storeResource)
 
+	ms.restoreKeyspaceQuotas(storeHeartbeat.KeyspaceQuotas)
+	ms.restoreKeyspaceStorages(storeHeartbeat.KeyspaceStorages)
+
+	if err = stream.Send(&pb.StoreMessage{
+		AccessControlList:     ms.storeAccessControlList,
+		AccessControlDisabled: ms.storeAccessControlList == nil,
+	}); err != nil {
+		return fmt.Errorf("send access control list to %v: %v", storeResource.Address, err)
+	}
+
 	seenShardsOnThisServer := make(map[string]*pb.ShardInfo)
 	defer ms.unRegisterShards(seenShardsOnThisServer, storeResource)
+	defer ms.replicationLags.deleteStoreLags(storeResource.Address)
 
 	var e error
 	for {
@@ -40,6 +51,13 @@ func (ms *masterServer) RegisterStore(stream pb.VastoMaster_RegisterStoreServer)
 		if e != nil {
 			break
 		}
+		if beat.ShardInfo == nil {
+			if err := ms.processReplicationLags(seenShardsOnThisServer, storeResource, beat.ReplicationLags); err != nil {
+				glog.Errorf("process replication lags from %v: %v", storeResource.Address, err)
+				return err
+			}
+			continue
+		}
 		if err := ms.processShardInfo(seenShardsOnThisServer, storeResource, beat.ShardInfo); err != nil {
 			glog.Errorf("process shard status %v: %v", beat.ShardInfo, err)
 			glog.Errorf("[master] - store %v: %v", storeResource.Address, e)
@@ -113,6 +131,7 @@ func (ms *masterServer) processShardInfo(seenShardsOnThisServer map[string]*pb.S
 			shardInfo.IdentifierOnThisServer(), storeResource.Address, cluster)
 	} else {
 		// println("updated shard info:", shardInfo.String(), "store", storeResource.GetAddress())
+		shardInfo.IsStale = ms.isStale(storeResource, shardInfo)
 		oldShardInfo := cluster.SetShard(storeResource, shardInfo)
 		ms.notifyUpdate(shardInfo, storeResource)
 		seenShardsOnThisServer[shardInfo.IdentifierOnThisServer()] = shardInfo
@@ -0,0 +1,65 @@
+package master
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/chrislusf/vasto/stats"
+)
+
+type masterReadiness struct {
+	Ready     bool                `json:"ready"`
+	Stores    []storeReadiness    `json:"stores"`
+	Keyspaces []keyspaceReadiness `json:"keyspaces"`
+}
+
+type storeReadiness struct {
+	Address      string   `json:"address"`
+	AdminAddress string   `json:"admin_address"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+type keyspaceReadiness struct {
+	Keyspace     string `json:"keyspace"`
+	CurrentSize  int    `json:"current_size"`
+	ExpectedSize int    `json:"expected_size"`
+}
+
+// handleReady lists the connected stores and the keyspace clusters.
+// The master is ready as soon as it serves, since stores and clients re-connect to it anyway.
+func (ms *masterServer) handleReady(w http.ResponseWriter, r *http.Request) {
+
+	readiness := &masterReadiness{Ready: true}
+
+	dataCenter := ms.topo.dataCenter
+	dataCenter.RLock()
+	for _, storeResource := range dataCenter.servers {
+		readiness.Stores = append(readiness.Stores, storeReadiness{
+			Address:      storeResource.Address,
+			AdminAddress: storeResource.AdminAddress,
+			Tags:         storeResource.Tags,
+		})
+	}
+	dataCenter.RUnlock()
+
+	keyspaces := ms.topo.keyspaces
+	keyspaces.RLock()
+	for name, k := range keyspaces.keyspaces {
+		t := keyspaceReadiness{Keyspace: string(name)}
+		if k.cluster != nil {
+			t.CurrentSize = k.cluster.CurrentSize()
+			t.ExpectedSize = k.cluster.ExpectedSize()
+		}
+		readiness.Keyspaces = append(readiness.Keyspaces, t)
+	}
+	keyspaces.RUnlock()
+
+	sort.Slice(readiness.Stores, func(i, j int) bool {
+		return readiness.Stores[i].Address < readiness.Stores[j].Address
+	})
+	sort.Slice(readiness.Keyspaces, func(i, j int) bool {
+		return readiness.Keyspaces[i].Keyspace < readiness.Keyspaces[j].Keyspace
+	})
+
+	stats.WriteReadiness(w, readiness.Ready, readiness)
+}
@@ -0,0 +1,30 @@
+package master
+
+import (
+	"github.com/chrislusf/vasto/pb"
+)
+
+func (k *keyspace) setStorage(keyspaceStorage *pb.KeyspaceStorage) {
+	k.storageLock.Lock()
+	k.storage = keyspaceStorage
+	k.storageLock.Unlock()
+}
+
+func (k *keyspace) getStorage() *pb.KeyspaceStorage {
+	k.storageLock.RLock()
+	defer k.storageLock.RUnlock()
+	return k.storage
+}
+
+// restoreKeyspaceStorages adopts the storage engines reported by a store, if this master does not know them yet,
+// e.g., after the master restarts, so the new shards of resizing or replacing use the same engine.
+func (ms *masterServer) restoreKeyspaceStorages(keyspaceStorages []*pb.KeyspaceStorage) {
+	for _, keyspaceStorage := range keyspaceStorages {
+		keyspace := ms.topo.keyspaces.getOrCreateKeyspace(keyspaceStorage.Keyspace)
+		keyspace.storageLock.Lock()
+		if keyspace.storage == nil {
+			keyspace.storage = keyspaceStorage
+		}
+		keyspace.storageLock.Unlock()
+	}
+}
@@ -0,0 +1,70 @@
+package master
+
+import (
+	"time"
+
+	"github.com/chrislusf/vasto/stats"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	masterTopologyOpHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "vasto",
+			Subsystem: "master",
+			Name:      "topology_op_seconds",
+			Help:      "Duration of topology operations, e.g., create, delete, resize, replace, and compact.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+		}, []string{"op", "result"})
+
+	masterStoreCountDesc = prometheus.NewDesc(
+		"vasto_master_stores",
+		"Number of stores registered to the master.",
+		nil, nil)
+
+	masterClientCountDesc = prometheus.NewDesc(
+		"vasto_master_clients",
+		"Number of clients connected to the master, by keyspace.",
+		[]string{"keyspace"}, nil)
+)
+
+// newMetricsServer creates the http server of the master metrics
+func (ms *masterServer) newMetricsServer() *stats.HttpServer {
+	httpServer := stats.NewHttpServer("Vasto master")
+	httpServer.MustRegister(masterTopologyOpHistogram, &masterCollector{ms: ms})
+	return httpServer
+}
+
+// observeTopologyOp is used as: defer observeTopologyOp("create", time.Now(), &resp.Error)
+func observeTopologyOp(op string, startTime time.Time, respError *string) {
+	result := "ok"
+	if *respError != "" {
+		result = "error"
+	}
+	masterTopologyOpHistogram.WithLabelValues(op, result).Observe(time.Since(startTime).Seconds())
+}
+
+// masterCollector reads the master states when the metrics are scraped
+type masterCollector struct {
+	ms *masterServer
+}
+
+func (c *masterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- masterStoreCountDesc
+	ch <- masterClientCountDesc
+}
+
+func (c *masterCollector) Collect(ch chan<- prometheus.Metric) {
+
+	dataCenter := c.ms.topo.dataCenter
+	dataCenter.RLock()
+	storeCount := len(dataCenter.servers)
+	dataCenter.RUnlock()
+	ch <- prometheus.MustNewConstMetric(masterStoreCountDesc, prometheus.GaugeValue, float64(storeCount))
+
+	c.ms.clientsStat.Lock()
+	for keyspace, count := range c.ms.clientsStat.keyspaceClientCount {
+		ch <- prometheus.MustNewConstMetric(masterClientCountDesc, prometheus.GaugeValue, float64(count), string(keyspace))
+	}
+	c.ms.clientsStat.Unlock()
+}
@@ -0,0 +1,95 @@
+package master
+
+import (
+	"sync"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+)
+
+// replicationLags keeps the latest replication lags reported by each store
+type replicationLags struct {
+	sync.RWMutex
+	lagsByStore map[serverAddress][]*pb.ReplicationLag
+}
+
+func newReplicationLags() *replicationLags {
+	return &replicationLags{
+		lagsByStore: make(map[serverAddress][]*pb.ReplicationLag),
+	}
+}
+
+func (rl *replicationLags) setStoreLags(address string, lags []*pb.ReplicationLag) {
+	rl.Lock()
+	rl.lagsByStore[serverAddress(address)] = lags
+	rl.Unlock()
+}
+
+func (rl *replicationLags) deleteStoreLags(address string) {
+	rl.Lock()
+	delete(rl.lagsByStore, serverAddress(address))
+	rl.Unlock()
+}
+
+// getKeyspaceLags returns the lags of all shards in the keyspace
+func (rl *replicationLags) getKeyspaceLags(keyspace string) (lags []*pb.ReplicationLag) {
+	rl.RLock()
+	for _, storeLags := range rl.lagsByStore {
+		for _, lag := range storeLags {
+			if lag.Keyspace == keyspace {
+				lags = append(lags, lag)
+			}
+		}
+	}
+	rl.RUnlock()
+	return
+}
+
+// maxLag returns the largest lag of the shard on the store to any of its followed peers
+func (rl *replicationLags) maxLag(address string, shardInfo *pb.ShardInfo) (maxTimeNs uint64) {
+	rl.RLock()
+	for _, lag := range rl.lagsByStore[serverAddress(address)] {
+		if lag.Keyspace == shardInfo.KeyspaceName && lag.ServerId == shardInfo.ServerId && lag.ShardId == shardInfo.ShardId {
+			if lag.TimeNs > maxTimeNs {
+				maxTimeNs = lag.TimeNs
+			}
+		}
+	}
+	rl.RUnlock()
+	return
+}
+
+// isStale checks whether the shard on the store lags behind its peers more than the threshold
+func (ms *masterServer) isStale(storeResource *pb.StoreResource, shardInfo *pb.ShardInfo) bool {
+	threshold := *ms.option.StaleReplicaLag
+	if threshold <= 0 {
+		return false
+	}
+	return time.Duration(ms.replicationLags.maxLag(storeResource.Address, shardInfo)) > threshold
+}
+
+// processReplicationLags saves the reported lags, and notifies the clients of the shards becoming stale or fresh
+func (ms *masterServer) processReplicationLags(seenShardsOnThisServer map[string]*pb.ShardInfo,
+	storeResource *pb.StoreResource, lags []*pb.ReplicationLag) error {
+
+	ms.replicationLags.setStoreLags(storeResource.Address, lags)
+
+	var changedShards []*pb.ShardInfo
+	for _, shardInfo := range seenShardsOnThisServer {
+		if isStale := ms.isStale(storeResource, shardInfo); isStale != shardInfo.IsStale {
+			t := shardInfo.Clone()
+			t.IsStale = isStale
+			changedShards = append(changedShards, t)
+		}
+	}
+
+	for _, shardInfo := range changedShards {
+		glog.V(1).Infof("[master] * %s on %s stale:%v", shardInfo.IdentifierOnThisServer(), storeResource.Address, shardInfo.IsStale)
+		if err := ms.processShardInfo(seenShardsOnThisServer, storeResource, shardInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
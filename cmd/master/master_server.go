@@ -5,14 +5,25 @@ import (
 	"sync"
 
 	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/security"
+	"github.com/chrislusf/vasto/tracing"
+	"github.com/chrislusf/vasto/util/interrupt"
 	"google.golang.org/grpc"
 	"sync/atomic"
+	"time"
 )
 
 // MasterOption has options to run a master process
 type MasterOption struct {
-	Address *string
+	Address         *string
+	HttpAddress     *string
+	StaleReplicaLag *time.Duration
+	TraceExporter   *string
+	AclFile         *string
+	Tls             *security.TlsOption
+	AuditLog        *string
 }
 
 type masterServer struct {
@@ -22,6 +33,17 @@ type masterServer struct {
 	topo                 *masterTopology
 	keyspaceMutexMap     map[string]*mutexWithCounter
 	keyspaceMutexMapLock sync.Mutex
+	replicationLags      *replicationLags
+	// nil if the access control is not enabled
+	accessControl          *auth.AccessControl
+	storeAccessControlList *pb.AccessControlList
+	// nil if TLS is not enabled
+	tls      *security.Tls
+	auditLog *auditLog
+	// storeTransport is the transport credentials to dial the store admin ports
+	storeTransport grpc.DialOption
+	// storeCredentials is sent by the master when calling the store admin ports
+	storeCredentials *auth.TokenCredentials
 }
 
 // RunMaster starts a master process
@@ -32,6 +54,19 @@ func RunMaster(option *MasterOption) {
 		clientsStat:      newClientsStat(),
 		topo:             newMasterTopology(),
 		keyspaceMutexMap: make(map[string]*mutexWithCounter),
+		replicationLags:  newReplicationLags(),
+		tls:              security.MustNewTls(option.Tls),
+		storeCredentials: auth.NewTokenCredentials(""),
+	}
+	ms.storeTransport = ms.tls.GrpcDialOption()
+
+	if err := ms.loadAccessControl(*option.AclFile); err != nil {
+		glog.Fatal(err)
+	}
+
+	var err error
+	if ms.auditLog, err = newAuditLog(*option.AuditLog); err != nil {
+		glog.Fatal(err)
 	}
 
 	listener, err := net.Listen("tcp", *option.Address)
@@ -40,6 +75,15 @@ func RunMaster(option *MasterOption) {
 	}
 	glog.V(0).Infof("Vasto master starts on %s\n", *option.Address)
 
+	httpServer := ms.newMetricsServer()
+	httpServer.HandleFunc("/master/ready", ms.handleReady)
+	httpServer.Start(*option.HttpAddress)
+	if shutdownTracing, err := tracing.Init("Vasto master", *option.TraceExporter); err != nil {
+		glog.Fatal(err)
+	} else {
+		interrupt.OnInterrupt(shutdownTracing, nil)
+	}
+
 	// m := cmux.New(listener)
 	// grpcListener := m.Match(cmux.HTTP2HeaderField("content-type", "application/grpc"))
 
@@ -52,7 +96,10 @@ func RunMaster(option *MasterOption) {
 }
 
 func (ms *masterServer) serveGrpc(listener net.Listener) {
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(append(ms.tls.GrpcServerOptions(),
+		grpc.ChainUnaryInterceptor(ms.auditUnaryInterceptor, auth.UnaryServerInterceptor(ms.authorizeGrpc)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(ms.authorizeGrpc)),
+	)...)
 	pb.RegisterVastoMasterServer(grpcServer, ms)
 	grpcServer.Serve(listener)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
+	"time"
 )
 
 func (ms *masterServer) CompactCluster(ctx context.Context, req *pb.CompactClusterRequest) (resp *pb.CompactClusterResponse, err error) {
@@ -12,6 +13,7 @@ func (ms *masterServer) CompactCluster(ctx context.Context, req *pb.CompactClust
 	defer ms.unlock(req.Keyspace)
 
 	resp = &pb.CompactClusterResponse{}
+	defer observeTopologyOp("compact", time.Now(), &resp.Error)
 
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
@@ -33,7 +35,7 @@ func (ms *masterServer) CompactCluster(ctx context.Context, req *pb.CompactClust
 		servers = append(servers, server.GetStoreResource())
 	}
 
-	if err = compactShards(ctx, req, servers); err != nil {
+	if err = ms.compactShards(ctx, req, servers); err != nil {
 		resp.Error = err.Error()
 	}
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
 	"math"
+	"time"
 )
 
 func (ms *masterServer) CreateCluster(ctx context.Context, req *pb.CreateClusterRequest) (resp *pb.CreateClusterResponse, err error) {
@@ -13,6 +15,7 @@ func (ms *masterServer) CreateCluster(ctx context.Context, req *pb.CreateCluster
 	defer ms.unlock(req.Keyspace)
 
 	resp = &pb.CreateClusterResponse{}
+	defer observeTopologyOp("create", time.Now(), &resp.Error)
 
 	dc := ms.topo.dataCenter
 	if dc == nil {
@@ -28,6 +31,12 @@ func (ms *masterServer) CreateCluster(ctx context.Context, req *pb.CreateCluster
 		}
 	}
 
+	profile, err := storage.ResolveProfile(req.StorageProfile)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
 	servers, err := dc.allocateServers(int(req.ClusterSize), float64(req.TotalDiskSizeGb*req.ReplicationFactor),
 		func(resource *pb.StoreResource) bool {
 			return meetRequirement(resource.Tags, req.Tags)
@@ -54,7 +63,21 @@ func (ms *masterServer) CreateCluster(ctx context.Context, req *pb.CreateCluster
 
 	eachShardSizeGb := uint32(math.Ceil(float64(req.TotalDiskSizeGb) / float64(req.ClusterSize)))
 
-	if err = createShards(ctx, req.Keyspace, req.ClusterSize, req.ReplicationFactor, eachShardSizeGb, servers); err != nil {
+	// the requested disk size is enforced as a hard limit by the stores
+	quota := &pb.KeyspaceQuota{
+		Keyspace:        req.Keyspace,
+		TotalDiskSizeGb: req.TotalDiskSizeGb,
+	}
+	keyspaceStorage := &pb.KeyspaceStorage{
+		Keyspace: req.Keyspace,
+		Engine:   req.Engine,
+		Profile:  profile,
+	}
+	keyspace = ms.topo.keyspaces.getOrCreateKeyspace(req.Keyspace)
+	keyspace.setQuota(quota)
+	keyspace.setStorage(keyspaceStorage)
+
+	if err = ms.createShards(ctx, req.Keyspace, req.ClusterSize, req.ReplicationFactor, eachShardSizeGb, quota, keyspaceStorage, servers); err != nil {
 		resp.Error = err.Error()
 	}
 
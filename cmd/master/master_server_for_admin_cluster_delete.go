@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
+	"time"
 )
 
 func (ms *masterServer) DeleteCluster(ctx context.Context, req *pb.DeleteClusterRequest) (resp *pb.DeleteClusterResponse, err error) {
@@ -12,6 +13,7 @@ func (ms *masterServer) DeleteCluster(ctx context.Context, req *pb.DeleteCluster
 	defer ms.unlock(req.Keyspace)
 
 	resp = &pb.DeleteClusterResponse{}
+	defer observeTopologyOp("delete", time.Now(), &resp.Error)
 
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
@@ -34,8 +36,11 @@ func (ms *masterServer) DeleteCluster(ctx context.Context, req *pb.DeleteCluster
 		servers = append(servers, server.GetStoreResource())
 	}
 
-	if err = deleteShards(ctx, req, servers); err != nil {
+	if err = ms.deleteShards(ctx, req, servers); err != nil {
 		resp.Error = err.Error()
+	} else {
+		keyspace.setQuota(nil)
+		keyspace.setStorage(nil)
 	}
 
 	return resp, nil
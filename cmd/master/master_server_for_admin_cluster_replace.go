@@ -18,6 +18,7 @@ func (ms *masterServer) ReplaceNode(ctx context.Context, req *pb.ReplaceNodeRequ
 	defer ms.unlock(req.Keyspace)
 
 	resp = &pb.ReplaceNodeResponse{}
+	defer observeTopologyOp("replace", time.Now(), &resp.Error)
 
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
@@ -55,13 +56,13 @@ func (ms *masterServer) ReplaceNode(ctx context.Context, req *pb.ReplaceNodeRequ
 		AdminAddress: adminAddress,
 	}
 
-	if err = replicateNodePrepare(ctx, req, cluster, newStore, oldServer); err != nil {
+	if err = ms.replicateNodePrepare(ctx, req, cluster, keyspace.getQuota(), keyspace.getStorage(), newStore, oldServer); err != nil {
 		glog.Errorf("replicateNodePrepare %v: %v", req, err)
 		resp.Error = err.Error()
 		return
 	}
 
-	if err = replicateNodeCommit(ctx, req, cluster, newStore, oldServer); err != nil {
+	if err = ms.replicateNodeCommit(ctx, req, cluster, newStore, oldServer); err != nil {
 		glog.Errorf("replicateNodeCommit %v: %v", req, err)
 		resp.Error = err.Error()
 		return
@@ -73,7 +74,7 @@ func (ms *masterServer) ReplaceNode(ctx context.Context, req *pb.ReplaceNodeRequ
 		return
 	}
 
-	if err = replicateNodeCleanup(ctx, req, cluster, newStore, oldServer); err != nil {
+	if err = ms.replicateNodeCleanup(ctx, req, cluster, newStore, oldServer); err != nil {
 		glog.Errorf("replicateNodeCleanup %v: %v", req, err)
 		resp.Error = err.Error()
 		return
@@ -84,11 +85,11 @@ func (ms *masterServer) ReplaceNode(ctx context.Context, req *pb.ReplaceNodeRequ
 }
 
 // 1. create the new shard and follow the old shard and its peers
-func replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
+func (ms *masterServer) replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, quota *pb.KeyspaceQuota, keyspaceStorage *pb.KeyspaceStorage, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
 
 	glog.V(1).Infof("replicateNodePrepare %v", req)
 
-	return withConnection(newStore, func(grpcConnection *grpc.ClientConn) error {
+	return ms.withConnection(newStore, func(grpcConnection *grpc.ClientConn) error {
 
 		client := pb.NewVastoStoreClient(grpcConnection)
 		request := &pb.ReplicateNodePrepareRequest{
@@ -96,6 +97,8 @@ func replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, clust
 			ServerId:          req.NodeId,
 			ClusterSize:       uint32(cluster.ExpectedSize()),
 			ReplicationFactor: uint32(cluster.ReplicationFactor()),
+			Quota:             quota,
+			Storage:           keyspaceStorage,
 		}
 
 		glog.V(1).Infof("prepare replicate keyspace %s from %s to %v: %v", req.Keyspace, oldServer.GetAddress(), newStore.Address, request)
@@ -111,11 +114,11 @@ func replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, clust
 }
 
 // 2. let the server to promote the new shard from CANDIDATE to READY
-func replicateNodeCommit(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
+func (ms *masterServer) replicateNodeCommit(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
 
 	glog.V(1).Infof("replicateNodeCommit %v", req)
 
-	return withConnection(newStore, func(grpcConnection *grpc.ClientConn) error {
+	return ms.withConnection(newStore, func(grpcConnection *grpc.ClientConn) error {
 
 		request := &pb.ReplicateNodeCommitRequest{
 			Keyspace: req.Keyspace,
@@ -182,11 +185,11 @@ func (ms *masterServer) adjustAndBroadcastShardStatus(ctx context.Context, req *
 }
 
 // 4. let the server to remove the old shard
-func replicateNodeCleanup(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
+func (ms *masterServer) replicateNodeCleanup(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
 
 	glog.V(1).Infof("replicateNodeCleanup %v", req)
 
-	return withConnection(oldServer, func(grpcConnection *grpc.ClientConn) error {
+	return ms.withConnection(oldServer, func(grpcConnection *grpc.ClientConn) error {
 
 		request := &pb.ReplicateNodeCleanupRequest{
 			Keyspace: req.Keyspace,
@@ -6,6 +6,8 @@ import (
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/topology"
+	"github.com/chrislusf/vasto/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"time"
 )
@@ -16,6 +18,17 @@ func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest
 	defer ms.unlock(req.Keyspace)
 
 	resp = &pb.ResizeResponse{}
+	defer observeTopologyOp("resize", time.Now(), &resp.Error)
+
+	ctx, span := tracing.Start(ctx, "vasto.master.resize",
+		attribute.String("keyspace", req.Keyspace),
+		attribute.Int("target_cluster_size", int(req.TargetClusterSize)),
+	)
+	defer func() {
+		tracing.End(span, tracing.StatusError(resp.Error))
+	}()
+	// the stores continue the trace
+	ctx = tracing.OutgoingGrpcContext(ctx)
 
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
@@ -68,14 +81,14 @@ func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest
 
 	// 2. create missing shards on existing servers, create new shards on new servers
 	servers := append(existingServers, newServers...)
-	if err = resizeCreateShards(ctx, req.Keyspace, uint32(cluster.ExpectedSize()), req.TargetClusterSize, uint32(cluster.ReplicationFactor()), servers); err != nil {
+	if err = ms.resizeCreateShards(ctx, req.Keyspace, uint32(cluster.ExpectedSize()), req.TargetClusterSize, uint32(cluster.ReplicationFactor()), keyspace.getQuota(), keyspace.getStorage(), servers); err != nil {
 		glog.Errorf("resizeCreateShards %v: %v", req, err)
 		resp.Error = err.Error()
 		return
 	}
 
 	// 3. tell all servers to commit the new shards, adjust local cluster size, status, etc, not informing the master of shard info changes
-	if err = resizeCommit(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
+	if err = ms.resizeCommit(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
 		resp.Error = err.Error()
 		return
 	}
@@ -87,7 +100,7 @@ func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest
 	}
 
 	// 3. cleanup old shards
-	if err = resizeCleanup(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
+	if err = ms.resizeCleanup(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
 		glog.Errorf("resizeCleanup %v: %v", req, err)
 		resp.Error = err.Error()
 		return
@@ -117,11 +130,11 @@ func allocateServers(cluster *topology.Cluster, dc *dataCenter, serverCount int,
 	return servers, err
 }
 
-func resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targetClusterSize, replicationFactor uint32, stores []*pb.StoreResource) error {
+func (ms *masterServer) resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targetClusterSize, replicationFactor uint32, quota *pb.KeyspaceQuota, keyspaceStorage *pb.KeyspaceStorage, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
-		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
 
 			client := pb.NewVastoStoreClient(grpcConnection)
 			request := &pb.ResizeCreateShardRequest{
@@ -130,6 +143,8 @@ func resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targe
 				ClusterSize:       clusterSize,
 				ReplicationFactor: replicationFactor,
 				TargetClusterSize: targetClusterSize,
+				Quota:             quota,
+				Storage:           keyspaceStorage,
 			}
 
 			glog.V(1).Infof("resize create shard on %v: %v", store.AdminAddress, request)
@@ -145,11 +160,11 @@ func resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targe
 	})
 }
 
-func resizeCommit(ctx context.Context, keyspace string, clusterSize uint32, stores []*pb.StoreResource) error {
+func (ms *masterServer) resizeCommit(ctx context.Context, keyspace string, clusterSize uint32, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
-		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
 
 			client := pb.NewVastoStoreClient(grpcConnection)
 			request := &pb.ResizeCommitRequest{
@@ -242,11 +257,11 @@ func (ms *masterServer) adjustAndBroadcastUpcomingShardStatuses(ctx context.Cont
 	return nil
 }
 
-func resizeCleanup(ctx context.Context, keyspace string, clusterSize uint32, stores []*pb.StoreResource) error {
+func (ms *masterServer) resizeCleanup(ctx context.Context, keyspace string, clusterSize uint32, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
-		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
 
 			client := pb.NewVastoStoreClient(grpcConnection)
 			request := &pb.ResizeCleanupRequest{
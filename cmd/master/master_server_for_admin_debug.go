@@ -9,7 +9,7 @@ func (ms *masterServer) DebugMaster(ctx context.Context, req *pb.Empty) (*pb.Emp
 
 	resp := &pb.Empty{}
 
-	ms.topo.Debug()
+	ms.topo.Debug(ms.withConnection)
 
 	return resp, nil
 }
@@ -17,8 +17,11 @@ func (ms *masterServer) Describe(ctx context.Context, req *pb.DescribeRequest) (
 			cluster := keyspace.cluster
 			if cluster != nil {
 				resp.DescCluster = &pb.DescribeResponse_DescCluster{
-					Cluster:     cluster.ToCluster(),
-					ClientCount: uint32(ms.clientsStat.getKeyspaceClientCount(keyspace.name)),
+					Cluster:         cluster.ToCluster(),
+					ClientCount:     uint32(ms.clientsStat.getKeyspaceClientCount(keyspace.name)),
+					ReplicationLags: ms.replicationLags.getKeyspaceLags(req.DescCluster.Keyspace),
+					Quota:           keyspace.getQuota(),
+					Storage:         keyspace.getStorage(),
 				}
 				if cluster.GetNextCluster() != nil {
 					resp.DescCluster.NextCluster = cluster.GetNextCluster().ToCluster()
@@ -42,6 +45,12 @@ func (ms *masterServer) Describe(ctx context.Context, req *pb.DescribeRequest) (
 		}
 	}
 
+	if req.GetDescAuditLog() != nil {
+		resp.DescAuditLog = &pb.DescribeResponse_DescAuditLog{
+			Records: ms.auditLog.query(req.DescAuditLog.Keyspace, int(req.DescAuditLog.Limit)),
+		}
+	}
+
 	if req.GetDescKeyspaces() != nil {
 		resp.DescKeyspaces = &pb.DescribeResponse_DescKeyspaces{}
 		ms.topo.keyspaces.RLock()
@@ -0,0 +1,93 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"google.golang.org/grpc"
+)
+
+// SetKeyspaceQuota changes the quota of the keyspace, and pushes it to all the stores of the cluster.
+func (ms *masterServer) SetKeyspaceQuota(ctx context.Context, req *pb.SetKeyspaceQuotaRequest) (resp *pb.SetKeyspaceQuotaResponse, err error) {
+
+	keyspaceName := req.Quota.GetKeyspace()
+
+	ms.lock(keyspaceName)
+	defer ms.unlock(keyspaceName)
+
+	resp = &pb.SetKeyspaceQuotaResponse{}
+	defer observeTopologyOp("quota", time.Now(), &resp.Error)
+
+	keyspace, found := ms.topo.keyspaces.getKeyspace(keyspaceName)
+	if !found {
+		resp.Error = fmt.Sprintf("no keyspace %v found", keyspaceName)
+		return
+	}
+
+	cluster := keyspace.cluster
+	if cluster == nil {
+		resp.Error = fmt.Sprintf("no cluster for %v found", keyspaceName)
+		return
+	}
+
+	var servers []*pb.StoreResource
+	for i := 0; i < cluster.ExpectedSize(); i++ {
+		if server, found := cluster.GetNode(i, 0); found {
+			servers = append(servers, server.GetStoreResource())
+		}
+	}
+
+	keyspace.setQuota(req.Quota)
+
+	if err = ms.setStoreQuotas(ctx, req, servers); err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
+
+func (ms *masterServer) setStoreQuotas(ctx context.Context, req *pb.SetKeyspaceQuotaRequest, stores []*pb.StoreResource) error {
+
+	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+
+			glog.V(1).Infof("set keyspace quota on %v: %v", store.AdminAddress, req)
+			resp, err := pb.NewVastoStoreClient(grpcConnection).SetKeyspaceQuota(ctx, req)
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("set keyspace quota on %s: %s", store.AdminAddress, resp.Error)
+			}
+			return nil
+		})
+	})
+}
+
+func (k *keyspace) setQuota(quota *pb.KeyspaceQuota) {
+	k.quotaLock.Lock()
+	k.quota = quota
+	k.quotaLock.Unlock()
+}
+
+func (k *keyspace) getQuota() *pb.KeyspaceQuota {
+	k.quotaLock.RLock()
+	defer k.quotaLock.RUnlock()
+	return k.quota
+}
+
+// restoreKeyspaceQuotas adopts the quotas reported by a store, if this master does not know them yet,
+// e.g., after the master restarts.
+func (ms *masterServer) restoreKeyspaceQuotas(quotas []*pb.KeyspaceQuota) {
+	for _, quota := range quotas {
+		keyspace := ms.topo.keyspaces.getOrCreateKeyspace(quota.Keyspace)
+		keyspace.quotaLock.Lock()
+		if keyspace.quota == nil {
+			keyspace.quota = quota
+		}
+		keyspace.quotaLock.Unlock()
+	}
+}
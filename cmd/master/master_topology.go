@@ -28,8 +28,13 @@ type dataCenter struct {
 }
 
 type keyspace struct {
-	name    keyspaceName
-	cluster *topology.Cluster
+	name      keyspaceName
+	cluster   *topology.Cluster
+	quota     *pb.KeyspaceQuota
+	quotaLock sync.RWMutex
+	// storage is set when the keyspace is created, and is nil for keyspaces created before it is tracked
+	storage     *pb.KeyspaceStorage
+	storageLock sync.RWMutex
 }
 
 type keyspaces struct {
@@ -59,11 +59,11 @@ func meetRequirement(existingTags, requiredTags []string) bool {
 	return true
 }
 
-func createShards(ctx context.Context, keyspace string, clusterSize, replicationFactor, eachShardSizeGb uint32, stores []*pb.StoreResource) error {
+func (ms *masterServer) createShards(ctx context.Context, keyspace string, clusterSize, replicationFactor, eachShardSizeGb uint32, quota *pb.KeyspaceQuota, keyspaceStorage *pb.KeyspaceStorage, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
-		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
 
 			client := pb.NewVastoStoreClient(grpcConnection)
 			request := &pb.CreateShardRequest{
@@ -72,6 +72,8 @@ func createShards(ctx context.Context, keyspace string, clusterSize, replication
 				ClusterSize:       clusterSize,
 				ReplicationFactor: replicationFactor,
 				ShardDiskSizeGb:   eachShardSizeGb,
+				Quota:             quota,
+				Storage:           keyspaceStorage,
 			}
 
 			glog.V(1).Infof("create shard on %v: %v", store.AdminAddress, request)
@@ -87,11 +89,11 @@ func createShards(ctx context.Context, keyspace string, clusterSize, replication
 	})
 }
 
-func deleteShards(ctx context.Context, req *pb.DeleteClusterRequest, stores []*pb.StoreResource) error {
+func (ms *masterServer) deleteShards(ctx context.Context, req *pb.DeleteClusterRequest, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
-		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
 
 			client := pb.NewVastoStoreClient(grpcConnection)
 			request := &pb.DeleteKeyspaceRequest{
@@ -112,11 +114,11 @@ func deleteShards(ctx context.Context, req *pb.DeleteClusterRequest, stores []*p
 
 }
 
-func compactShards(ctx context.Context, req *pb.CompactClusterRequest, stores []*pb.StoreResource) error {
+func (ms *masterServer) compactShards(ctx context.Context, req *pb.CompactClusterRequest, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
-		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+		return ms.withConnection(store, func(grpcConnection *grpc.ClientConn) error {
 
 			client := pb.NewVastoStoreClient(grpcConnection)
 			request := &pb.CompactKeyspaceRequest{
@@ -137,9 +139,9 @@ func compactShards(ctx context.Context, req *pb.CompactClusterRequest, stores []
 
 }
 
-func withConnection(store *pb.StoreResource, fn func(*grpc.ClientConn) error) error {
+func (ms *masterServer) withConnection(store *pb.StoreResource, fn func(*grpc.ClientConn) error) error {
 
-	grpcConnection, err := grpc.Dial(store.GetAdminAddress(), grpc.WithInsecure())
+	grpcConnection, err := grpc.Dial(store.GetAdminAddress(), ms.storeTransport, grpc.WithPerRPCCredentials(ms.storeCredentials))
 	if err != nil {
 		return fmt.Errorf("fail to dial %s: %v", store.GetAdminAddress(), err)
 	}
@@ -12,7 +12,7 @@ func (k *keyspace) debug(prefix string) {
 	return
 }
 
-func (dc *dataCenter) debug(prefix string) {
+func (dc *dataCenter) debug(prefix string, withConnect func(*pb.StoreResource, func(*grpc.ClientConn) error) error) {
 	dc.RLock()
 	for serverAddress, storeResource := range dc.servers {
 		fmt.Printf("%s  address: %v\n", prefix, serverAddress)
@@ -27,7 +27,8 @@ func (dc *dataCenter) debug(prefix string) {
 	return
 }
 
-func (topo *masterTopology) Debug() {
+// Debug prints the topology, and asks the stores to print their shards.
+func (topo *masterTopology) Debug(withConnect func(*pb.StoreResource, func(*grpc.ClientConn) error) error) {
 
 	topo.keyspaces.RLock()
 	for keyspaceName, keyspace := range topo.keyspaces.keyspaces {
@@ -36,16 +37,5 @@ func (topo *masterTopology) Debug() {
 	}
 	topo.keyspaces.RUnlock()
 
-	topo.dataCenter.debug(" ")
-}
-
-func withConnect(node *pb.StoreResource, fn func(*grpc.ClientConn) error) error {
-
-	grpcConnection, err := grpc.Dial(node.AdminAddress, grpc.WithInsecure())
-	if err != nil {
-		return fmt.Errorf("fail to dial %s: %v", node.AdminAddress, err)
-	}
-	defer grpcConnection.Close()
-
-	return fn(grpcConnection)
+	topo.dataCenter.debug(" ", withConnect)
 }
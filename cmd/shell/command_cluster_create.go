@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 
 	"github.com/chrislusf/vasto/goclient/vs"
 	"github.com/chrislusf/vasto/pb"
@@ -21,15 +22,26 @@ func (c *commandCreateKeyspace) Name() string {
 }
 
 func (c *commandCreateKeyspace) Help() string {
-	return "<cluster_name> <server count> <replication factor>"
+	return `<cluster_name> <server count> <replication factor> [<option>=<value> ...]
+	options:
+		engine=<rocksdb|leveldb|memory>
+		profile=<point-lookup|scan-heavy|write-heavy>
+		blockCacheMb=<n> bloomBits=<n> writeBufferMb=<n>
+		compression=<level0,level1,...> of none,snappy,zlib,bz2,lz4,lz4hc,zstd
+		directIo=true disableWal=true`
 }
 
 func (c *commandCreateKeyspace) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
-	if len(args) != 3 {
+	if len(args) < 3 {
 		return errInvalidArguments
 	}
 
+	options, err := parseCreateClusterOptions(args[3:])
+	if err != nil {
+		return err
+	}
+
 	keyspace := args[0]
 	serverCountString := args[1]
 	replicationString := args[2]
@@ -55,7 +67,7 @@ func (c *commandCreateKeyspace) Do(vastoClient *vs.VastoClient, args []string, c
 		return errInvalidArguments
 	}
 
-	cluster, err := vastoClient.CreateCluster(keyspace, int(clusterSize), int(replicationFactor))
+	cluster, err := vastoClient.CreateCluster(keyspace, int(clusterSize), int(replicationFactor), options...)
 
 	if err != nil {
 		return fmt.Errorf("create cluster request: %v", err)
@@ -66,14 +78,89 @@ func (c *commandCreateKeyspace) Do(vastoClient *vs.VastoClient, args []string, c
 	return nil
 }
 
+func parseCreateClusterOptions(args []string) (options []vs.CreateClusterOption, err error) {
+
+	var profile *pb.StorageProfile
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("option %s is not in <option>=<value> format", arg)
+		}
+		name, value := parts[0], parts[1]
+		if name == "engine" {
+			options = append(options, vs.WithEngine(value))
+			continue
+		}
+
+		if profile == nil {
+			profile = &pb.StorageProfile{}
+			options = append(options, vs.WithStorageProfile(profile))
+		}
+		var n uint64
+		switch name {
+		case "profile":
+			profile.Preset = value
+		case "blockCacheMb":
+			n, err = strconv.ParseUint(value, 10, 32)
+			profile.BlockCacheSizeMb = uint32(n)
+		case "bloomBits":
+			n, err = strconv.ParseUint(value, 10, 32)
+			profile.BloomFilterBits = uint32(n)
+		case "writeBufferMb":
+			n, err = strconv.ParseUint(value, 10, 32)
+			profile.WriteBufferSizeMb = uint32(n)
+		case "compression":
+			for _, c := range strings.Split(value, ",") {
+				compression, found := pb.StorageProfile_Compression_value[strings.ToUpper(c)]
+				if !found {
+					return nil, fmt.Errorf("unknown compression %s", c)
+				}
+				profile.CompressionPerLevel = append(profile.CompressionPerLevel, pb.StorageProfile_Compression(compression))
+			}
+		case "directIo":
+			profile.UseDirectIo, err = strconv.ParseBool(value)
+		case "disableWal":
+			profile.DisableWal, err = strconv.ParseBool(value)
+		default:
+			return nil, fmt.Errorf("unknown option %s", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("option %s: %v", arg, err)
+		}
+	}
+
+	return options, nil
+}
+
+func printStorage(out io.Writer, keyspaceStorage *pb.KeyspaceStorage) {
+	if keyspaceStorage == nil {
+		return
+	}
+	if keyspaceStorage.Engine != "" {
+		fmt.Fprintf(out, "Storage Engine: %s\n", keyspaceStorage.Engine)
+	}
+	if p := keyspaceStorage.Profile; p != nil {
+		var compressions []string
+		for _, c := range p.CompressionPerLevel {
+			compressions = append(compressions, strings.ToLower(c.String()))
+		}
+		fmt.Fprintf(out, "Storage Profile: %s blockCacheMb:%d bloomBits:%d writeBufferMb:%d compression:%s directIo:%v disableWal:%v\n",
+			p.Preset, p.BlockCacheSizeMb, p.BloomFilterBits, p.WriteBufferSizeMb, strings.Join(compressions, ","), p.UseDirectIo, p.DisableWal)
+	}
+}
+
 func printCluster(out io.Writer, cluster *pb.Cluster) {
 	if cluster != nil {
 		fmt.Fprintf(out, "Cluster Expected Size: %d\n", cluster.ExpectedClusterSize)
 		fmt.Fprintf(out, "Cluster Current  Size: %d\n", cluster.CurrentClusterSize)
 
 		for _, node := range cluster.Nodes {
-			fmt.Fprintf(out, "        * shard %v server %v %v\n",
-				node.ShardInfo.ShardId, node.ShardInfo.ServerId, node.StoreResource.Address)
+			stale := ""
+			if node.ShardInfo.IsStale {
+				stale = " stale"
+			}
+			fmt.Fprintf(out, "        * shard %v server %v %v%s\n",
+				node.ShardInfo.ShardId, node.ShardInfo.ServerId, node.StoreResource.Address, stale)
 		}
 
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
+	"time"
 
 	"github.com/chrislusf/vasto/goclient/vs"
 	"github.com/chrislusf/vasto/pb"
@@ -97,6 +99,9 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 
 		fmt.Fprintf(out, "Cluster Client Count : %d\n", descResponse.DescCluster.ClientCount)
 		printCluster(out, descResponse.DescCluster.GetCluster())
+		printQuota(out, descResponse.DescCluster.Quota)
+		printStorage(out, descResponse.DescCluster.Storage)
+		printReplicationLags(out, descResponse.DescCluster.ReplicationLags)
 		if descResponse.DescCluster.GetNextCluster() != nil {
 			nextCluster := descResponse.DescCluster.GetNextCluster()
 			fmt.Fprintf(out, "=> Cluster Size: %d\n", nextCluster.ExpectedClusterSize)
@@ -115,3 +120,23 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 
 	return nil
 }
+
+func printReplicationLags(out io.Writer, lags []*pb.ReplicationLag) {
+	if len(lags) == 0 {
+		return
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].ShardId != lags[j].ShardId {
+			return lags[i].ShardId < lags[j].ShardId
+		}
+		if lags[i].ServerId != lags[j].ServerId {
+			return lags[i].ServerId < lags[j].ServerId
+		}
+		return lags[i].SourceServerId < lags[j].SourceServerId
+	})
+	fmt.Fprintf(out, "Replication Lag:\n")
+	for _, lag := range lags {
+		fmt.Fprintf(out, "        * shard %v server %v <= shard %v server %v: %d entries, %d bytes, %v\n",
+			lag.ShardId, lag.ServerId, lag.SourceShardId, lag.SourceServerId, lag.Entries, lag.Bytes, time.Duration(lag.TimeNs))
+	}
+}
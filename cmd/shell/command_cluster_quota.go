@@ -0,0 +1,106 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func init() {
+	commands = append(commands, &commandClusterQuota{})
+}
+
+type commandClusterQuota struct {
+}
+
+func (c *commandClusterQuota) Name() string {
+	return "cluster.quota"
+}
+
+func (c *commandClusterQuota) Help() string {
+	return "<keyspace> [ops=<ops_per_second>] [bytes=<bytes_per_second>] [disk=<total_disk_size_gb>], 0 means unlimited"
+}
+
+func (c *commandClusterQuota) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, out io.Writer) error {
+
+	if len(args) < 1 {
+		return errInvalidArguments
+	}
+	keyspace := args[0]
+
+	descResponse, err := vastoClient.MasterClient.Describe(
+		context.Background(),
+		&pb.DescribeRequest{
+			DescCluster: &pb.DescribeRequest_DescCluster{
+				Keyspace: keyspace,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if descResponse.DescCluster == nil {
+		return fmt.Errorf("no cluster keyspace(%v) found", keyspace)
+	}
+
+	// only the given limits are changed
+	quota := &pb.KeyspaceQuota{Keyspace: keyspace}
+	if current := descResponse.DescCluster.Quota; current != nil {
+		quota = proto.Clone(current).(*pb.KeyspaceQuota)
+	}
+
+	if len(args) == 1 {
+		printQuota(out, quota)
+		return nil
+	}
+
+	for _, arg := range args[1:] {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return errInvalidArguments
+		}
+		value, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return errInvalidArguments
+		}
+		switch parts[0] {
+		case "ops":
+			quota.OpsPerSecond = value
+		case "bytes":
+			quota.BytesPerSecond = value
+		case "disk":
+			quota.TotalDiskSizeGb = uint32(value)
+		default:
+			return errInvalidArguments
+		}
+	}
+
+	if err = vastoClient.SetKeyspaceQuota(quota); err != nil {
+		return err
+	}
+
+	printQuota(out, quota)
+
+	return nil
+}
+
+func printQuota(out io.Writer, quota *pb.KeyspaceQuota) {
+	if quota == nil {
+		return
+	}
+	fmt.Fprintf(out, "Quota: ops/sec:%s bytes/sec:%s disk:%s\n",
+		quotaLimit(quota.OpsPerSecond, ""), quotaLimit(quota.BytesPerSecond, ""), quotaLimit(uint64(quota.TotalDiskSizeGb), " GB"))
+}
+
+func quotaLimit(limit uint64, unit string) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d%s", limit, unit)
+}
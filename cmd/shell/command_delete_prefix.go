@@ -0,0 +1,33 @@
+package shell
+
+import (
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandDeletePrefix{})
+}
+
+type commandDeletePrefix struct {
+}
+
+func (c *commandDeletePrefix) Name() string {
+	return "delprefix"
+}
+
+func (c *commandDeletePrefix) Help() string {
+	return "<prefix>, deletes all keys with the prefix"
+}
+
+func (c *commandDeletePrefix) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) != 1 {
+		return errInvalidArguments
+	}
+
+	return commandEnv.clusterClient.DeletePrefix([]byte(args[0]))
+}
@@ -103,7 +103,7 @@ func (c *commandDump) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 				}
 			}
 
-		})
+		}, vastoClient.ClusterListener.DialOptions()...)
 
 	}
 
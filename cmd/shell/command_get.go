@@ -3,9 +3,13 @@ package shell
 import (
 	"fmt"
 	"io"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/chrislusf/vasto/goclient/vs"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
 	"github.com/chrislusf/vasto/util"
 )
 
@@ -36,12 +40,7 @@ func (c *commandGet) Do(vastoClient *vs.VastoClient, args []string, commandEnv *
 		value, dt, err := commandEnv.clusterClient.Get(key)
 
 		if err == nil {
-			switch dt {
-			case pb.OpAndDataType_FLOAT64:
-				fmt.Fprintf(writer, "%f\n", util.BytesToFloat64(value))
-			default:
-				fmt.Fprintf(writer, "%s\n", string(value))
-			}
+			fmt.Fprintf(writer, "%s\n", formatValue(value, dt))
 		}
 
 		return err
@@ -59,7 +58,52 @@ func (c *commandGet) Do(vastoClient *vs.VastoClient, args []string, commandEnv *
 		if keyValue == nil {
 			continue
 		}
-		fmt.Fprintf(writer, "%s : %s\n", string(keyValue.GetKey()), string(keyValue.GetValue()))
+		fmt.Fprintf(writer, "%s : %s\n", string(keyValue.GetKey()), formatValue(keyValue.GetValue(), keyValue.GetValueType()))
 	}
 	return nil
 }
+
+// formatValue prints the numbers by the data type, and other values as strings
+func formatValue(value []byte, dataType pb.OpAndDataType) string {
+	if len(value) == 8 {
+		switch dataType {
+		case pb.OpAndDataType_FLOAT64, pb.OpAndDataType_MAX_FLOAT64, pb.OpAndDataType_MIN_FLOAT64:
+			return fmt.Sprintf("%f", util.BytesToFloat64(value))
+		case pb.OpAndDataType_INT64, pb.OpAndDataType_MAX_INT64, pb.OpAndDataType_MIN_INT64:
+			return fmt.Sprintf("%d", util.BytesToInt64(value))
+		}
+	}
+	switch dataType {
+	case pb.OpAndDataType_SET:
+		if members, err := codec.DecodeSetMembers(value); err == nil {
+			var list []string
+			for _, member := range members {
+				list = append(list, string(member))
+			}
+			return fmt.Sprintf("{%s}", strings.Join(list, ", "))
+		}
+	case pb.OpAndDataType_SORTED_SET:
+		if members, err := codec.DecodeSortedSetMembers(value, math.Inf(-1), math.Inf(1)); err == nil {
+			var list []string
+			for _, member := range members {
+				list = append(list, fmt.Sprintf("%s:%g", member.Member, member.Score))
+			}
+			return fmt.Sprintf("{%s}", strings.Join(list, ", "))
+		}
+	case pb.OpAndDataType_HLL:
+		if count, err := codec.HllCount(value); err == nil {
+			return fmt.Sprintf("hll ~%d distinct items", count)
+		}
+	case pb.OpAndDataType_LIST:
+		if elements, err := codec.DecodeListRange(value, 0, -1, time.Now()); err == nil {
+			var list []string
+			for _, element := range elements {
+				list = append(list, string(element))
+			}
+			return fmt.Sprintf("[%s]", strings.Join(list, ", "))
+		}
+	case pb.OpAndDataType_BLOOM:
+		return fmt.Sprintf("bloom filter %d bytes", len(value))
+	}
+	return string(value)
+}
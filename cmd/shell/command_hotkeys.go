@@ -0,0 +1,110 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	commands = append(commands, &commandHotkeys{})
+}
+
+type commandHotkeys struct {
+}
+
+func (c *commandHotkeys) Name() string {
+	return "hotkeys"
+}
+
+func (c *commandHotkeys) Help() string {
+	return "<keyspace> [limit]"
+}
+
+func (c *commandHotkeys) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, out io.Writer) error {
+
+	if len(args) < 1 {
+		return errInvalidArguments
+	}
+	keyspace := args[0]
+	limit := uint64(10)
+	if len(args) > 1 {
+		var err error
+		if limit, err = strconv.ParseUint(args[1], 10, 32); err != nil {
+			return err
+		}
+	}
+
+	descResponse, err := vastoClient.MasterClient.Describe(
+		context.Background(),
+		&pb.DescribeRequest{
+			DescCluster: &pb.DescribeRequest_DescCluster{
+				Keyspace: keyspace,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if descResponse.DescCluster == nil {
+		return fmt.Errorf("no cluster keyspace(%v) found", keyspace)
+	}
+
+	// each store reports all its local shards of the keyspace
+	seenStores := make(map[string]bool)
+	for _, node := range descResponse.DescCluster.GetCluster().GetNodes() {
+		address := node.StoreResource.AdminAddress
+		if seenStores[address] {
+			continue
+		}
+		seenStores[address] = true
+
+		err := topology.VastoNodes{node}.WithConnection("hotkeys", 0, func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
+
+			resp, err := pb.NewVastoStoreClient(grpcConnection).Hotspots(context.Background(), &pb.HotspotsRequest{
+				Keyspace: keyspace,
+				Limit:    uint32(limit),
+			})
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("%s", resp.Error)
+			}
+
+			printHotspots(out, node.StoreResource.Address, resp)
+			return nil
+		}, vastoClient.ClusterListener.DialOptions()...)
+		if err != nil {
+			fmt.Fprintf(out, "store %s: %v\n", node.StoreResource.Address, err)
+		}
+	}
+
+	return nil
+}
+
+func printHotspots(out io.Writer, address string, resp *pb.HotspotsResponse) {
+	fmt.Fprintf(out, "store %s\n", address)
+	for _, shard := range resp.Shards {
+		fmt.Fprintf(out, "    shard %d hot keys:\n", shard.ShardId)
+		for _, hotKey := range shard.HotKeys {
+			fmt.Fprintf(out, "        %8d ±%-6d %s\n", hotKey.Count, hotKey.Error, string(hotKey.Key))
+		}
+	}
+	if len(resp.SlowOps) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "    slow ops:\n")
+	for _, op := range resp.SlowOps {
+		fmt.Fprintf(out, "        %s shard %d %-6s %10v %8d bytes %s\n",
+			time.Unix(0, int64(op.StartedAtNs)).Format("2006-01-02 15:04:05.000"),
+			op.ShardId, op.Op, time.Duration(op.LatencyNs), op.ValueSize, string(op.KeyPrefix))
+	}
+}
@@ -0,0 +1,48 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandIncr{})
+}
+
+type commandIncr struct {
+}
+
+func (c *commandIncr) Name() string {
+	return "incr"
+}
+
+func (c *commandIncr) Help() string {
+	return "<key> [delta], adds the int64 delta, 1 by default, and prints the new value"
+}
+
+func (c *commandIncr) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) != 1 && len(args) != 2 {
+		return errInvalidArguments
+	}
+
+	delta := int64(1)
+	if len(args) == 2 {
+		var err error
+		if delta, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+			return err
+		}
+	}
+
+	value, err := commandEnv.clusterClient.IncrBy(vs.Key([]byte(args[0])), delta)
+	if err == nil {
+		fmt.Fprintf(writer, "%d\n", value)
+	}
+
+	return err
+}
@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandLogin{})
+}
+
+type commandLogin struct {
+}
+
+func (c *commandLogin) Name() string {
+	return "login"
+}
+
+func (c *commandLogin) Help() string {
+	return "[<token>], prompts for the token if not given"
+}
+
+func (c *commandLogin) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, out io.Writer) error {
+
+	var token string
+	if len(args) > 0 {
+		token = args[0]
+	} else {
+		var err error
+		if token, err = line.PasswordPrompt("token: "); err != nil {
+			return err
+		}
+	}
+
+	resp, err := vastoClient.Login(token)
+	if err != nil {
+		return err
+	}
+
+	if resp.User == "" {
+		fmt.Fprintf(out, "access control is not enabled on the master\n")
+		return nil
+	}
+
+	fmt.Fprintf(out, "logged in as %s\n", resp.User)
+	for _, grant := range resp.Grants {
+		fmt.Fprintf(out, "    keyspace %s read:%v write:%v admin:%v\n", grant.Keyspace, grant.Read, grant.Write, grant.Admin)
+	}
+
+	return nil
+}
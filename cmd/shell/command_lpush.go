@@ -0,0 +1,38 @@
+package shell
+
+import (
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandLPush{})
+}
+
+type commandLPush struct {
+}
+
+func (c *commandLPush) Name() string {
+	return "lpush"
+}
+
+func (c *commandLPush) Help() string {
+	return "<key> <element> [element...], pushes the elements to the head of the list of the key"
+}
+
+func (c *commandLPush) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) < 2 {
+		return errInvalidArguments
+	}
+
+	var elements [][]byte
+	for _, element := range args[1:] {
+		elements = append(elements, []byte(element))
+	}
+
+	return commandEnv.clusterClient.LPush(vs.Key([]byte(args[0])), elements...)
+}
@@ -0,0 +1,56 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandLRange{})
+}
+
+type commandLRange struct {
+}
+
+func (c *commandLRange) Name() string {
+	return "lrange"
+}
+
+func (c *commandLRange) Help() string {
+	return "<key> [start] [stop], prints the elements of the list of the key, newest first, all by default"
+}
+
+func (c *commandLRange) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) < 1 || len(args) > 3 {
+		return errInvalidArguments
+	}
+
+	start, stop := int64(0), int64(-1)
+	var err error
+	if len(args) > 1 {
+		if start, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+			return err
+		}
+	}
+	if len(args) > 2 {
+		if stop, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+			return err
+		}
+	}
+
+	elements, err := commandEnv.clusterClient.LRange(vs.Key([]byte(args[0])), start, stop)
+	if err != nil {
+		return err
+	}
+	for _, element := range elements {
+		fmt.Fprintf(writer, "%s\n", string(element))
+	}
+
+	return nil
+}
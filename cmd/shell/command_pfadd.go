@@ -0,0 +1,38 @@
+package shell
+
+import (
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandPFAdd{})
+}
+
+type commandPFAdd struct {
+}
+
+func (c *commandPFAdd) Name() string {
+	return "pfadd"
+}
+
+func (c *commandPFAdd) Help() string {
+	return "<key> <item> [item...], adds the items to the HyperLogLog of the key"
+}
+
+func (c *commandPFAdd) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) < 2 {
+		return errInvalidArguments
+	}
+
+	var items [][]byte
+	for _, item := range args[1:] {
+		items = append(items, []byte(item))
+	}
+
+	return commandEnv.clusterClient.PFAdd(vs.Key([]byte(args[0])), items...)
+}
@@ -0,0 +1,44 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandPFCount{})
+}
+
+type commandPFCount struct {
+}
+
+func (c *commandPFCount) Name() string {
+	return "pfcount"
+}
+
+func (c *commandPFCount) Help() string {
+	return "<key> [key...], estimates the number of distinct items in the HyperLogLogs of the keys"
+}
+
+func (c *commandPFCount) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) < 1 {
+		return errInvalidArguments
+	}
+
+	var keys []*vs.KeyObject
+	for _, key := range args {
+		keys = append(keys, vs.Key([]byte(key)))
+	}
+
+	count, err := commandEnv.clusterClient.PFCount(keys...)
+	if err == nil {
+		fmt.Fprintf(writer, "%d\n", count)
+	}
+
+	return err
+}
@@ -49,7 +49,7 @@ func (c *commandPrefix) Do(vastoClient *vs.VastoClient, args []string, commandEn
 		return err
 	}
 	for _, keyValue := range keyValues {
-		fmt.Fprintf(writer, "%s : %s\n", string(keyValue.GetKey()), string(keyValue.GetValue()))
+		fmt.Fprintf(writer, "%s : %s\n", string(keyValue.GetKey()), formatValue(keyValue.GetValue(), keyValue.GetValueType()))
 	}
 	return nil
 }
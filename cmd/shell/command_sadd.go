@@ -0,0 +1,38 @@
+package shell
+
+import (
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandSAdd{})
+}
+
+type commandSAdd struct {
+}
+
+func (c *commandSAdd) Name() string {
+	return "sadd"
+}
+
+func (c *commandSAdd) Help() string {
+	return "<key> <member> [member...], adds the members to the set of the key"
+}
+
+func (c *commandSAdd) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) < 2 {
+		return errInvalidArguments
+	}
+
+	var members [][]byte
+	for _, member := range args[1:] {
+		members = append(members, []byte(member))
+	}
+
+	return commandEnv.clusterClient.SAdd(vs.Key([]byte(args[0])), members...)
+}
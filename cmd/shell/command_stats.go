@@ -0,0 +1,122 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	commands = append(commands, &commandStats{})
+}
+
+type commandStats struct {
+}
+
+func (c *commandStats) Name() string {
+	return "stats"
+}
+
+func (c *commandStats) Help() string {
+	return "<keyspace>, db statistics of each shard and the cluster total, replicas included"
+}
+
+func (c *commandStats) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, out io.Writer) error {
+
+	if len(args) < 1 {
+		return errInvalidArguments
+	}
+	keyspace := args[0]
+
+	descResponse, err := vastoClient.MasterClient.Describe(
+		context.Background(),
+		&pb.DescribeRequest{
+			DescCluster: &pb.DescribeRequest_DescCluster{
+				Keyspace: keyspace,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if descResponse.DescCluster == nil {
+		return fmt.Errorf("no cluster keyspace(%v) found", keyspace)
+	}
+
+	total := &pb.DbStats{}
+
+	// each store reports all its local shards of the keyspace
+	seenStores := make(map[string]bool)
+	for _, node := range descResponse.DescCluster.GetCluster().GetNodes() {
+		address := node.StoreResource.AdminAddress
+		if seenStores[address] {
+			continue
+		}
+		seenStores[address] = true
+
+		err := topology.VastoNodes{node}.WithConnection("stats", 0, func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
+
+			resp, err := pb.NewVastoStoreClient(grpcConnection).ShardStats(context.Background(), &pb.ShardStatsRequest{
+				Keyspace: keyspace,
+			})
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("%s", resp.Error)
+			}
+
+			fmt.Fprintf(out, "store %s\n", node.StoreResource.Address)
+			for _, shardStats := range resp.Shards {
+				printDbStats(out, fmt.Sprintf("shard %d", shardStats.ShardId), shardStats)
+				addDbStats(total, shardStats)
+			}
+			return nil
+		}, vastoClient.ClusterListener.DialOptions()...)
+		if err != nil {
+			fmt.Fprintf(out, "store %s: %v\n", node.StoreResource.Address, err)
+		}
+	}
+
+	printDbStats(out, "total", total)
+
+	return nil
+}
+
+func addDbStats(total, s *pb.DbStats) {
+	total.EstimatedKeys += s.EstimatedKeys
+	total.LiveSstSize += s.LiveSstSize
+	total.TotalSstSize += s.TotalSstSize
+	total.MemtableSize += s.MemtableSize
+	total.PendingCompactionBytes += s.PendingCompactionBytes
+	for level, count := range s.LevelFileCounts {
+		if level >= len(total.LevelFileCounts) {
+			total.LevelFileCounts = append(total.LevelFileCounts, 0)
+		}
+		total.LevelFileCounts[level] += count
+	}
+	total.BlockCacheHits += s.BlockCacheHits
+	total.BlockCacheMisses += s.BlockCacheMisses
+	total.StallMicros += s.StallMicros
+}
+
+func printDbStats(out io.Writer, name string, s *pb.DbStats) {
+	hitRate := "-"
+	if lookups := s.BlockCacheHits + s.BlockCacheMisses; lookups > 0 {
+		hitRate = fmt.Sprintf("%.1f%%", float64(s.BlockCacheHits)*100/float64(lookups))
+	}
+	var levels []string
+	for _, count := range s.LevelFileCounts {
+		levels = append(levels, fmt.Sprintf("%d", count))
+	}
+	fmt.Fprintf(out, "    %-10s keys:%d sst:%d/%d bytes memtable:%d bytes pending compaction:%d bytes files:[%s] block cache hit:%s stall:%v\n",
+		name, s.EstimatedKeys, s.LiveSstSize, s.TotalSstSize, s.MemtableSize, s.PendingCompactionBytes,
+		strings.Join(levels, " "), hitRate, time.Duration(s.StallMicros)*time.Microsecond)
+}
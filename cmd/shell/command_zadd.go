@@ -0,0 +1,44 @@
+package shell
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func init() {
+	commands = append(commands, &commandZAdd{})
+}
+
+type commandZAdd struct {
+}
+
+func (c *commandZAdd) Name() string {
+	return "zadd"
+}
+
+func (c *commandZAdd) Help() string {
+	return "<key> <score> <member> [score member...], adds the members to the sorted set of the key"
+}
+
+func (c *commandZAdd) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+	if len(args) < 3 || len(args)%2 != 1 {
+		return errInvalidArguments
+	}
+
+	var members []codec.ScoredMember
+	for i := 1; i < len(args); i += 2 {
+		score, err := strconv.ParseFloat(args[i], 64)
+		if err != nil {
+			return err
+		}
+		members = append(members, codec.ScoredMember{Member: []byte(args[i+1]), Score: score})
+	}
+
+	return commandEnv.clusterClient.ZAdd(vs.Key([]byte(args[0])), members...)
+}
@@ -3,6 +3,7 @@ package shell
 import (
 	"context"
 	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/security"
 )
 
 // ShellOption has options to run the shell
@@ -10,6 +11,8 @@ type ShellOption struct {
 	Master     *string
 	DataCenter *string
 	Keyspace   *string
+	Token      *string
+	Tls        *security.TlsOption
 }
 
 type shell struct {
@@ -22,9 +25,11 @@ type shell struct {
 func RunShell(option *ShellOption) {
 	var b = &shell{
 		option:      option,
-		vastoClient: vs.NewVastoClient(context.Background(), "", *option.Master),
+		vastoClient: vs.NewVastoClient(context.Background(), "", *option.Master, vs.WithTls(security.MustNewTls(option.Tls))),
 	}
 
+	b.vastoClient.SetToken(*option.Token)
+
 	if *option.Keyspace != "" {
 		b.vastoClient.NewClusterClient(*option.Keyspace)
 	}
@@ -12,16 +12,19 @@ func (ss *storeServer) processDelete(shard *shard, deleteRequest *pb.DeleteReque
 		Ok: true,
 	}
 
-	err := shard.db.Delete(deleteRequest.Key)
-	if err != nil {
+	shard.lockForWrite(deleteRequest.Key)
+	defer shard.unlockForWrite(deleteRequest.Key)
+
+	nowInNano := deleteRequest.UpdatedAtNs
+	if nowInNano == 0 {
+		nowInNano = uint64(time.Now().UnixNano())
+	}
+
+	if err := shard.db.Delete(deleteRequest.Key); err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
 	} else {
 		if !*ss.option.DisableBinLog {
-			nowInNano := deleteRequest.UpdatedAtNs
-			if nowInNano == 0 {
-				nowInNano = uint64(time.Now().UnixNano())
-			}
 			shard.logDelete(deleteRequest, nowInNano)
 		}
 	}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"bytes"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func (ss *storeServer) processDeleteRange(shard *shard, deleteRangeRequest *pb.DeleteRangeRequest) *pb.WriteResponse {
+
+	resp := &pb.WriteResponse{
+		Ok: true,
+	}
+
+	err := shard.deleteRange(deleteRangeRequest, deleteRangeRequest.UpdatedAtNs, !*ss.option.DisableBinLog)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+	}
+	return resp
+
+}
+
+// deleteRange deletes the entries in [start, end) updated no later than updatedAtNs, and keeps the internal meta data.
+// If updatedAtNs is 0, the range delete is stamped when the writes in progress have finished.
+// Only the writes to the range wait until the range is deleted and logged, so no newer writes are deleted,
+// and the writes to the other keys go on during the scan.
+// The scan reads the UpdatedAtNs of every entry in the range, so it takes time proportional to the number of keys,
+// but the internal key span is skipped without scanning.
+func (s *shard) deleteRange(deleteRangeRequest *pb.DeleteRangeRequest, updatedAtNs uint64, appendLog bool) error {
+
+	r, updatedAtNs := s.startRangeDelete(deleteRangeRequest.Start, deleteRangeRequest.End, updatedAtNs)
+	defer s.finishRangeDelete(r)
+
+	keepNewer := func(key, value []byte) bool {
+		return codec.GetUpdatedAtNsFromBytes(value) > updatedAtNs
+	}
+	for _, span := range excludeInternalKeys(deleteRangeRequest.Start, deleteRangeRequest.End) {
+		if err := s.db.DeleteRange(span[0], span[1], keepNewer); err != nil {
+			return err
+		}
+	}
+
+	if appendLog {
+		s.logDeleteRange(deleteRangeRequest, updatedAtNs)
+	}
+	return nil
+
+}
+
+// excludeInternalKeys splits [start, end) into the spans outside of the internal meta data,
+// so the internal keys are kept without being scanned.
+func excludeInternalKeys(start, end []byte) (spans [][2][]byte) {
+	internalEnd := keyPrefixEnd(VastoInternalKeyPrefix)
+	if bytes.Compare(start, VastoInternalKeyPrefix) < 0 {
+		if len(end) > 0 && bytes.Compare(end, VastoInternalKeyPrefix) <= 0 {
+			return append(spans, [2][]byte{start, end})
+		}
+		spans = append(spans, [2][]byte{start, VastoInternalKeyPrefix})
+		start = internalEnd
+	} else if bytes.Compare(start, internalEnd) < 0 {
+		start = internalEnd
+	}
+	if len(end) == 0 || bytes.Compare(start, end) < 0 {
+		spans = append(spans, [2][]byte{start, end})
+	}
+	return spans
+}
+
+// keyPrefixEnd returns the smallest key after all keys with the prefix
+func keyPrefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func (s *shard) logDeleteRange(deleteRangeRequest *pb.DeleteRangeRequest, updatedAtNs uint64) {
+
+	if s.lm == nil {
+		return
+	}
+
+	err := s.lm.AppendEntry(&pb.LogEntry{
+		UpdatedAtNs: updatedAtNs,
+		DeleteRange: deleteRangeRequest,
+	})
+
+	if err != nil {
+		glog.Errorf("append delete range log entry: %v", err)
+	}
+
+}
@@ -0,0 +1,185 @@
+package store
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/binlog"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func newTestShard(t *testing.T, engine string, withBinlog bool) *shard {
+	dir, err := ioutil.TempDir("", "vasto-shard-test")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	db, err := storage.NewEngine(engine, dir, storage.EngineOptions{MergeOperator: NewVastoMergeOperator(nil)})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	s := &shard{keyspace: "ks", db: db}
+	if withBinlog {
+		s.lm = binlog.NewLogManager(dir, 0, 1024*1024, 3)
+		s.lm.Initialze()
+	}
+	return s
+}
+
+func cleanupTestShard(s *shard) {
+	if s.lm != nil {
+		s.lm.Shutdown()
+	}
+	s.db.Close()
+	s.db.Destroy()
+}
+
+func newTestStoreServer() *storeServer {
+	disableBinLog := false
+	return &storeServer{option: &StoreOption{DisableBinLog: &disableBinLog}}
+}
+
+func testPut(t *testing.T, ss *storeServer, s *shard, key string, updatedAtNs uint64) {
+	resp := ss.processPut(s, &pb.PutRequest{
+		Key:           []byte(key),
+		UpdatedAtNs:   updatedAtNs,
+		OpAndDataType: pb.OpAndDataType_BYTES,
+		Value:         []byte("v"),
+	})
+	if !resp.Ok {
+		t.Fatalf("put %s: %s", key, resp.Status)
+	}
+}
+
+func assertKeys(t *testing.T, s *shard, expected map[string]bool) {
+	for key, exists := range expected {
+		data, err := s.db.Get([]byte(key))
+		if err != nil {
+			t.Errorf("%s get %s: %v", s, key, err)
+		}
+		if exists != (codec.FromBytes(data) != nil) {
+			t.Errorf("%s key %s exists %v, expecting %v", s, key, !exists, exists)
+		}
+	}
+}
+
+func TestDeleteRangeKeepsNewerWrites(t *testing.T) {
+
+	ss := newTestStoreServer()
+	primary := newTestShard(t, "memory", true)
+	defer cleanupTestShard(primary)
+
+	now := uint64(time.Now().UnixNano())
+	testPut(t, ss, primary, "k1", 0)
+	testPut(t, ss, primary, "k2", now+uint64(time.Hour))
+	testPut(t, ss, primary, "x1", 0)
+
+	if resp := ss.processDeleteRange(primary, &pb.DeleteRangeRequest{Start: []byte("k"), End: []byte("l")}); !resp.Ok {
+		t.Fatalf("delete range: %s", resp.Status)
+	}
+	testPut(t, ss, primary, "k3", 0)
+
+	expected := map[string]bool{"k1": false, "k2": true, "k3": true, "x1": true}
+	assertKeys(t, primary, expected)
+
+	// the follower replays the binlog into the same state
+	follower := newTestShard(t, "memory", false)
+	defer cleanupTestShard(follower)
+	segment, _ := primary.lm.GetSegmentRange()
+	entries, _, err := primary.lm.ReadEntries(segment, 0, 100)
+	if err != nil {
+		t.Fatalf("read binlog: %v", err)
+	}
+	if len(entries) != 5 {
+		t.Fatalf("binlog has %d entries, expecting 5", len(entries))
+	}
+	for _, entry := range entries {
+		follower.processEntry(entry)
+	}
+	assertKeys(t, follower, expected)
+}
+
+func TestDeleteRangeOnlyBlocksWritesInRange(t *testing.T) {
+
+	ss := newTestStoreServer()
+	s := newTestShard(t, "memory", false)
+	defer cleanupTestShard(s)
+
+	r, _ := s.startRangeDelete([]byte("k"), []byte("l"), 0)
+
+	// writes outside the range go on during the range delete
+	testPut(t, ss, s, "x1", 0)
+
+	written := make(chan bool)
+	go func() {
+		testPut(t, ss, s, "k1", 0)
+		close(written)
+	}()
+	select {
+	case <-written:
+		t.Fatalf("write in the range does not wait for the range delete")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.finishRangeDelete(r)
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		t.Fatalf("write in the range is blocked after the range delete")
+	}
+}
+
+func TestDeleteRangeKeepsInternalKeys(t *testing.T) {
+
+	ss := newTestStoreServer()
+	s := newTestShard(t, "memory", false)
+	defer cleanupTestShard(s)
+
+	internalKey := append(append([]byte(nil), VastoInternalKeyPrefix...), "next.offset"...)
+	if err := s.db.Put(internalKey, []byte("42")); err != nil {
+		t.Fatalf("put internal key: %v", err)
+	}
+	testPut(t, ss, s, "a1", 0)
+	testPut(t, ss, s, "z1", 0)
+
+	if resp := ss.processDeleteRange(s, &pb.DeleteRangeRequest{}); !resp.Ok {
+		t.Fatalf("delete range: %s", resp.Status)
+	}
+
+	assertKeys(t, s, map[string]bool{"a1": false, "z1": false})
+	if data, err := s.db.Get(internalKey); err != nil || string(data) != "42" {
+		t.Errorf("internal key is deleted: %q, %v", data, err)
+	}
+}
+
+func TestExcludeInternalKeys(t *testing.T) {
+
+	internalEnd := keyPrefixEnd(VastoInternalKeyPrefix)
+	tests := []struct {
+		start, end string
+		spans      [][2]string
+	}{
+		{"a", "b", [][2]string{{"a", "b"}}},
+		{"", "", [][2]string{{"", string(VastoInternalKeyPrefix)}, {string(internalEnd), ""}}},
+		{"A", "z", [][2]string{{"A", string(VastoInternalKeyPrefix)}, {string(internalEnd), "z"}}},
+		{"A", "_vasto.x", [][2]string{{"A", string(VastoInternalKeyPrefix)}}},
+		{"_vasto.a", "_vasto.b", nil},
+		{"_vasto.a", "z", [][2]string{{string(internalEnd), "z"}}},
+		{"x", "", [][2]string{{"x", ""}}},
+	}
+	for _, test := range tests {
+		spans := excludeInternalKeys([]byte(test.start), []byte(test.end))
+		if len(spans) != len(test.spans) {
+			t.Errorf("[%s, %s) spans %q, expecting %q", test.start, test.end, spans, test.spans)
+			continue
+		}
+		for i, span := range spans {
+			if string(span[0]) != test.spans[i][0] || string(span[1]) != test.spans[i][1] {
+				t.Errorf("[%s, %s) spans %q, expecting %q", test.start, test.end, spans, test.spans)
+				break
+			}
+		}
+	}
+}
@@ -24,6 +24,11 @@ func (ss *storeServer) processGet(shard *shard, getRequest *pb.GetRequest) *pb.G
 				Status: "expired",
 			}
 		}
+		if err := entry.Decrypt(shard.cipher); err != nil {
+			return &pb.GetResponse{
+				Status: err.Error(),
+			}
+		}
 		return &pb.GetResponse{
 			Ok: true,
 			KeyValue: &pb.KeyTypeValue{
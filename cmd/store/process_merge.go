@@ -11,19 +11,40 @@ import (
 func (ss *storeServer) processMerge(shard *shard, mergeRequest *pb.MergeRequest) *pb.WriteResponse {
 
 	key := mergeRequest.Key
+
+	resp := &pb.WriteResponse{
+		Ok: true,
+	}
+
+	if err := codec.CheckRequestedType(mergeRequest.OpAndDataType); err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+
+	// glog.V(2).Infof"shard %d put key: %v\n", shard.id, string(mergeRequest.KeyValue.Key))
+
+	shard.lockForWrite(key)
+	defer shard.unlockForWrite(key)
+
 	nowInNano := mergeRequest.UpdatedAtNs
 	if nowInNano == 0 {
 		nowInNano = uint64(time.Now().UnixNano())
 	}
 	entry := codec.NewMergeEntry(mergeRequest, nowInNano)
 
-	resp := &pb.WriteResponse{
-		Ok: true,
+	if err := entry.Encrypt(shard.cipher); err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
 	}
 
-	// glog.V(2).Infof"shard %d put key: %v\n", shard.id, string(mergeRequest.KeyValue.Key))
-
-	err := shard.db.Merge(key, entry.ToBytes())
+	var err error
+	if mergeRequest.ReturnValue {
+		resp.Value, err = shard.mergeAndGet(key, entry.ToBytes())
+	} else {
+		err = shard.db.Merge(key, entry.ToBytes())
+	}
 	if err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
@@ -58,3 +79,24 @@ func (s *shard) logMerge(mergeRequest *pb.MergeRequest, updatedAtNs uint64) {
 	// println("logMerge3", mergeRequest.String())
 
 }
+
+// mergeAndGet merges and reads back the merged value. It must be called with lockForWrite(key),
+// so each caller gets the value right after its own merge, e.g., the post-increment value of a counter.
+func (s *shard) mergeAndGet(key, value []byte) ([]byte, error) {
+
+	if err := s.db.Merge(key, value); err != nil {
+		return nil, err
+	}
+	data, err := s.db.Get(key)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	entry := codec.FromBytes(data)
+	if entry == nil {
+		return nil, nil
+	}
+	if err = entry.Decrypt(s.cipher); err != nil {
+		return nil, err
+	}
+	return entry.Value, nil
+}
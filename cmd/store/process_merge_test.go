@@ -0,0 +1,45 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+)
+
+func TestMergeWaitsForWriteOnSameKey(t *testing.T) {
+
+	ss := newTestStoreServer()
+	s := newTestShard(t, "memory", false)
+	defer cleanupTestShard(s)
+
+	merge := &pb.MergeRequest{Key: []byte("k"), OpAndDataType: pb.OpAndDataType_FLOAT64, Value: util.Float64ToBytes(1)}
+
+	// a merge without ReturnValue would otherwise land between the merge and the read of mergeAndGet
+	s.lockForWrite([]byte("k"))
+
+	merged := make(chan bool)
+	go func() {
+		ss.processMerge(s, merge)
+		s.processEntry(&pb.LogEntry{UpdatedAtNs: uint64(time.Now().UnixNano()), Merge: merge})
+		close(merged)
+	}()
+	select {
+	case <-merged:
+		t.Fatalf("merge does not wait for the write on the same key")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.unlockForWrite([]byte("k"))
+	select {
+	case <-merged:
+	case <-time.After(time.Second):
+		t.Fatalf("merge is blocked after the write on the same key")
+	}
+
+	resp := ss.processMerge(s, &pb.MergeRequest{Key: merge.Key, OpAndDataType: merge.OpAndDataType, Value: merge.Value, ReturnValue: true})
+	if !resp.Ok || util.BytesToFloat64(resp.Value) != 3 {
+		t.Errorf("merged value %v: %s", util.BytesToFloat64(resp.Value), resp.Status)
+	}
+}
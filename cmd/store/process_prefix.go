@@ -11,6 +11,7 @@ func (ss *storeServer) processPrefix(shard *shard, prefixRequest *pb.GetByPrefix
 	resp := &pb.GetByPrefixResponse{
 		Ok: true,
 	}
+	var decryptErr error
 	err := shard.db.PrefixScan(
 		prefixRequest.Prefix,
 		prefixRequest.LastSeenKey,
@@ -18,17 +19,26 @@ func (ss *storeServer) processPrefix(shard *shard, prefixRequest *pb.GetByPrefix
 		func(key, value []byte) bool {
 			entry := codec.FromBytes(value)
 			if !entry.IsExpired() {
+				if decryptErr = entry.Decrypt(shard.cipher); decryptErr != nil {
+					return false
+				}
+				// the key and value are only valid during the scan
 				t := make([]byte, len(key))
 				copy(t, key)
+				v := make([]byte, len(entry.Value))
+				copy(v, entry.Value)
 				keyValues = append(keyValues, &pb.KeyTypeValue{
 					Key:           t,
 					PartitionHash: entry.PartitionHash,
 					DataType:      pb.OpAndDataType(entry.OpAndDataType),
-					Value:         entry.Value,
+					Value:         v,
 				})
 			}
 			return true
 		})
+	if err == nil {
+		err = decryptErr
+	}
 	if err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
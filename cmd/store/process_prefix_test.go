@@ -0,0 +1,35 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestPrefixKeepsScannedValues(t *testing.T) {
+
+	ss := newTestStoreServer()
+	s := newTestShard(t, "leveldb", false)
+	defer cleanupTestShard(s)
+
+	for i := 0; i < 100; i++ {
+		if resp := ss.processPut(s, &pb.PutRequest{
+			Key:           []byte(fmt.Sprintf("k%3d", i)),
+			OpAndDataType: pb.OpAndDataType_BYTES,
+			Value:         []byte(fmt.Sprintf("v%3d", i)),
+		}); !resp.Ok {
+			t.Fatalf("put: %s", resp.Status)
+		}
+	}
+
+	resp := ss.processPrefix(s, &pb.GetByPrefixRequest{Prefix: []byte("k")})
+	if len(resp.KeyValues) != 100 {
+		t.Fatalf("prefix scan returns %d entries, expecting 100", len(resp.KeyValues))
+	}
+	for i, kv := range resp.KeyValues {
+		if string(kv.Value) != fmt.Sprintf("v%3d", i) {
+			t.Errorf("value of %s is %s", kv.Key, kv.Value)
+		}
+	}
+}
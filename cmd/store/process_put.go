@@ -11,20 +11,35 @@ import (
 func (ss *storeServer) processPut(shard *shard, putRequest *pb.PutRequest) *pb.WriteResponse {
 
 	key := putRequest.Key
+
+	resp := &pb.WriteResponse{
+		Ok: true,
+	}
+
+	if err := codec.CheckRequestedType(putRequest.OpAndDataType); err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+
+	// glog.V(2).Infof"shard %d put key: %v\n", shard.id, string(putRequest.KeyValue.Key))
+
+	shard.lockForWrite(key)
+	defer shard.unlockForWrite(key)
+
 	nowInNano := putRequest.UpdatedAtNs
 	if nowInNano == 0 {
 		nowInNano = uint64(time.Now().UnixNano())
 	}
 	entry := codec.NewPutEntry(putRequest, nowInNano)
 
-	resp := &pb.WriteResponse{
-		Ok: true,
+	if err := entry.Encrypt(shard.cipher); err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
 	}
 
-	// glog.V(2).Infof"shard %d put key: %v\n", shard.id, string(putRequest.KeyValue.Key))
-
-	err := shard.db.Put(key, entry.ToBytes())
-	if err != nil {
+	if err := shard.db.Put(key, entry.ToBytes()); err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
 	} else {
@@ -0,0 +1,27 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func TestPutRejectsEncryptedFlag(t *testing.T) {
+
+	ss := newTestStoreServer()
+	s := newTestShard(t, "memory", false)
+	defer cleanupTestShard(s)
+
+	requestedType := pb.OpAndDataType(codec.EncryptedFlag) | pb.OpAndDataType_BYTES
+
+	if resp := ss.processPut(s, &pb.PutRequest{Key: []byte("k"), OpAndDataType: requestedType, Value: []byte("v")}); resp.Ok {
+		t.Errorf("put with the encrypted flag is accepted")
+	}
+	if resp := ss.processMerge(s, &pb.MergeRequest{Key: []byte("k"), OpAndDataType: requestedType, Value: []byte("v")}); resp.Ok {
+		t.Errorf("merge with the encrypted flag is accepted")
+	}
+	if data, _ := s.db.Get([]byte("k")); data != nil {
+		t.Errorf("rejected write is stored")
+	}
+}
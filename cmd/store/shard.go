@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/stats"
+	"github.com/chrislusf/vasto/storage"
 	"github.com/chrislusf/vasto/storage/binlog"
-	"github.com/chrislusf/vasto/storage/rocks"
+	"github.com/chrislusf/vasto/storage/crypt"
 	"github.com/chrislusf/vasto/topology"
 	"github.com/chrislusf/vasto/topology/clusterlistener"
 	"github.com/chrislusf/vasto/util"
 	"google.golang.org/grpc"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -25,7 +28,7 @@ type shard struct {
 	keyspace            string
 	id                  VastoShardId
 	serverId            VastoServerId
-	db                  *rocks.Rocks
+	db                  storage.Engine
 	lm                  *binlog.LogManager
 	cluster             *topology.Cluster
 	clusterListener     *clusterlistener.ClusterListener
@@ -36,9 +39,20 @@ type shard struct {
 	followProgressLock  sync.Mutex
 	followProcesses     map[topology.ClusterShard]*followProcess
 	followProcessesLock sync.Mutex
+	followLags          map[topology.ClusterShard]*followLag
+	followLagsLock      sync.Mutex
+	hotKeys             *stats.TopK
+	hotKeySampleCounter uint64
+	hotKeysDecayedAt    time.Time
 	ctx                 context.Context
 	oneTimeFollowCancel context.CancelFunc
-	hasBackfilled       bool // whether addSst() has been called on this db
+	hasBackfilled       bool          // whether IngestBehind() has been called on this db
+	liveFilesSize       uint64        // cached db size, refreshed every second
+	cipher              *crypt.Cipher // nil if the store has no encryption keys
+	status              int32         // pb.ShardInfo_Status, BOOTSTRAP until the shard is started
+	writeLock           sync.RWMutex  // writes hold the read lock, and range deletes hold the write lock to start and finish
+	rangeDeletes        []*rangeDelete
+	mergeLocks          [64]sync.Mutex // striped by key, serializing the writes on the same key
 }
 
 func (s *shard) String() string {
@@ -47,19 +61,23 @@ func (s *shard) String() string {
 
 func newShard(keyspaceName, dir string, serverId, nodeId int, cluster *topology.Cluster,
 	clusterListener *clusterlistener.ClusterListener,
-	replicationFactor int, logFileSizeMb int, logFileCount int) *shard {
+	replicationFactor int, logFileSizeMb int, logFileCount int, cipher *crypt.Cipher, engine string, engineOptions storage.EngineOptions) *shard {
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	glog.V(1).Infof("open %s.%d.%d in %s", keyspaceName, serverId, nodeId, dir)
 
-	mergeOperator := NewVastoMergeOperator()
+	engineOptions.MergeOperator = NewVastoMergeOperator(cipher)
+	db, err := storage.NewEngine(engine, dir, engineOptions)
+	if err != nil {
+		glog.Fatalf("open %s.%d.%d in %s: %v", keyspaceName, serverId, nodeId, dir, err)
+	}
 
 	s := &shard{
 		keyspace:        keyspaceName,
 		id:              VastoShardId(nodeId),
 		serverId:        VastoServerId(serverId),
-		db:              rocks.NewDb(dir, mergeOperator),
+		db:              db,
 		cluster:         cluster,
 		clusterListener: clusterListener,
 		nodeFinishChan:  make(chan bool),
@@ -67,12 +85,21 @@ func newShard(keyspaceName, dir string, serverId, nodeId int, cluster *topology.
 			glog.V(1).Infof("cancelling shard %d.%d", serverId, nodeId)
 			cancelFunc()
 		},
-		followProgress:  make(map[progressKey]progressValue),
-		followProcesses: make(map[topology.ClusterShard]*followProcess),
-		ctx:             ctx,
+		followProgress:   make(map[progressKey]progressValue),
+		followProcesses:  make(map[topology.ClusterShard]*followProcess),
+		followLags:       make(map[topology.ClusterShard]*followLag),
+		hotKeys:          stats.NewTopK(hotKeyCapacity),
+		hotKeysDecayedAt: time.Now(),
+		ctx:              ctx,
+		cipher:           cipher,
+		status:           int32(pb.ShardInfo_BOOTSTRAP),
+	}
+	if cipher != nil {
+		s.db.SetCompactionRewrite(s.compactionRewrite)
 	}
 	if logFileSizeMb > 0 {
 		s.lm = binlog.NewLogManager(dir, nodeId, int64(logFileSizeMb*1024*1024), logFileCount)
+		s.lm.SetCipher(cipher)
 		s.lm.Initialze()
 	}
 
@@ -103,6 +130,8 @@ func (s *shard) setCompactionFilterClusterSize(clusterSize int) {
 
 func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, selfAdminAddress string, existingPrimaryShards []*pb.ClusterNode) error {
 
+	s.setStatus(pb.ShardInfo_BOOTSTRAP)
+
 	if len(existingPrimaryShards) == 0 {
 		for i := 0; i < s.cluster.ExpectedSize(); i++ {
 			if n, ok := s.cluster.GetNode(i, 0); ok {
@@ -137,8 +166,9 @@ func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, se
 				fmt.Sprintf("%s one-time follow %d.%d", s.String(), shard.ServerId, shard.ShardId),
 				shard.ServerId,
 				func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
-					return s.followChanges(oneTimeFollowCtx, node, grpcConnection, shard.ShardId, bootstrapPlan.ToClusterSize, true)
+					return s.followChanges(oneTimeFollowCtx, node, grpcConnection, shard.ShardId, bootstrapPlan.ToClusterSize, true, nil)
 				},
+				s.clusterListener.DialOptions()...,
 			)
 			if err != nil {
 				glog.Errorf("%s one-time follow3 %+v: %v", s.String(), shard, err)
@@ -152,10 +182,20 @@ func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, se
 
 	s.clusterListener.RegisterShardEventProcessor(s)
 
+	s.setStatus(pb.ShardInfo_READY)
+
 	return nil
 
 }
 
+func (s *shard) setStatus(status pb.ShardInfo_Status) {
+	atomic.StoreInt32(&s.status, int32(status))
+}
+
+func (s *shard) getStatus() pb.ShardInfo_Status {
+	return pb.ShardInfo_Status(atomic.LoadInt32(&s.status))
+}
+
 func (s *shard) adjustNormalFollowings(clusterSize, replicationFactor int) {
 
 	followTargetPeers := topology.PeerShards(int(s.serverId), int(s.id), clusterSize, replicationFactor)
@@ -172,7 +212,7 @@ func (s *shard) adjustNormalFollowings(clusterSize, replicationFactor int) {
 		serverId, shardId := peer.ServerId, peer.ShardId
 		glog.V(1).Infof("%s normal follow %d.%d", s.String(), serverId, shardId)
 		ctx, cancelFunc := context.WithCancel(s.ctx)
-		s.startFollowProcess(peer, cancelFunc)
+		process := s.startFollowProcess(peer, cancelFunc)
 
 		go util.RetryForever(ctx, fmt.Sprintf("shard %s normal follow %d.%d", s.String(), serverId, shardId),
 			func() error {
@@ -180,8 +220,9 @@ func (s *shard) adjustNormalFollowings(clusterSize, replicationFactor int) {
 					fmt.Sprintf("%s follow %d.%d", s.String(), serverId, shardId),
 					serverId,
 					func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
-						return s.followChanges(ctx, node, grpcConnection, shardId, clusterSize, false)
+						return s.followChanges(ctx, node, grpcConnection, shardId, clusterSize, false, process)
 					},
+					s.clusterListener.DialOptions()...,
 				)
 			},
 			2*time.Second,
@@ -8,11 +8,12 @@ import (
 	"time"
 
 	"github.com/chrislusf/glog"
-	"github.com/chrislusf/gorocksdb"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
 	"github.com/chrislusf/vasto/topology"
+	"github.com/chrislusf/vasto/tracing"
 	"github.com/chrislusf/vasto/util"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,7 +30,12 @@ bootstrap ensure current shard is bootstrapped and can be synced by binlog taili
 3. starts to add changes to sstable
 4. get the new offsets
 */
-func (s *shard) maybeBootstrapAfterRestart(ctx context.Context) error {
+func (s *shard) maybeBootstrapAfterRestart(ctx context.Context) (err error) {
+
+	ctx, span := tracing.Start(ctx, "vasto.store.bootstrap.restart", s.spanAttributes()...)
+	defer func() {
+		tracing.End(span, err)
+	}()
 
 	bestPeerToCopy, isNeeded := s.isBootstrapNeeded(ctx, &topology.BootstrapPlan{
 		BootstrapSource: s.peerShards(),
@@ -51,16 +57,27 @@ func (s *shard) maybeBootstrapAfterRestart(ctx context.Context) error {
 			return s.doBootstrapCopy(ctx, grpcConnection, node, s.cluster.ExpectedSize(), 0, 0)
 		}
 		return nil
-	})
+	}, s.clusterListener.DialOptions()...)
 
 }
 
-func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology.BootstrapPlan, existingPrimaryShards []*pb.ClusterNode) error {
+func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology.BootstrapPlan, existingPrimaryShards []*pb.ClusterNode) (err error) {
 
 	if bootstrapPlan == nil {
 		return nil
 	}
 
+	ctx, span := tracing.Start(ctx, "vasto.store.bootstrap.resize", append(s.spanAttributes(),
+		attribute.Int("from_cluster_size", bootstrapPlan.FromClusterSize),
+		attribute.Int("to_cluster_size", bootstrapPlan.ToClusterSize),
+	)...)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	storeBootstrapGauge.With(s.bootstrapLabels()).Inc()
+	defer storeBootstrapGauge.With(s.bootstrapLabels()).Dec()
+
 	if bootstrapPlan.PickBestBootstrapSource {
 
 		bestPeerToCopy, isNeeded := s.isBootstrapNeeded(ctx, bootstrapPlan)
@@ -74,7 +91,7 @@ func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology
 		return topology.VastoNodes(existingPrimaryShards).WithConnection(fmt.Sprintf("%s bootstrap from one exisiting %d.%d", s.String(), bestPeerToCopy.ServerId, bestPeerToCopy.ShardId),
 			bestPeerToCopy.ServerId, func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
 				return s.doBootstrapCopy(ctx, grpcConnection, node, bootstrapPlan.FromClusterSize, bootstrapPlan.ToClusterSize, int(s.id))
-			})
+			}, s.clusterListener.DialOptions()...)
 	}
 
 	var bootstrapSourceServerIds []int
@@ -95,6 +112,7 @@ func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology
 					func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
 						return s.doBootstrapCopy2(ctx, grpcConnection, node, bootstrapPlan.FromClusterSize, bootstrapPlan.ToClusterSize, int(s.id), sourceChan)
 					},
+					s.clusterListener.DialOptions()...,
 				)
 			})
 		},
@@ -102,14 +120,10 @@ func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology
 			if !s.hasBackfilled {
 				s.hasBackfilled = true
 				glog.V(1).Infof("bootstrap %v via sst ...", s.String())
-				return s.db.AddSstByWriter(fmt.Sprintf("%s bootstrapCopy write", s.String()),
-					func(w *gorocksdb.SSTFileWriter) (int64, error) {
+				return s.db.IngestBehind(fmt.Sprintf("%s bootstrapCopy write", s.String()),
+					func(add func(key, value []byte) error) (int64, error) {
 						counter, err := pb.MergeSorted(sourceRowChans, 0, func(keyValue *pb.RawKeyValue) error {
-
-							if err := w.Add(keyValue.Key, keyValue.Value); err != nil {
-								return fmt.Errorf("add to sst: %v", err)
-							}
-							return nil
+							return add(keyValue.Key, keyValue.Value)
 						})
 						return counter, err
 					},
@@ -199,6 +213,9 @@ func (s *shard) doBootstrapCopy(ctx context.Context, grpcConnection *grpc.Client
 
 	glog.V(1).Infof("bootstrap %s from %s %s filter by %d/%d", s.String(), node.StoreResource.Address, node.ShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize)
 
+	storeBootstrapGauge.With(s.bootstrapLabels()).Inc()
+	defer storeBootstrapGauge.With(s.bootstrapLabels()).Dec()
+
 	s.db.Close()
 	s.db.Destroy()
 	s.db.EnsureDirectory()
@@ -236,6 +253,8 @@ func (s *shard) doBootstrapCopy2(ctx context.Context, grpcConnection *grpc.Clien
 		return fmt.Errorf("client.TailBinlog: %v", err)
 	}
 
+	rowCounter := storeBootstrapRowCounter.With(s.bootstrapLabels())
+
 	var segment uint32
 	var offset uint64
 	var counter int
@@ -253,9 +272,13 @@ func (s *shard) doBootstrapCopy2(ctx context.Context, grpcConnection *grpc.Clien
 		for _, keyValue := range response.KeyValues {
 
 			// fmt.Printf("%s add to sst: %v\n", sourceShardInfo.IdentifierOnThisServer(), string(keyValue.Key))
+			if err := s.encryptRow(keyValue); err != nil {
+				return err
+			}
 			t := keyValue
 			rowChan <- t
 			counter++
+			rowCounter.Inc()
 
 		}
 
@@ -297,9 +320,11 @@ func (s *shard) writeToSst(ctx context.Context, grpcConnection *grpc.ClientConn,
 		return 0, 0, 0, fmt.Errorf("client.BootstrapCopy: %v", err)
 	}
 
-	err = s.db.AddSstByWriter(fmt.Sprintf("bootstrap %s from %s %d/%d", s.String(), sourceShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize),
+	rowCounter := storeBootstrapRowCounter.With(s.bootstrapLabels())
+
+	err = s.db.IngestBehind(fmt.Sprintf("bootstrap %s from %s %d/%d", s.String(), sourceShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize),
 
-		func(w *gorocksdb.SSTFileWriter) (int64, error) {
+		func(add func(key, value []byte) error) (int64, error) {
 
 			for {
 
@@ -317,11 +342,14 @@ func (s *shard) writeToSst(ctx context.Context, grpcConnection *grpc.ClientConn,
 
 					// fmt.Printf("%s add to sst: %v\n", sourceShardInfo.IdentifierOnThisServer(), string(keyValue.Key))
 
-					err = w.Add(keyValue.Key, keyValue.Value)
-					if err != nil {
-						return counter, fmt.Errorf("add to sst: %v", err)
+					if err = s.encryptRow(keyValue); err != nil {
+						return counter, err
+					}
+					if err = add(keyValue.Key, keyValue.Value); err != nil {
+						return counter, err
 					}
 					counter++
+					rowCounter.Inc()
 
 				}
 
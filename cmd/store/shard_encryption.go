@@ -0,0 +1,58 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// compactionRewrite trims the encrypted LIST values, which the compaction filter can not read,
+// and rotates the encryption of the values.
+func (s *shard) compactionRewrite(value []byte) []byte {
+	trimmed, err := codec.TrimEncryptedList(s.cipher, value, time.Now())
+	if err != nil {
+		glog.Errorf("%s trim encrypted list: %v", s, err)
+		return nil
+	}
+	if trimmed != nil {
+		value = trimmed
+	}
+	if rotated := s.rotateEncryption(value); rotated != nil {
+		return rotated
+	}
+	return trimmed
+}
+
+// rotateEncryption is called during compaction, to re-encrypt the values with the active key,
+// to encrypt the values when the keyspace becomes encrypted, or to decrypt them when it is not any more.
+func (s *shard) rotateEncryption(value []byte) []byte {
+	rotated, err := codec.RotateBytes(s.cipher, value)
+	if err != nil {
+		glog.Errorf("%s rotate encryption: %v", s, err)
+		return nil
+	}
+	return rotated
+}
+
+// encryptRow encrypts the row received by bootstrap copy, which is sent with the value in plain text.
+func (s *shard) encryptRow(row *pb.RawKeyValue) error {
+	value, err := codec.EncryptBytes(s.cipher, row.Value)
+	if err != nil {
+		return fmt.Errorf("encrypt %s: %v", string(row.Key), err)
+	}
+	row.Value = value
+	return nil
+}
+
+// decryptRow decrypts the row to send by bootstrap copy, so that the receiver can encrypt it with its own keys.
+func (s *shard) decryptRow(row *pb.RawKeyValue) error {
+	value, err := codec.DecryptBytes(s.cipher, row.Value)
+	if err != nil {
+		return fmt.Errorf("decrypt %s: %v", string(row.Key), err)
+	}
+	row.Value = value
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/topology"
 	"google.golang.org/grpc"
 )
 
@@ -15,7 +16,8 @@ const (
 	syncProgressFlushInterval = time.Minute
 )
 
-func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcConnection *grpc.ClientConn, sourceShardId int, targetClusterSize int, saveFollowProgress bool) error {
+// followChanges tails the binlog of the source shard. process is the normal follow process, nil for one-time follows.
+func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcConnection *grpc.ClientConn, sourceShardId int, targetClusterSize int, saveFollowProgress bool, process *followProcess) error {
 
 	client := pb.NewVastoStoreClient(grpcConnection)
 
@@ -46,6 +48,17 @@ func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcCon
 		return fmt.Errorf("client.TailBinlog to server %d %s: %v", node.ShardInfo.ServerId, node.StoreResource.GetAdminAddress(), err)
 	}
 
+	source := topology.ClusterShard{ServerId: int(node.ShardInfo.ServerId), ShardId: sourceShardId}
+	process.setConnected(true)
+	defer func() {
+		process.setConnected(false)
+		if ctx.Err() != nil {
+			// stopped following, instead of failed and retrying
+			s.deleteFollowLag(source)
+		}
+	}()
+	var lastAppliedUpdatedAtNs uint64
+
 	for {
 
 		// println("TailBinlog receive from", s.id)
@@ -59,7 +72,9 @@ func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcCon
 
 		for _, entry := range changes.Entries {
 			s.processEntry(entry)
+			lastAppliedUpdatedAtNs = entry.UpdatedAtNs
 		}
+		s.updateFollowLag(source, changes, lastAppliedUpdatedAtNs)
 
 		// set the nextSegment and nextOffset
 		nextSegment, nextOffset = changes.NextSegment, changes.NextOffset
@@ -72,11 +87,26 @@ func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcCon
 }
 
 func (s *shard) processEntry(entry *pb.LogEntry) {
+	// process range deletes
+	if deleteRange := entry.GetDeleteRange(); deleteRange != nil {
+		if err := s.deleteRange(deleteRange, entry.UpdatedAtNs, false); err != nil {
+			glog.Errorf("%s delete range [%v, %v): %v", s, string(deleteRange.Start), string(deleteRange.End), err)
+		}
+		return
+	}
+
+	s.lockForWrite(entry.GetKey())
+	defer s.unlockForWrite(entry.GetKey())
+
 	// process merges
 	if entry.GetMerge() != nil {
 		merge := entry.GetMerge()
 		key := merge.Key
 		t := codec.NewMergeEntry(merge, entry.UpdatedAtNs)
+		if err := t.Encrypt(s.cipher); err != nil {
+			glog.Errorf("%s merge %v: %v", s, string(key), err)
+			return
+		}
 
 		s.db.Merge(key, t.ToBytes())
 		return
@@ -109,6 +139,10 @@ func (s *shard) processEntry(entry *pb.LogEntry) {
 		put := entry.GetPut()
 		key := put.Key
 		t := codec.NewPutEntry(put, entry.UpdatedAtNs)
+		if err := t.Encrypt(s.cipher); err != nil {
+			glog.Errorf("%s put %v: %v", s, string(key), err)
+			return
+		}
 
 		if len(b) == 0 {
 			// no existing data found
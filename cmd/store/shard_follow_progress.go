@@ -6,6 +6,8 @@ import (
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/topology"
 	"github.com/chrislusf/vasto/util"
+	"sync/atomic"
+	"time"
 )
 
 type progressKey struct {
@@ -20,6 +22,7 @@ type progressValue struct {
 
 type followProcess struct {
 	cancelFunc context.CancelFunc
+	connected  int32 // 1 if tailing the binlog of the peer
 }
 
 var (
@@ -35,6 +38,8 @@ func genSegmentOffsetKeys(serverAdminAddress string, shardId VastoShardId) (segm
 
 // implementing PeriodicTask
 func (s *shard) EverySecond() {
+	s.maybeDecayHotKeys(time.Now())
+	s.updateLiveFilesSize()
 	// glog.V(2).Infof("%s every second", s)
 	s.followProcessesLock.Lock()
 	for pk, pv := range s.followProgress {
@@ -103,10 +108,38 @@ func (s *shard) isFollowing(peer topology.ClusterShard) bool {
 	return found
 }
 
-func (s *shard) startFollowProcess(peer topology.ClusterShard, cancelFunc context.CancelFunc) {
+func (s *shard) startFollowProcess(peer topology.ClusterShard, cancelFunc context.CancelFunc) *followProcess {
+	process := &followProcess{cancelFunc: cancelFunc}
 	s.followProcessesLock.Lock()
-	s.followProcesses[peer] = &followProcess{cancelFunc: cancelFunc}
+	s.followProcesses[peer] = process
 	s.followProcessesLock.Unlock()
+	return process
+}
+
+// setConnected marks whether the normal follow process is tailing the binlog of the peer.
+// It is a no-op for the nil process of one-time follows.
+func (p *followProcess) setConnected(connected bool) {
+	if p == nil {
+		return
+	}
+	if connected {
+		atomic.StoreInt32(&p.connected, 1)
+	} else {
+		atomic.StoreInt32(&p.connected, 0)
+	}
+}
+
+// followConnections counts the normal follow processes, and how many of them are connected
+func (s *shard) followConnections() (connected, total int) {
+	s.followProcessesLock.Lock()
+	for _, followProcess := range s.followProcesses {
+		total++
+		if atomic.LoadInt32(&followProcess.connected) == 1 {
+			connected++
+		}
+	}
+	s.followProcessesLock.Unlock()
+	return
 }
 
 func (s *shard) insertInMemoryFollowProgress(serverAdminAddress string, targetShardId VastoShardId, segment uint32, offset uint64) {
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+const (
+	// hotKeyCapacity is how many keys are tracked for each shard
+	hotKeyCapacity = 128
+	// hotKeySampleEvery samples one in this many requests, to keep the cost low
+	hotKeySampleEvery = 8
+	// hotKeyDecayInterval halves the counts periodically, so the hot keys are the recent ones
+	hotKeyDecayInterval = time.Minute
+)
+
+// recordKeyAccess samples the accessed keys for the hot key detection
+func (s *shard) recordKeyAccess(key []byte) {
+	if atomic.AddUint64(&s.hotKeySampleCounter, 1)%hotKeySampleEvery != 0 {
+		return
+	}
+	s.hotKeys.Add(key)
+}
+
+func (s *shard) maybeDecayHotKeys(now time.Time) {
+	if now.Sub(s.hotKeysDecayedAt) < hotKeyDecayInterval {
+		return
+	}
+	s.hotKeysDecayedAt = now
+	s.hotKeys.Decay()
+}
+
+// topHotKeys returns the estimated hottest keys, with the counts scaled back from the sampling
+func (s *shard) topHotKeys(limit int) (hotKeys []*pb.HotKey) {
+	for _, item := range s.hotKeys.Top(limit) {
+		hotKeys = append(hotKeys, &pb.HotKey{
+			Key:   item.Key,
+			Count: item.Count * hotKeySampleEvery,
+			Error: item.Error * hotKeySampleEvery,
+		})
+	}
+	return
+}
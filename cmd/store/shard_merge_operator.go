@@ -1,16 +1,20 @@
 package store
 
 import (
-	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/storage"
 	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/storage/crypt"
 )
 
-// NewVastoMergeOperator creates a MergeOperator object for rocksdb.
-func NewVastoMergeOperator() gorocksdb.MergeOperator {
-	return vastorMergeOperator{}
+// NewVastoMergeOperator creates a MergeOperator object for the storage engines.
+// The encrypted values are decrypted to merge, and the merged value is encrypted with the active key.
+func NewVastoMergeOperator(cipher *crypt.Cipher) storage.MergeOperator {
+	return vastorMergeOperator{cipher: cipher}
 }
 
 type vastorMergeOperator struct {
+	cipher *crypt.Cipher
 }
 
 // Gives the client a way to express the read -> modify -> write semantics
@@ -28,6 +32,9 @@ type vastorMergeOperator struct {
 // returns false, it is because client specified bad data or there was
 // internal corruption. This will be treated as an error by the library.
 func (mo vastorMergeOperator) FullMerge(key, existingValue []byte, operands [][]byte) ([]byte, bool) {
+	if mo.cipher != nil {
+		return mo.fullMergeEncrypted(key, existingValue, operands)
+	}
 	var entry *codec.Entry
 	for i, operand := range operands {
 		if i == 0 {
@@ -59,8 +66,37 @@ func (mo vastorMergeOperator) FullMerge(key, existingValue []byte, operands [][]
 // The library will internally keep track of the operations, and apply them in the
 // correct order once a base-value (a Put/Delete/End-of-Database) is seen.
 func (mo vastorMergeOperator) PartialMerge(key, leftOperand, rightOperand []byte) ([]byte, bool) {
+	if mo.cipher != nil {
+		return mo.fullMergeEncrypted(key, leftOperand, [][]byte{rightOperand})
+	}
 	return codec.Merge(leftOperand, rightOperand)
 }
 
 // The name of the MergeOperator.
 func (mo vastorMergeOperator) Name() string { return "VastorMergeOperator" }
+
+func (mo vastorMergeOperator) fullMergeEncrypted(key, existingValue []byte, operands [][]byte) ([]byte, bool) {
+	var err error
+	if existingValue != nil {
+		if existingValue, err = codec.DecryptBytes(mo.cipher, existingValue); err != nil {
+			glog.Errorf("merge %s: %v", string(key), err)
+			return nil, false
+		}
+	}
+	plainOperands := make([][]byte, len(operands))
+	for i, operand := range operands {
+		if plainOperands[i], err = codec.DecryptBytes(mo.cipher, operand); err != nil {
+			glog.Errorf("merge %s: %v", string(key), err)
+			return nil, false
+		}
+	}
+	merged, ok := vastorMergeOperator{}.FullMerge(key, existingValue, plainOperands)
+	if !ok {
+		return nil, false
+	}
+	if merged, err = codec.EncryptBytes(mo.cipher, merged); err != nil {
+		glog.Errorf("merge %s: %v", string(key), err)
+		return nil, false
+	}
+	return merged, true
+}
@@ -40,7 +40,7 @@ func (s *shard) isBootstrapNeeded(ctx context.Context, bootstrapOption *topology
 				}
 				isBootstrapNeededChan <- !canTailBinlog
 				return nil
-			})
+			}, s.clusterListener.DialOptions()...)
 
 		}(peer)
 	}
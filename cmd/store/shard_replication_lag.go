@@ -0,0 +1,58 @@
+package store
+
+import (
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"github.com/golang/protobuf/proto"
+)
+
+// followLag is the lag reported by the latest poll of the source shard
+type followLag struct {
+	lag      *pb.ReplicationLag
+	polledAt time.Time
+}
+
+// updateFollowLag records how far this shard is behind the source shard, after applying the changes.
+// lastAppliedUpdatedAtNs is the time of the latest applied entry from the source, 0 if not known yet.
+func (s *shard) updateFollowLag(source topology.ClusterShard, changes *pb.PullUpdateResponse, lastAppliedUpdatedAtNs uint64) {
+
+	lag := &pb.ReplicationLag{
+		Keyspace:       s.keyspace,
+		ServerId:       uint32(s.serverId),
+		ShardId:        uint32(s.id),
+		SourceServerId: uint32(source.ServerId),
+		SourceShardId:  uint32(source.ShardId),
+		Entries:        changes.LagEntries,
+		Bytes:          changes.LagBytes,
+	}
+	if changes.LagBytes > 0 && lastAppliedUpdatedAtNs > 0 && changes.LatestUpdatedAtNs > lastAppliedUpdatedAtNs {
+		lag.TimeNs = changes.LatestUpdatedAtNs - lastAppliedUpdatedAtNs
+	}
+
+	s.followLagsLock.Lock()
+	s.followLags[source] = &followLag{lag: lag, polledAt: time.Now()}
+	s.followLagsLock.Unlock()
+}
+
+func (s *shard) deleteFollowLag(source topology.ClusterShard) {
+	s.followLagsLock.Lock()
+	delete(s.followLags, source)
+	s.followLagsLock.Unlock()
+}
+
+// replicationLags returns the lags of this shard to all the followed peers.
+// The time since the last poll is added, so a follow stuck without any response keeps lagging more.
+func (s *shard) replicationLags(now time.Time) (lags []*pb.ReplicationLag) {
+	s.followLagsLock.Lock()
+	for _, f := range s.followLags {
+		lag := proto.Clone(f.lag).(*pb.ReplicationLag)
+		if sincePoll := now.Sub(f.polledAt); sincePoll > 0 {
+			lag.TimeNs += uint64(sincePoll)
+		}
+		lags = append(lags, lag)
+	}
+	s.followLagsLock.Unlock()
+	return
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+)
+
+func TestReplicationLagGrowsWithoutPolls(t *testing.T) {
+
+	s := &shard{keyspace: "ks", followLags: make(map[topology.ClusterShard]*followLag)}
+	source := topology.ClusterShard{ServerId: 1, ShardId: 0}
+
+	s.updateFollowLag(source, &pb.PullUpdateResponse{}, 0)
+
+	now := time.Now()
+	if lags := s.replicationLags(now); len(lags) != 1 || time.Duration(lags[0].TimeNs) > time.Second {
+		t.Fatalf("caught up follow lags %v", lags)
+	}
+
+	// the source stops responding
+	lags := s.replicationLags(now.Add(time.Minute))
+	if len(lags) != 1 || time.Duration(lags[0].TimeNs) < time.Minute {
+		t.Errorf("follow without polls lags %v", lags)
+	}
+
+	s.deleteFollowLag(source)
+	if lags := s.replicationLags(now); len(lags) != 0 {
+		t.Errorf("stopped follow lags %v", lags)
+	}
+}
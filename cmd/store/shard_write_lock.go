@@ -0,0 +1,85 @@
+package store
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/vasto/util"
+)
+
+// rangeDelete is a range delete in progress. The writes to the keys in [start, end) wait until done is closed.
+type rangeDelete struct {
+	start []byte
+	end   []byte // empty means to the end of the shard
+	done  chan struct{}
+}
+
+func (r *rangeDelete) covers(key []byte) bool {
+	return bytes.Compare(key, r.start) >= 0 && (len(r.end) == 0 || bytes.Compare(key, r.end) < 0)
+}
+
+// lockForWrite holds the read lock of the shard, after the range deletes covering the key have finished,
+// and the merge lock of the key, so the writes on the same key are serialized.
+// The caller stamps the write time, applies the write and appends it to the binlog before unlockForWrite,
+// so the binlog order matches the order the writes are applied.
+func (s *shard) lockForWrite(key []byte) {
+	for {
+		s.writeLock.RLock()
+		r := s.findRangeDelete(key)
+		if r == nil {
+			s.mergeLock(key).Lock()
+			return
+		}
+		s.writeLock.RUnlock()
+		<-r.done
+	}
+}
+
+func (s *shard) unlockForWrite(key []byte) {
+	s.mergeLock(key).Unlock()
+	s.writeLock.RUnlock()
+}
+
+func (s *shard) mergeLock(key []byte) *sync.Mutex {
+	return &s.mergeLocks[util.Hash(key)%uint64(len(s.mergeLocks))]
+}
+
+// findRangeDelete must be called with the writeLock held
+func (s *shard) findRangeDelete(key []byte) *rangeDelete {
+	for _, r := range s.rangeDeletes {
+		if r.covers(key) {
+			return r
+		}
+	}
+	return nil
+}
+
+// startRangeDelete waits for the writes in progress, and makes the new writes in [start, end) wait.
+// If updatedAtNs is 0, the range delete is stamped with the current time after the writes in progress.
+func (s *shard) startRangeDelete(start, end []byte, updatedAtNs uint64) (*rangeDelete, uint64) {
+	r := &rangeDelete{
+		start: start,
+		end:   end,
+		done:  make(chan struct{}),
+	}
+	s.writeLock.Lock()
+	if updatedAtNs == 0 {
+		updatedAtNs = uint64(time.Now().UnixNano())
+	}
+	s.rangeDeletes = append(s.rangeDeletes, r)
+	s.writeLock.Unlock()
+	return r, updatedAtNs
+}
+
+func (s *shard) finishRangeDelete(r *rangeDelete) {
+	s.writeLock.Lock()
+	for i, x := range s.rangeDeletes {
+		if x == r {
+			s.rangeDeletes = append(s.rangeDeletes[:i], s.rangeDeletes[i+1:]...)
+			break
+		}
+	}
+	s.writeLock.Unlock()
+	close(r.done)
+}
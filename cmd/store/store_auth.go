@@ -0,0 +1,56 @@
+package store
+
+import (
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
+	"github.com/chrislusf/vasto/pb"
+)
+
+// storeReadMethods only read the data, and are allowed with the read permission
+var storeReadMethods = map[string]bool{
+	"/pb.VastoStore/BootstrapCopy": true,
+	"/pb.VastoStore/TailBinlog":    true,
+	"/pb.VastoStore/CheckBinlog":   true,
+}
+
+// setAccessControl changes the access control list received from the master
+func (ss *storeServer) setAccessControl(acl *pb.AccessControlList) {
+	ss.accessControlLock.Lock()
+	ss.accessControl = auth.NewAccessControl(acl)
+	ss.accessControlLock.Unlock()
+	glog.V(1).Infof("%s access control enabled: %v", ss.storeName, acl != nil)
+}
+
+func (ss *storeServer) getAccessControl() *auth.AccessControl {
+	ss.accessControlLock.RLock()
+	defer ss.accessControlLock.RUnlock()
+	return ss.accessControl
+}
+
+// authorizeGrpc checks the token of the calls to the store admin port
+func (ss *storeServer) authorizeGrpc(token string, fullMethod string, req interface{}) error {
+
+	keyspace := auth.AllKeyspaces
+	switch r := req.(type) {
+	case *pb.SetKeyspaceQuotaRequest:
+		keyspace = r.GetQuota().GetKeyspace()
+	case interface{ GetKeyspace() string }:
+		keyspace = r.GetKeyspace()
+	}
+
+	permission := auth.Admin
+	if storeReadMethods[fullMethod] {
+		permission = auth.Read
+	}
+
+	return ss.getAccessControl().Authorize(token, keyspace, permission)
+}
+
+// authorizeRequest checks the token of one request on the data port
+func (ss *storeServer) authorizeRequest(token, keyspace string, request *pb.Request) error {
+	permission := auth.Write
+	if request.GetGet() != nil || request.GetGetByPrefix() != nil {
+		permission = auth.Read
+	}
+	return ss.getAccessControl().Authorize(token, keyspace, permission)
+}
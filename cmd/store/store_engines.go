@@ -0,0 +1,7 @@
+package store
+
+import (
+	// the pure-Go storage engines are always available
+	_ "github.com/chrislusf/vasto/storage/leveldb"
+	_ "github.com/chrislusf/vasto/storage/memory"
+)
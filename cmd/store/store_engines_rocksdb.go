@@ -0,0 +1,8 @@
+// +build cgo
+
+package store
+
+import (
+	// rocksdb needs cgo, so stores built with CGO_ENABLED=0 only have the pure-Go engines
+	_ "github.com/chrislusf/vasto/storage/rocks"
+)
@@ -12,6 +12,8 @@ import (
 	"time"
 )
 
+const replicationLagReportInterval = 5 * time.Second
+
 func (ss *storeServer) keepConnectedToMasterServer(ctx context.Context) {
 
 	util.RetryForever(ctx, "store connect to master", func() error {
@@ -29,7 +31,7 @@ func (ss *storeServer) selfAdminAddress() string {
 }
 
 func (ss *storeServer) registerAtMasterServer() error {
-	grpcConnection, err := grpc.Dial(*ss.option.Master, grpc.WithInsecure())
+	grpcConnection, err := grpc.Dial(*ss.option.Master, ss.clusterListener.DialOptions()...)
 	if err != nil {
 		return fmt.Errorf("fail to dial: %v", err)
 	}
@@ -53,6 +55,8 @@ func (ss *storeServer) registerAtMasterServer() error {
 			DiskSizeGb:   uint32(*ss.option.DiskSizeGb),
 			Tags:         strings.Split(*ss.option.Tags, ","),
 		},
+		KeyspaceQuotas:   ss.keyspaceQuotas(),
+		KeyspaceStorages: ss.keyspaceStorages(),
 	}
 
 	// glog.V(2).Infof("Reporting store %v", storeHeartbeat.StoreResource)
@@ -77,6 +81,8 @@ func (ss *storeServer) registerAtMasterServer() error {
 	defer close(finishChan)
 
 	go func() {
+		lagTicker := time.NewTicker(replicationLagReportInterval)
+		defer lagTicker.Stop()
 		for {
 			select {
 			case ShardInfo := <-ss.ShardInfoChan:
@@ -89,6 +95,14 @@ func (ss *storeServer) registerAtMasterServer() error {
 					glog.Errorf("send shard status %v: %v", storeHeartbeat, err)
 					return
 				}
+			case <-lagTicker.C:
+				storeHeartbeat = &pb.StoreHeartbeat{
+					ReplicationLags: ss.replicationLags(),
+				}
+				if err := stream.Send(storeHeartbeat); err != nil {
+					glog.Errorf("send replication lags: %v", err)
+					return
+				}
 			case <-finishChan:
 				return
 			}
@@ -118,4 +132,23 @@ func (ss *storeServer) sendShardInfoToMaster(ShardInfo *pb.ShardInfo, status pb.
 
 func (ss *storeServer) processStoreMessage(msg *pb.StoreMessage) {
 	glog.V(2).Infof("%s received message %v", ss.storeName, msg)
+	if msg.AccessControlDisabled {
+		ss.setAccessControl(nil)
+	} else if msg.AccessControlList != nil {
+		ss.setAccessControl(msg.AccessControlList)
+	}
+}
+
+// replicationLags collects the replication lags of all local shards
+func (ss *storeServer) replicationLags() (lags []*pb.ReplicationLag) {
+	ss.keyspaceShards.RLock()
+	defer ss.keyspaceShards.RUnlock()
+
+	now := time.Now()
+	for _, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, s := range shards {
+			lags = append(lags, s.replicationLags(now)...)
+		}
+	}
+	return
 }
@@ -3,12 +3,16 @@ package store
 import (
 	"net"
 
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/pb"
 	"google.golang.org/grpc"
 )
 
 func (ss *storeServer) serveGrpc(listener net.Listener) {
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(append(ss.tls.GrpcServerOptions(),
+		grpc.UnaryInterceptor(auth.UnaryServerInterceptor(ss.authorizeGrpc)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(ss.authorizeGrpc)),
+	)...)
 	pb.RegisterVastoStoreServer(grpcServer, ss)
 	grpcServer.Serve(listener)
 }
@@ -79,11 +79,13 @@ func (ss *storeServer) TailBinlog(request *pb.PullUpdateRequest, stream pb.Vasto
 			NextSegment: segment,
 			NextOffset:  uint64(nextOffset),
 		}
+		t.LagEntries, t.LagBytes, t.LatestUpdatedAtNs = shard.lm.Lag(segment, nextOffset)
 
 		for _, entry := range entries {
 
 			// glog.V(2).Infof("shard %v send0 %v: %v offset:%d", shard.String(), request.Origin, string(entry.Key), offset)
-			if targetClusterSize > 0 && jump.Hash(entry.GetPartitionHash(), targetClusterSize) != targetShardId {
+			// range deletes span all partitions
+			if targetClusterSize > 0 && entry.GetDeleteRange() == nil && jump.Hash(entry.GetPartitionHash(), targetClusterSize) != targetShardId {
 				// glog.V(2).Infof("shard %v send %v skipped: %v, hash:%v, targetClusterSize:%d, targetShardId:%d ", shard.String(), request.Origin, string(entry.Key), entry.PartitionHash, targetClusterSize, targetShardId)
 				continue
 			}
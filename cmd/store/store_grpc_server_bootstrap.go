@@ -66,6 +66,12 @@ func (ss *storeServer) BootstrapCopy(request *pb.BootstrapCopyRequest, stream pb
 			}
 		}
 
+		for _, row := range filteredRows {
+			if err := shard.decryptRow(row); err != nil {
+				return err
+			}
+		}
+
 		t := &pb.BootstrapCopyResponse{
 			KeyValues: filteredRows,
 		}
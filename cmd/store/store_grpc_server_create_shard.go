@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/crypt"
 	"github.com/chrislusf/vasto/topology"
 	"golang.org/x/net/context"
 	"os"
@@ -14,7 +16,7 @@ import (
 func (ss *storeServer) CreateShard(ctx context.Context, request *pb.CreateShardRequest) (*pb.CreateShardResponse, error) {
 
 	glog.V(1).Infof("%s create shard %v", ss.storeName, request)
-	err := ss.createShards(request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ReplicationFactor), false, func(shardId int) *topology.BootstrapPlan {
+	err := ss.createShards(request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ReplicationFactor), false, request.Quota, request.Storage, func(shardId int) *topology.BootstrapPlan {
 		return &topology.BootstrapPlan{
 			ToClusterSize: int(request.ClusterSize),
 		}
@@ -32,7 +34,11 @@ func (ss *storeServer) CreateShard(ctx context.Context, request *pb.CreateShardR
 
 }
 
-func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize, replicationFactor int, isCandidate bool, planGen func(shardId int) *topology.BootstrapPlan) error {
+func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize, replicationFactor int, isCandidate bool, quota *pb.KeyspaceQuota, keyspaceStorage *pb.KeyspaceStorage, planGen func(shardId int) *topology.BootstrapPlan) error {
+
+	if engine := keyspaceStorage.GetEngine(); engine != "" && !storage.HasEngine(engine) {
+		return fmt.Errorf("%s storage engine %q is not compiled in, choose from %v", ss.storeName, engine, storage.EngineNames())
+	}
 
 	var existingPrimaryShards []*pb.ClusterNode
 	if cluster, found := ss.clusterListener.GetCluster(keyspace); found {
@@ -62,6 +68,12 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 	}
 
 	localShards := ss.getOrCreateServerStatusInCluster(keyspace, serverId, clusterSize, replicationFactor)
+	if quota != nil {
+		localShards.Quota = quota
+	}
+	if keyspaceStorage != nil {
+		localShards.Storage = keyspaceStorage
+	}
 
 	for _, clusterShard := range topology.LocalShards(serverId, clusterSize, replicationFactor) {
 
@@ -105,23 +117,25 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 
 	}
 
-	return ss.saveClusterConfig(localShards, keyspace)
+	if err := ss.saveClusterConfig(localShards, keyspace); err != nil {
+		return err
+	}
+	ss.applyKeyspaceQuota(keyspace)
+
+	return nil
 
 }
 
 func (ss *storeServer) startExistingNodes(keyspaceName string, storeStatus *pb.LocalShardsInCluster) error {
+	ss.applyKeyspaceQuota(keyspaceName)
 	for _, shardInfo := range storeStatus.ShardMap {
 		shard, shardOpenError := ss.openShard(shardInfo)
 		if shardOpenError != nil {
 			return fmt.Errorf("%s open %s: %v", ss.storeName, shardInfo.IdentifierOnThisServer(), shardOpenError)
 		}
 
-		for fileId, meta := range shard.db.GetLiveFilesMetaData() {
-			glog.V(1).Infof("%s %d name:%s, level:%d size:%d SmallestKey:%s LargestKey:%s", ss.storeName, fileId, meta.Name, meta.Level, meta.Size, string(meta.SmallestKey), string(meta.LargestKey))
-			if meta.Level >= 6 {
-				shard.hasBackfilled = true
-			}
-		}
+		shard.hasBackfilled = shard.db.HasIngestedBehind()
+		shard.updateLiveFilesSize()
 
 		if err := shard.startWithBootstrapPlan(&topology.BootstrapPlan{
 			ToClusterSize: int(shardInfo.ClusterSize),
@@ -144,8 +158,10 @@ func (ss *storeServer) openShard(shardInfo *pb.ShardInfo) (shard *shard, err err
 		return nil, fmt.Errorf("%s mkdir %s: %v", ss.storeName, dir, err)
 	}
 
+	engine, profile := ss.keyspaceStorage(shardInfo.KeyspaceName)
 	shard = newShard(shardInfo.KeyspaceName, dir, int(shardInfo.ServerId), int(shardInfo.ShardId), cluster, ss.clusterListener,
-		int(shardInfo.ReplicationFactor), *ss.option.LogFileSizeMb, *ss.option.LogFileCount)
+		int(shardInfo.ReplicationFactor), *ss.option.LogFileSizeMb, *ss.option.LogFileCount, crypt.NewCipher(ss.keyProvider, shardInfo.KeyspaceName), engine,
+		storage.EngineOptions{Profile: profile, MemoryBudget: ss.memoryBudget, EnableStatistics: *ss.option.DbStatistics})
 	shard.setCompactionFilterClusterSize(int(shardInfo.ClusterSize))
 	// println("loading shard", shard.String())
 	ss.keyspaceShards.addShards(shardInfo.KeyspaceName, shard)
@@ -0,0 +1,32 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+	"golang.org/x/net/context"
+)
+
+// Hotspots returns the hottest keys of each local shard of the keyspace, and the recent slow requests.
+func (ss *storeServer) Hotspots(ctx context.Context, request *pb.HotspotsRequest) (*pb.HotspotsResponse, error) {
+
+	shards, found := ss.keyspaceShards.getShards(request.Keyspace)
+	if !found {
+		return &pb.HotspotsResponse{
+			Error: fmt.Sprintf("keyspace %s not found", request.Keyspace),
+		}, nil
+	}
+
+	resp := &pb.HotspotsResponse{
+		SlowOps: ss.slowOps.list(request.Keyspace, int(request.Limit)),
+	}
+	for _, shard := range shards {
+		resp.Shards = append(resp.Shards, &pb.HotspotsResponse_ShardHotKeys{
+			ShardId: uint32(shard.id),
+			HotKeys: shard.topHotKeys(int(request.Limit)),
+		})
+	}
+
+	return resp, nil
+
+}
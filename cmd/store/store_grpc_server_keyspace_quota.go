@@ -0,0 +1,34 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"golang.org/x/net/context"
+)
+
+// SetKeyspaceQuota saves the quota to the local cluster config and applies it to the keyspace limiter.
+func (ss *storeServer) SetKeyspaceQuota(ctx context.Context, request *pb.SetKeyspaceQuotaRequest) (*pb.SetKeyspaceQuotaResponse, error) {
+
+	glog.V(1).Infof("%s set keyspace quota %v", ss.storeName, request)
+
+	keyspace := request.Quota.GetKeyspace()
+	localShards, found := ss.getServerStatusInCluster(keyspace)
+	if !found {
+		return &pb.SetKeyspaceQuotaResponse{
+			Error: fmt.Sprintf("keyspace %s not found", keyspace),
+		}, nil
+	}
+
+	localShards.Quota = request.Quota
+	if err := ss.saveClusterConfig(localShards, keyspace); err != nil {
+		return &pb.SetKeyspaceQuotaResponse{
+			Error: err.Error(),
+		}, nil
+	}
+	ss.applyKeyspaceQuota(keyspace)
+
+	return &pb.SetKeyspaceQuotaResponse{}, nil
+
+}
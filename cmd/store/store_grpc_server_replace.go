@@ -66,7 +66,7 @@ func (ss *storeServer) ReplicateNodeCleanup(ctx context.Context, request *pb.Rep
 
 func (ss *storeServer) replicateNode(request *pb.ReplicateNodePrepareRequest) (err error) {
 
-	err = ss.createShards(request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ReplicationFactor), true, func(shardId int) *topology.BootstrapPlan {
+	err = ss.createShards(request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ReplicationFactor), true, request.Quota, request.Storage, func(shardId int) *topology.BootstrapPlan {
 
 		return topology.BootstrapPlanWithTopoChange(&topology.BootstrapRequest{
 			ServerId:          int(request.ServerId),
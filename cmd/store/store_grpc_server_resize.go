@@ -5,6 +5,8 @@ import (
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/topology"
+	"github.com/chrislusf/vasto/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/net/context"
 	"os"
 )
@@ -13,7 +15,12 @@ import (
 func (ss *storeServer) ResizePrepare(ctx context.Context, request *pb.ResizeCreateShardRequest) (*pb.ResizeCreateShardResponse, error) {
 
 	glog.V(1).Infof("resize prepare %v", request)
+	ctx, span := tracing.Start(tracing.IncomingGrpcContext(ctx), "vasto.store.resize.prepare",
+		attribute.String("keyspace", request.Keyspace),
+		attribute.Int("target_cluster_size", int(request.TargetClusterSize)),
+	)
 	err := ss.resizeCreateShards(ctx, request)
+	tracing.End(span, err)
 	if err != nil {
 		glog.Errorf("resize prepare %v: %v", request, err)
 		return &pb.ResizeCreateShardResponse{
@@ -31,7 +38,12 @@ func (ss *storeServer) ResizePrepare(ctx context.Context, request *pb.ResizeCrea
 func (ss *storeServer) ResizeCommit(ctx context.Context, request *pb.ResizeCommitRequest) (*pb.ResizeCommitResponse, error) {
 
 	glog.V(1).Infof("resize commit %v", request)
+	ctx, span := tracing.Start(tracing.IncomingGrpcContext(ctx), "vasto.store.resize.commit",
+		attribute.String("keyspace", request.Keyspace),
+		attribute.Int("target_cluster_size", int(request.TargetClusterSize)),
+	)
 	err := ss.resizeCommitShardInfoNewCluster(ctx, request)
+	tracing.End(span, err)
 	if err != nil {
 		glog.Errorf("resize commit %v: %v", request, err)
 		return &pb.ResizeCommitResponse{
@@ -49,7 +61,12 @@ func (ss *storeServer) ResizeCommit(ctx context.Context, request *pb.ResizeCommi
 func (ss *storeServer) ResizeCleanup(ctx context.Context, request *pb.ResizeCleanupRequest) (*pb.ResizeCleanupResponse, error) {
 
 	glog.V(1).Infof("cleanup old shards %v", request)
+	ctx, span := tracing.Start(tracing.IncomingGrpcContext(ctx), "vasto.store.resize.cleanup",
+		attribute.String("keyspace", request.Keyspace),
+		attribute.Int("target_cluster_size", int(request.TargetClusterSize)),
+	)
 	err := ss.deleteOldShardsInNewCluster(ctx, request)
+	tracing.End(span, err)
 	if err != nil {
 		glog.Errorf("cleanup old shards %v: %v", request, err)
 		return &pb.ResizeCleanupResponse{
@@ -69,7 +86,7 @@ func (ss *storeServer) resizeCreateShards(ctx context.Context, request *pb.Resiz
 		shard.db.PrepareForClusterResize()
 	})
 
-	err = ss.createShards(request.Keyspace, int(request.ServerId), int(request.TargetClusterSize), int(request.ReplicationFactor), true, func(shardId int) *topology.BootstrapPlan {
+	err = ss.createShards(request.Keyspace, int(request.ServerId), int(request.TargetClusterSize), int(request.ReplicationFactor), true, request.Quota, request.Storage, func(shardId int) *topology.BootstrapPlan {
 
 		return topology.BootstrapPlanWithTopoChange(&topology.BootstrapRequest{
 			ServerId:          int(request.ServerId),
@@ -156,6 +173,7 @@ func (ss *storeServer) deleteOldShardsInNewCluster(ctx context.Context, request
 			os.RemoveAll(dir)
 			ss.keyspaceShards.deleteKeyspace(request.Keyspace)
 			ss.deleteServerStatusInCluster(request.Keyspace)
+			ss.deleteKeyspaceLimiter(request.Keyspace)
 			ss.clusterListener.RemoveKeyspace(request.Keyspace)
 		} else {
 			for _, shard := range shards {
@@ -165,6 +183,8 @@ func (ss *storeServer) deleteOldShardsInNewCluster(ctx context.Context, request
 			}
 			localShardsStatus.ClusterSize = request.TargetClusterSize
 			ss.saveClusterConfig(localShardsStatus, request.Keyspace)
+			// the share of the quota changes with the cluster size
+			ss.applyKeyspaceQuota(request.Keyspace)
 		}
 	}
 
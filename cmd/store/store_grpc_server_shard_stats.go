@@ -0,0 +1,42 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"golang.org/x/net/context"
+)
+
+// ShardStats returns the db statistics of each local shard of the keyspace.
+func (ss *storeServer) ShardStats(ctx context.Context, request *pb.ShardStatsRequest) (*pb.ShardStatsResponse, error) {
+
+	shards, found := ss.keyspaceShards.getShards(request.Keyspace)
+	if !found {
+		return &pb.ShardStatsResponse{
+			Error: fmt.Sprintf("keyspace %s not found", request.Keyspace),
+		}, nil
+	}
+
+	resp := &pb.ShardStatsResponse{}
+	for _, shard := range shards {
+		var dbStats *pb.DbStats
+		if reporter, ok := shard.db.(storage.StatsReporter); ok {
+			dbStats = reporter.DbStats()
+		} else {
+			size := shard.db.LiveFilesSize()
+			dbStats = &pb.DbStats{
+				LiveSstSize:  size,
+				TotalSstSize: size,
+			}
+		}
+		if reporter, ok := shard.db.(storage.MemoryReporter); ok && dbStats.MemtableSize == 0 {
+			dbStats.MemtableSize = reporter.MemoryUsage()
+		}
+		dbStats.ShardId = uint32(shard.id)
+		resp.Shards = append(resp.Shards, dbStats)
+	}
+
+	return resp, nil
+
+}
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"net/http"
+	"sort"
+	"sync/atomic"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/stats"
+)
+
+type storeReadiness struct {
+	Ready   bool             `json:"ready"`
+	Started bool             `json:"started"`
+	Shards  []shardReadiness `json:"shards"`
+}
+
+type shardReadiness struct {
+	Shard            string `json:"shard"`
+	Status           string `json:"status"`
+	FollowsConnected int    `json:"follows_connected"`
+	Follows          int    `json:"follows"`
+	Ready            bool   `json:"ready"`
+}
+
+// handleReady reports whether the store can take traffic, i.e., the existing shards are loaded,
+// and every local shard is bootstrapped, ready, and tailing the binlogs of all its peers.
+func (ss *storeServer) handleReady(w http.ResponseWriter, r *http.Request) {
+
+	readiness := &storeReadiness{
+		Started: atomic.LoadInt32(&ss.hasStarted) == 1,
+	}
+	readiness.Ready = readiness.Started
+
+	ss.keyspaceShards.RLock()
+	for _, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, s := range shards {
+			connected, total := s.followConnections()
+			status := s.getStatus()
+			t := shardReadiness{
+				Shard:            s.String(),
+				Status:           status.String(),
+				FollowsConnected: connected,
+				Follows:          total,
+				Ready:            status == pb.ShardInfo_READY && connected == total,
+			}
+			readiness.Shards = append(readiness.Shards, t)
+			readiness.Ready = readiness.Ready && t.Ready
+		}
+	}
+	ss.keyspaceShards.RUnlock()
+
+	sort.Slice(readiness.Shards, func(i, j int) bool {
+		return readiness.Shards[i].Shard < readiness.Shards[j].Shard
+	})
+
+	stats.WriteReadiness(w, readiness.Ready, readiness)
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"sync/atomic"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+)
+
+const (
+	throttledStatus         = "throttled"
+	diskQuotaExceededStatus = "disk quota exceeded"
+)
+
+// keyspaceLimiter enforces this store's share of the keyspace quota
+type keyspaceLimiter struct {
+	ops               *util.TokenBucket
+	bytes             *util.TokenBucket
+	maxShardDiskBytes uint64
+}
+
+func newKeyspaceLimiter(quota *pb.KeyspaceQuota, clusterSize int) *keyspaceLimiter {
+	l := &keyspaceLimiter{
+		ops:   util.NewTokenBucket(0),
+		bytes: util.NewTokenBucket(0),
+	}
+	l.setQuota(quota, clusterSize)
+	return l
+}
+
+func (l *keyspaceLimiter) setQuota(quota *pb.KeyspaceQuota, clusterSize int) {
+	if clusterSize <= 0 {
+		clusterSize = 1
+	}
+	l.ops.SetRate(shareOf(quota.GetOpsPerSecond(), clusterSize))
+	l.bytes.SetRate(shareOf(quota.GetBytesPerSecond(), clusterSize))
+	atomic.StoreUint64(&l.maxShardDiskBytes, shareOf(uint64(quota.GetTotalDiskSizeGb())<<30, clusterSize))
+}
+
+func shareOf(limit uint64, clusterSize int) uint64 {
+	if limit == 0 {
+		return 0
+	}
+	share := limit / uint64(clusterSize)
+	if share == 0 {
+		share = 1
+	}
+	return share
+}
+
+// applyKeyspaceQuota loads the quota saved in the local cluster config into the keyspace limiter
+func (ss *storeServer) applyKeyspaceQuota(keyspace string) {
+
+	localShards, found := ss.getServerStatusInCluster(keyspace)
+	if !found {
+		return
+	}
+
+	ss.keyspaceLimitersLock.Lock()
+	defer ss.keyspaceLimitersLock.Unlock()
+
+	if l, found := ss.keyspaceLimiters[keyspace]; found {
+		l.setQuota(localShards.Quota, int(localShards.ClusterSize))
+	} else {
+		ss.keyspaceLimiters[keyspace] = newKeyspaceLimiter(localShards.Quota, int(localShards.ClusterSize))
+	}
+	glog.V(1).Infof("%s keyspace %s quota: %v", ss.storeName, keyspace, localShards.Quota)
+
+}
+
+func (ss *storeServer) deleteKeyspaceLimiter(keyspace string) {
+	ss.keyspaceLimitersLock.Lock()
+	delete(ss.keyspaceLimiters, keyspace)
+	ss.keyspaceLimitersLock.Unlock()
+}
+
+func (ss *storeServer) getKeyspaceLimiter(keyspace string) *keyspaceLimiter {
+	ss.keyspaceLimitersLock.RLock()
+	defer ss.keyspaceLimitersLock.RUnlock()
+	return ss.keyspaceLimiters[keyspace]
+}
+
+// keyspaceQuotas lists the quotas of all local keyspaces, to be reported to the master
+func (ss *storeServer) keyspaceQuotas() (quotas []*pb.KeyspaceQuota) {
+	ss.statusInClusterLock.RLock()
+	defer ss.statusInClusterLock.RUnlock()
+	for _, localShards := range ss.statusInCluster {
+		if localShards.Quota != nil {
+			quotas = append(quotas, localShards.Quota)
+		}
+	}
+	return
+}
+
+// checkQuota returns a failed response if the request is over the keyspace quota, or nil if it can proceed
+func (ss *storeServer) checkQuota(keyspace string, shard *shard, command *pb.Request) *pb.Response {
+
+	l := ss.getKeyspaceLimiter(keyspace)
+	if l == nil {
+		return nil
+	}
+
+	if command.GetPut() != nil || command.GetMerge() != nil {
+		if maxBytes := atomic.LoadUint64(&l.maxShardDiskBytes); maxBytes > 0 && shard.getLiveFilesSize() >= maxBytes {
+			storeThrottledCounter.WithLabelValues(keyspace, "disk").Inc()
+			return newErrorResponse(command, diskQuotaExceededStatus)
+		}
+	}
+
+	if !l.ops.TryTake(1) {
+		storeThrottledCounter.WithLabelValues(keyspace, "ops").Inc()
+		return newThrottledResponse(command)
+	}
+	if !l.bytes.TryTake(uint64(valueSize(command, nil))) {
+		storeThrottledCounter.WithLabelValues(keyspace, "bytes").Inc()
+		return newThrottledResponse(command)
+	}
+
+	return nil
+}
+
+// chargeReadBytes counts the bytes read, which are only known after the request is processed
+func (ss *storeServer) chargeReadBytes(keyspace string, command *pb.Request, response *pb.Response) {
+	if command.GetGet() == nil && command.GetGetByPrefix() == nil {
+		return
+	}
+	if l := ss.getKeyspaceLimiter(keyspace); l != nil {
+		l.bytes.Take(uint64(valueSize(command, response)))
+	}
+}
+
+// newThrottledResponse creates a failed response for the request rejected by the rate limits
+func newThrottledResponse(command *pb.Request) *pb.Response {
+	response := newErrorResponse(command, throttledStatus)
+	if response.Get != nil {
+		response.Get.IsThrottled = true
+	} else if response.GetByPrefix != nil {
+		response.GetByPrefix.IsThrottled = true
+	} else {
+		response.Write.IsThrottled = true
+	}
+	return response
+}
+
+// updateLiveFilesSize caches the db size, which is too costly to read for every request
+func (s *shard) updateLiveFilesSize() {
+	atomic.StoreUint64(&s.liveFilesSize, s.db.LiveFilesSize())
+}
+
+func (s *shard) getLiveFilesSize() uint64 {
+	return atomic.LoadUint64(&s.liveFilesSize)
+}
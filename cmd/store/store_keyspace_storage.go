@@ -0,0 +1,32 @@
+package store
+
+import (
+	"github.com/chrislusf/vasto/pb"
+)
+
+// keyspaceStorage returns the storage engine and profile chosen when the keyspace is created.
+// The engine defaults to the one of this store.
+func (ss *storeServer) keyspaceStorage(keyspace string) (engine string, profile *pb.StorageProfile) {
+	ss.statusInClusterLock.RLock()
+	defer ss.statusInClusterLock.RUnlock()
+	engine = *ss.option.Engine
+	if localShards, found := ss.statusInCluster[keyspace]; found {
+		if localShards.Storage.GetEngine() != "" {
+			engine = localShards.Storage.GetEngine()
+		}
+		profile = localShards.Storage.GetProfile()
+	}
+	return
+}
+
+// keyspaceStorages lists the storage of the local keyspaces, reported to the master in the initial heartbeat
+func (ss *storeServer) keyspaceStorages() (keyspaceStorages []*pb.KeyspaceStorage) {
+	ss.statusInClusterLock.RLock()
+	defer ss.statusInClusterLock.RUnlock()
+	for _, localShards := range ss.statusInCluster {
+		if localShards.Storage != nil {
+			keyspaceStorages = append(keyspaceStorages, localShards.Storage)
+		}
+	}
+	return
+}
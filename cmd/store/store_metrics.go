@@ -0,0 +1,175 @@
+package store
+
+import (
+	"strconv"
+
+	"github.com/chrislusf/vasto/stats"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	storeRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "vasto",
+			Subsystem: "store",
+			Name:      "requests_total",
+			Help:      "Number of requests by keyspace, shard and op type.",
+		}, []string{"keyspace", "shard", "op"})
+
+	storeRequestHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "vasto",
+			Subsystem: "store",
+			Name:      "request_seconds",
+			Help:      "Request latency by keyspace, shard and op type.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 18),
+		}, []string{"keyspace", "shard", "op"})
+
+	storeBootstrapRowCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "vasto",
+			Subsystem: "store",
+			Name:      "bootstrap_rows_total",
+			Help:      "Number of rows received when bootstrapping the shard.",
+		}, []string{"keyspace", "shard"})
+
+	storeBootstrapGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "vasto",
+			Subsystem: "store",
+			Name:      "bootstrap_in_progress",
+			Help:      "Number of bootstrap steps in progress, greater than 0 if the shard is bootstrapping.",
+		}, []string{"keyspace", "shard"})
+
+	storeThrottledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "vasto",
+			Subsystem: "store",
+			Name:      "throttled_total",
+			Help:      "Number of requests rejected by the keyspace quota, by keyspace and reason.",
+		}, []string{"keyspace", "reason"})
+
+	storeDbSizeDesc = prometheus.NewDesc(
+		"vasto_store_db_live_files_bytes",
+		"Size of the RocksDB live files of the shard.",
+		[]string{"keyspace", "shard"}, nil)
+
+	storeMemoryDesc = prometheus.NewDesc(
+		"vasto_store_memory_bytes",
+		"Memory used by the dbs of the keyspace, e.g., memtables and table readers, not counting the shared block cache.",
+		[]string{"keyspace"}, nil)
+
+	storeBlockCacheDesc = prometheus.NewDesc(
+		"vasto_store_block_cache_bytes",
+		"Usage and capacity of the block cache shared by all shards on the store.",
+		[]string{"kind"}, nil)
+
+	storeBinlogSegmentDesc = prometheus.NewDesc(
+		"vasto_store_binlog_segment",
+		"The earliest and latest binlog segment of the shard.",
+		[]string{"keyspace", "shard", "position"}, nil)
+
+	storeFollowSegmentDesc = prometheus.NewDesc(
+		"vasto_store_follow_segment",
+		"The next binlog segment to follow from the source shard.",
+		[]string{"keyspace", "shard", "source", "source_shard"}, nil)
+
+	storeFollowOffsetDesc = prometheus.NewDesc(
+		"vasto_store_follow_offset",
+		"The next binlog offset to follow from the source shard.",
+		[]string{"keyspace", "shard", "source", "source_shard"}, nil)
+)
+
+// newMetricsServer creates the http server of the store metrics
+func (ss *storeServer) newMetricsServer() *stats.HttpServer {
+	httpServer := stats.NewHttpServer(ss.storeName)
+	httpServer.MustRegister(
+		storeRequestCounter,
+		storeRequestHistogram,
+		storeBootstrapRowCounter,
+		storeBootstrapGauge,
+		storeThrottledCounter,
+		&storeCollector{ss: ss},
+	)
+	return httpServer
+}
+
+// storeCollector reads the shard states when the metrics are scraped
+type storeCollector struct {
+	ss *storeServer
+}
+
+func (c *storeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- storeDbSizeDesc
+	ch <- storeMemoryDesc
+	ch <- storeBlockCacheDesc
+	ch <- storeBinlogSegmentDesc
+	ch <- storeFollowSegmentDesc
+	ch <- storeFollowOffsetDesc
+}
+
+func (c *storeCollector) Collect(ch chan<- prometheus.Metric) {
+	c.ss.keyspaceShards.RLock()
+	defer c.ss.keyspaceShards.RUnlock()
+
+	if budget := c.ss.memoryBudget; budget != nil {
+		ch <- prometheus.MustNewConstMetric(storeBlockCacheDesc, prometheus.GaugeValue,
+			float64(budget.BlockCacheUsage()), "usage")
+		ch <- prometheus.MustNewConstMetric(storeBlockCacheDesc, prometheus.GaugeValue,
+			float64(budget.BlockCacheBytes), "capacity")
+	}
+
+	for keyspace, shards := range c.ss.keyspaceShards.keyspaceToShards {
+		var memoryUsage uint64
+		hasMemoryUsage := false
+		for _, s := range shards {
+			if reporter, ok := s.db.(storage.MemoryReporter); ok {
+				memoryUsage += reporter.MemoryUsage()
+				hasMemoryUsage = true
+			}
+		}
+		if hasMemoryUsage {
+			ch <- prometheus.MustNewConstMetric(storeMemoryDesc, prometheus.GaugeValue,
+				float64(memoryUsage), string(keyspace))
+		}
+
+		for _, s := range shards {
+			shardLabel := strconv.Itoa(int(s.id))
+
+			ch <- prometheus.MustNewConstMetric(storeDbSizeDesc, prometheus.GaugeValue,
+				float64(s.db.LiveFilesSize()), s.keyspace, shardLabel)
+
+			if s.lm != nil {
+				earliest, latest := s.lm.GetSegmentRange()
+				ch <- prometheus.MustNewConstMetric(storeBinlogSegmentDesc, prometheus.GaugeValue,
+					float64(earliest), s.keyspace, shardLabel, "earliest")
+				ch <- prometheus.MustNewConstMetric(storeBinlogSegmentDesc, prometheus.GaugeValue,
+					float64(latest), s.keyspace, shardLabel, "latest")
+			}
+
+			s.followProgressLock.Lock()
+			for pk, pv := range s.followProgress {
+				sourceShard := strconv.Itoa(int(pk.shardId))
+				ch <- prometheus.MustNewConstMetric(storeFollowSegmentDesc, prometheus.GaugeValue,
+					float64(pv.segment), s.keyspace, shardLabel, pk.serverAdminAddress, sourceShard)
+				ch <- prometheus.MustNewConstMetric(storeFollowOffsetDesc, prometheus.GaugeValue,
+					float64(pv.offset), s.keyspace, shardLabel, pk.serverAdminAddress, sourceShard)
+			}
+			s.followProgressLock.Unlock()
+		}
+	}
+}
+
+func (s *shard) bootstrapLabels() prometheus.Labels {
+	return prometheus.Labels{"keyspace": s.keyspace, "shard": strconv.Itoa(int(s.id))}
+}
+
+func (s *shard) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("keyspace", s.keyspace),
+		attribute.Int("server", int(s.serverId)),
+		attribute.Int("shard", int(s.id)),
+	}
+}
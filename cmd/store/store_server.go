@@ -7,11 +7,19 @@ import (
 
 	"context"
 	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/security"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/crypt"
 	"github.com/chrislusf/vasto/topology/clusterlistener"
+	"github.com/chrislusf/vasto/tracing"
 	"github.com/chrislusf/vasto/util"
 	"github.com/chrislusf/vasto/util/interrupt"
+	"google.golang.org/grpc"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // StoreOption has options to run a data store
@@ -29,6 +37,16 @@ type StoreOption struct {
 	Tags              *string
 	DisableUseEventIo *bool
 	DisableBinLog     *bool
+	HttpAddress       *string
+	TraceExporter     *string
+	SlowOpThreshold   *time.Duration
+	SlowOpLogSize     *int
+	Token             *string
+	Tls               *security.TlsOption
+	EncryptionKeyFile *string
+	Engine            *string
+	MemoryBudgetMb    *int
+	DbStatistics      *bool
 }
 
 // GetAdminPort returns the admin port of the store, which is the data port plus 10000
@@ -37,14 +55,28 @@ func (o *StoreOption) GetAdminPort() int32 {
 }
 
 type storeServer struct {
-	option              *StoreOption
-	clusterListener     *clusterlistener.ClusterListener
-	ShardInfoChan       chan *pb.ShardInfo
-	statusInCluster     map[string]*pb.LocalShardsInCluster // saved to disk
-	statusInClusterLock sync.RWMutex
-	periodTasks         []periodicTask
-	keyspaceShards      *keyspaceShards
-	storeName           string
+	option               *StoreOption
+	clusterListener      *clusterlistener.ClusterListener
+	ShardInfoChan        chan *pb.ShardInfo
+	statusInCluster      map[string]*pb.LocalShardsInCluster // saved to disk
+	statusInClusterLock  sync.RWMutex
+	periodTasks          []periodicTask
+	keyspaceShards       *keyspaceShards
+	storeName            string
+	slowOps              *slowOpLog
+	keyspaceLimiters     map[string]*keyspaceLimiter
+	keyspaceLimitersLock sync.RWMutex
+	credentials          *auth.TokenCredentials
+	accessControl        *auth.AccessControl
+	accessControlLock    sync.RWMutex
+	// nil if TLS is not enabled
+	tls *security.Tls
+	// nil if encryption at rest is not enabled
+	keyProvider crypt.KeyProvider
+	// nil if the memory is not capped
+	memoryBudget *storage.MemoryBudget
+	// 1 after the existing shards are loaded and the tcp servers are started
+	hasStarted int32
 }
 
 // RunStore starts a store process
@@ -55,14 +87,36 @@ func RunStore(option *StoreOption) {
 	ctx := context.Background()
 	clusterListener := clusterlistener.NewClusterListener(storeName)
 
+	if !storage.HasEngine(*option.Engine) {
+		glog.Fatalf("%s storage engine %q is not compiled in, choose from %v", storeName, *option.Engine, storage.EngineNames())
+	}
+
 	var ss = &storeServer{
-		option:          option,
-		clusterListener: clusterListener,
-		ShardInfoChan:   make(chan *pb.ShardInfo),
-		statusInCluster: make(map[string]*pb.LocalShardsInCluster),
-		keyspaceShards:  newKeyspaceShards(),
-		storeName:       storeName,
+		option:           option,
+		clusterListener:  clusterListener,
+		ShardInfoChan:    make(chan *pb.ShardInfo),
+		statusInCluster:  make(map[string]*pb.LocalShardsInCluster),
+		keyspaceShards:   newKeyspaceShards(),
+		storeName:        storeName,
+		slowOps:          newSlowOpLog(*option.SlowOpThreshold, *option.SlowOpLogSize),
+		keyspaceLimiters: make(map[string]*keyspaceLimiter),
+		credentials:      auth.NewTokenCredentials(*option.Token),
+		tls:              security.MustNewTls(option.Tls),
+	}
+	if *option.EncryptionKeyFile != "" {
+		keyProvider, err := crypt.NewKeyFileProvider(*option.EncryptionKeyFile)
+		if err != nil {
+			glog.Fatalf("%s load encryption keys: %v", storeName, err)
+		}
+		ss.keyProvider = keyProvider
 	}
+	if *option.MemoryBudgetMb > 0 {
+		ss.memoryBudget = storage.NewMemoryBudget(*option.MemoryBudgetMb)
+	}
+	// deny all until the master sends the access control list, or disables the access control
+	ss.setAccessControl(&pb.AccessControlList{})
+	clusterListener.SetTls(ss.tls)
+	clusterListener.SetDialOptions(grpc.WithPerRPCCredentials(ss.credentials))
 	go ss.startPeriodTasks()
 
 	// ss.clusterListener.RegisterShardEventProcessor(&clusterlistener.ClusterEventLogger{})
@@ -126,6 +180,17 @@ func RunStore(option *StoreOption) {
 		}
 	}
 
+	atomic.StoreInt32(&ss.hasStarted, 1)
+
+	httpServer := ss.newMetricsServer()
+	httpServer.HandleFunc("/store/ready", ss.handleReady)
+	httpServer.Start(*option.HttpAddress)
+	if shutdownTracing, err := tracing.Init(ss.storeName, *option.TraceExporter); err != nil {
+		glog.Fatal(err)
+	} else {
+		interrupt.OnInterrupt(shutdownTracing, nil)
+	}
+
 	glog.V(2).Infof("%s Vasto store starts on %s", ss.storeName, *option.Dir)
 
 	select {}
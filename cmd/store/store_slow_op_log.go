@@ -0,0 +1,116 @@
+package store
+
+import (
+	"sync"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// maxSlowOpKeyPrefix limits how much of the key is kept in the slow op log
+const maxSlowOpKeyPrefix = 64
+
+// slowOpLog is a ring buffer of the latest requests slower than the threshold
+type slowOpLog struct {
+	sync.Mutex
+	threshold time.Duration
+	ops       []*pb.SlowOp
+	next      int
+	isFull    bool
+}
+
+// newSlowOpLog keeps the latest size slow ops. The log is disabled if size is 0 or less.
+func newSlowOpLog(threshold time.Duration, size int) *slowOpLog {
+	if size < 0 {
+		size = 0
+	}
+	return &slowOpLog{
+		threshold: threshold,
+		ops:       make([]*pb.SlowOp, size),
+	}
+}
+
+// maybeAdd records the request if it took longer than the threshold
+func (l *slowOpLog) maybeAdd(keyspace string, request *pb.Request, response *pb.Response, startTime time.Time, latency time.Duration) {
+	if len(l.ops) == 0 || l.threshold <= 0 || latency < l.threshold {
+		return
+	}
+
+	keyPrefix := requestKey(request)
+	if len(keyPrefix) > maxSlowOpKeyPrefix {
+		keyPrefix = keyPrefix[:maxSlowOpKeyPrefix]
+	}
+
+	op := &pb.SlowOp{
+		Keyspace:    keyspace,
+		ShardId:     request.ShardId,
+		Op:          request.OpName(),
+		KeyPrefix:   append([]byte(nil), keyPrefix...),
+		LatencyNs:   uint64(latency),
+		ValueSize:   uint64(valueSize(request, response)),
+		StartedAtNs: uint64(startTime.UnixNano()),
+	}
+
+	l.Lock()
+	l.ops[l.next] = op
+	l.next++
+	if l.next == len(l.ops) {
+		l.next, l.isFull = 0, true
+	}
+	l.Unlock()
+}
+
+// list returns at most limit slow ops of the keyspace, newest first. All are returned if limit is 0.
+func (l *slowOpLog) list(keyspace string, limit int) (ops []*pb.SlowOp) {
+	l.Lock()
+	defer l.Unlock()
+
+	count := l.next
+	if l.isFull {
+		count = len(l.ops)
+	}
+	for i := 1; i <= count; i++ {
+		op := l.ops[(l.next-i+len(l.ops))%len(l.ops)]
+		if op.Keyspace != keyspace {
+			continue
+		}
+		ops = append(ops, op)
+		if limit > 0 && len(ops) >= limit {
+			break
+		}
+	}
+	return
+}
+
+// requestKey returns the key of the request, the prefix for prefix queries, or the start of range deletes
+func requestKey(request *pb.Request) []byte {
+	if request.GetGet() != nil {
+		return request.Get.Key
+	} else if request.GetPut() != nil {
+		return request.Put.Key
+	} else if request.GetMerge() != nil {
+		return request.Merge.Key
+	} else if request.GetDelete() != nil {
+		return request.Delete.Key
+	} else if request.GetDeleteRange() != nil {
+		return request.DeleteRange.Start
+	} else if request.GetGetByPrefix() != nil {
+		return request.GetByPrefix.Prefix
+	}
+	return nil
+}
+
+// valueSize returns the size of the values written by the request, or read in the response
+func valueSize(request *pb.Request, response *pb.Response) (size int) {
+	if request.GetPut() != nil {
+		return len(request.Put.Value)
+	} else if request.GetMerge() != nil {
+		return len(request.Merge.Value)
+	} else if response.GetGet() != nil {
+		return len(response.Get.GetKeyValue().GetValue())
+	}
+	for _, keyValue := range response.GetGetByPrefix().GetKeyValues() {
+		size += len(keyValue.Value)
+	}
+	return
+}
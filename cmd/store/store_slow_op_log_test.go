@@ -0,0 +1,30 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestSlowOpLog(t *testing.T) {
+
+	request := &pb.Request{Get: &pb.GetRequest{Key: []byte("k")}}
+
+	l := newSlowOpLog(time.Millisecond, 2)
+	for _, latency := range []time.Duration{time.Microsecond, time.Second, 2 * time.Second, 3 * time.Second} {
+		l.maybeAdd("ks", request, &pb.Response{}, time.Now(), latency)
+	}
+	ops := l.list("ks", 0)
+	if len(ops) != 2 || time.Duration(ops[0].LatencyNs) != 3*time.Second || time.Duration(ops[1].LatencyNs) != 2*time.Second {
+		t.Errorf("slow ops %v", ops)
+	}
+
+	for _, size := range []int{0, -1} {
+		l := newSlowOpLog(time.Millisecond, size)
+		l.maybeAdd("ks", request, &pb.Response{}, time.Now(), time.Second)
+		if ops := l.list("ks", 0); len(ops) != 0 {
+			t.Errorf("slow op log of size %d has %v", size, ops)
+		}
+	}
+}
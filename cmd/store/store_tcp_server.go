@@ -2,17 +2,25 @@ package store
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"net"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/tracing"
 	"github.com/chrislusf/vasto/util"
 	"github.com/golang/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// maxInFlightRequestsPerConnection limits how many pipelined Requests are processed concurrently for one connection
+const maxInFlightRequestsPerConnection = 64
+
 // Run starts the heartbeating to master and starts accepting requests.
 func (ss *storeServer) serveTcp(listener net.Listener) {
 
@@ -33,8 +41,15 @@ func (ss *storeServer) serveTcp(listener net.Listener) {
 				c.SetNoDelay(true)
 				c.SetLinger(0)
 			}
-			ss.handleConnection(conn)
-			conn.Close()
+			// only tcp connections are encrypted, unix sockets are local
+			tlsConn, err := ss.tls.ServerConn(conn)
+			if err != nil {
+				glog.V(1).Infof("tls handshake with %v: %v", conn.RemoteAddr(), err)
+				conn.Close()
+				return
+			}
+			ss.handleConnection(tlsConn)
+			tlsConn.Close()
 		}()
 	}
 }
@@ -43,34 +58,52 @@ func (ss *storeServer) handleConnection(conn net.Conn) {
 
 	reader := bufio.NewReader(conn)
 
+	var writeLock sync.Mutex
+	var wg sync.WaitGroup
+	inFlight := make(chan struct{}, maxInFlightRequestsPerConnection)
+
 	for {
-		if err := ss.handleRequest(reader, conn); err != nil {
+		input, err := util.ReadMessage(reader)
+		if err != nil {
 			if err != io.EOF {
-				glog.Errorf("handleRequest: %v", err)
+				glog.Errorf("read message: %v", err)
 			}
-			return
+			break
 		}
-	}
 
-}
+		receivedAt := time.Now()
 
-func (ss *storeServer) handleRequest(reader io.Reader, writer io.Writer) error {
+		inFlight <- struct{}{}
+		wg.Add(1)
+		go func(input []byte) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+			if err := ss.handleRequest(input, receivedAt, conn, &writeLock); err != nil {
+				glog.Errorf("handleRequest: %v", err)
+				// the client can not match the responses any more
+				conn.Close()
+			}
+		}(input)
+	}
 
-	var input, output []byte
-	var err error
+	wg.Wait()
 
-	input, err = util.ReadMessage(reader)
+}
 
-	if err == io.EOF {
-		return err
-	}
+// handleRequest processes one Requests message. Several of them can be processed concurrently
+// on one connection, and the responses are written back as they complete.
+func (ss *storeServer) handleRequest(input []byte, receivedAt time.Time, writer io.Writer, writeLock *sync.Mutex) error {
+
+	output, err := ss.handleInputOutput(input, receivedAt)
 	if err != nil {
-		return fmt.Errorf("read message: %v", err)
+		return err
 	}
 
-	output, err = ss.handleInputOutput(input)
-
+	writeLock.Lock()
 	err = util.WriteMessage(writer, output)
+	writeLock.Unlock()
 	if err != nil {
 		return fmt.Errorf("write message: %v", err)
 	}
@@ -79,16 +112,39 @@ func (ss *storeServer) handleRequest(reader io.Reader, writer io.Writer) error {
 
 }
 
-func (ss *storeServer) handleInputOutput(input []byte) (output []byte, err error) {
+func (ss *storeServer) handleInputOutput(input []byte, receivedAt time.Time) (output []byte, err error) {
 
 	requests := &pb.Requests{}
 	if err = proto.Unmarshal(input, requests); err != nil {
 		return nil, fmt.Errorf("unmarshal: %v", err)
 	}
 
-	responses := &pb.Responses{}
+	var deadline time.Time
+	if requests.TimeoutNs > 0 {
+		deadline = receivedAt.Add(time.Duration(requests.TimeoutNs))
+	}
+
+	ctx := tracing.Extract(context.Background(), requests.TraceContext)
+
+	responses := &pb.Responses{
+		RequestId: requests.RequestId,
+	}
 	for _, request := range requests.Requests {
-		response := ss.processRequest(requests.Keyspace, request)
+		var response *pb.Response
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			// the client has given up already
+			response = newErrorResponse(request, "deadline exceeded")
+		} else if err := ss.authorizeRequest(requests.Token, requests.Keyspace, request); err != nil {
+			response = newErrorResponse(request, err.Error())
+		} else {
+			startTime := time.Now()
+			response = ss.processRequest(ctx, requests.Keyspace, request)
+			latency := time.Since(startTime)
+			labels := []string{requests.Keyspace, strconv.Itoa(int(request.ShardId)), request.OpName()}
+			storeRequestCounter.WithLabelValues(labels...).Inc()
+			storeRequestHistogram.WithLabelValues(labels...).Observe(latency.Seconds())
+			ss.slowOps.maybeAdd(requests.Keyspace, request, response, startTime, latency)
+		}
 		responses.Responses = append(responses.Responses, response)
 	}
 
@@ -101,48 +157,30 @@ func (ss *storeServer) handleInputOutput(input []byte) (output []byte, err error
 
 }
 
-func (ss *storeServer) processRequest(keyspace string, command *pb.Request) *pb.Response {
+func (ss *storeServer) processRequest(ctx context.Context, keyspace string, command *pb.Request) (response *pb.Response) {
+
+	_, span := tracing.Start(ctx, "vasto.store."+command.OpName(),
+		attribute.String("keyspace", keyspace),
+		attribute.Int("shard", int(command.ShardId)),
+	)
+	defer func() {
+		tracing.End(span, response.StatusError())
+	}()
 
 	shard, found := ss.keyspaceShards.getShard(keyspace, VastoShardId(command.ShardId))
 
 	if !found {
-		if command.GetGet() != nil {
-			return &pb.Response{
-				Get: &pb.GetResponse{
-					Ok:     false,
-					Status: fmt.Sprintf("keyspace %s not found", keyspace),
-				},
-			}
-		} else if command.GetPut() != nil {
-			return &pb.Response{
-				Write: &pb.WriteResponse{
-					Ok:     false,
-					Status: fmt.Sprintf("keyspace %s not found", keyspace),
-				},
-			}
-		} else if command.GetMerge() != nil {
-			return &pb.Response{
-				Write: &pb.WriteResponse{
-					Ok:     false,
-					Status: fmt.Sprintf("keyspace %s not found", keyspace),
-				},
-			}
-		} else if command.GetDelete() != nil {
-			return &pb.Response{
-				Write: &pb.WriteResponse{
-					Ok:     false,
-					Status: fmt.Sprintf("keyspace %s not found", keyspace),
-				},
-			}
-		} else if command.GetGetByPrefix() != nil {
-			return &pb.Response{
-				GetByPrefix: &pb.GetByPrefixResponse{
-					Ok:     false,
-					Status: fmt.Sprintf("keyspace %s not found", keyspace),
-				},
-			}
-		}
+		return newErrorResponse(command, fmt.Sprintf("keyspace %s not found", keyspace))
+	}
+
+	if throttled := ss.checkQuota(keyspace, shard, command); throttled != nil {
+		return throttled
 	}
+	defer func() {
+		ss.chargeReadBytes(keyspace, command, response)
+	}()
+
+	shard.recordKeyAccess(requestKey(command))
 
 	if command.GetGet() != nil {
 		return &pb.Response{
@@ -160,6 +198,10 @@ func (ss *storeServer) processRequest(keyspace string, command *pb.Request) *pb.
 		return &pb.Response{
 			Write: ss.processDelete(shard, command.Delete),
 		}
+	} else if command.GetDeleteRange() != nil {
+		return &pb.Response{
+			Write: ss.processDeleteRange(shard, command.DeleteRange),
+		}
 	} else if command.GetGetByPrefix() != nil {
 		return &pb.Response{
 			GetByPrefix: ss.processPrefix(shard, command.GetByPrefix),
@@ -171,3 +213,28 @@ func (ss *storeServer) processRequest(keyspace string, command *pb.Request) *pb.
 		},
 	}
 }
+
+// newErrorResponse creates a failed response matching the type of the request
+func newErrorResponse(command *pb.Request, status string) *pb.Response {
+	if command.GetGet() != nil {
+		return &pb.Response{
+			Get: &pb.GetResponse{
+				Ok:     false,
+				Status: status,
+			},
+		}
+	} else if command.GetGetByPrefix() != nil {
+		return &pb.Response{
+			GetByPrefix: &pb.GetByPrefixResponse{
+				Ok:     false,
+				Status: status,
+			},
+		}
+	}
+	return &pb.Response{
+		Write: &pb.WriteResponse{
+			Ok:     false,
+			Status: status,
+		},
+	}
+}
@@ -1,7 +1,9 @@
 package vs
 
 import (
+	"context"
 	"fmt"
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/topology"
 	"github.com/chrislusf/vasto/topology/clusterlistener"
@@ -14,6 +16,9 @@ type ClusterClient struct {
 	ClusterListener *clusterlistener.ClusterListener
 	WriteConfig
 	AccessConfig
+	RetryConfig
+	nearCache   *nearCache
+	credentials *auth.TokenCredentials
 }
 
 // Clone creates a new instance of ClusterClient, mostly to adjust the write config and access config.
@@ -23,6 +28,9 @@ func (c *ClusterClient) Clone() *ClusterClient {
 		ClusterListener: c.ClusterListener,
 		WriteConfig:     c.WriteConfig,
 		AccessConfig:    c.AccessConfig,
+		RetryConfig:     c.RetryConfig,
+		nearCache:       c.nearCache,
+		credentials:     c.credentials,
 	}
 }
 
@@ -35,36 +43,16 @@ func (c *ClusterClient) GetCluster() (*topology.Cluster, error) {
 	return cluster, nil
 }
 
-// sendRequestsToOneShard send the requests to one partition
-// assuming the requests going to the same shard
-func (c *ClusterClient) sendRequestsToOneShard(shardId int, requests []*pb.Request) (results []*pb.Response, err error) {
-
-	conn, err := c.ClusterListener.GetConnectionByShardId(c.keyspace, shardId, c.Replica)
-
-	if err != nil {
-		return nil, err
-	}
-
-	responses, err := pb.SendRequests(conn, &pb.Requests{
-		Keyspace: c.keyspace,
-		Requests: requests,
-	})
-	conn.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("shard %d process error: %v", shardId, err)
-	}
-
-	results = responses.Responses
-
-	return
-
-}
-
 // BatchProcess devides requests, groups them by the destination, and sends to the partitions by batch.
 // Expert usage expected.
 func (c *ClusterClient) BatchProcess(requests []*pb.Request,
 	processResultFunc func([]*pb.Response, error) error) error {
+	return c.BatchProcessCtx(context.Background(), requests, processResultFunc)
+}
+
+// BatchProcessCtx is BatchProcess with a context for deadline and cancellation.
+func (c *ClusterClient) BatchProcessCtx(ctx context.Context, requests []*pb.Request,
+	processResultFunc func([]*pb.Response, error) error) error {
 
 	cluster, err := c.GetCluster()
 	if err != nil {
@@ -79,10 +67,12 @@ func (c *ClusterClient) BatchProcess(requests []*pb.Request,
 
 	err = mapEachShard(shardIdToRequests, func(shardId uint32, requests []*pb.Request) error {
 
-		responses, err := c.sendRequestsToOneShard(int(shardId), requests)
+		responses, err := c.sendRequestsToOneShard(ctx, int(shardId), requests)
+
+		c.invalidateNearCache(requests)
 
 		if err != nil {
-			return fmt.Errorf("shard %d process error: %v", shardId, err)
+			return fmt.Errorf("shard %d process error: %w", shardId, err)
 		}
 
 		if processResultFunc != nil {
@@ -93,7 +83,7 @@ func (c *ClusterClient) BatchProcess(requests []*pb.Request,
 	})
 
 	if err != nil {
-		return fmt.Errorf("process error: %v", err)
+		return fmt.Errorf("process error: %w", err)
 	}
 
 	return nil
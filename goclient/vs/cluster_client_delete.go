@@ -1,6 +1,7 @@
 package vs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -9,6 +10,11 @@ import (
 
 // Delete deletes one entry by the key.
 func (c *ClusterClient) Delete(key *KeyObject) error {
+	return c.DeleteCtx(context.Background(), key)
+}
+
+// DeleteCtx is Delete with a context for deadline and cancellation.
+func (c *ClusterClient) DeleteCtx(ctx context.Context, key *KeyObject) error {
 
 	request := &pb.Request{
 		Delete: &pb.DeleteRequest{
@@ -18,22 +24,21 @@ func (c *ClusterClient) Delete(key *KeyObject) error {
 		},
 	}
 
-	err := c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+	err := c.BatchProcessCtx(ctx, []*pb.Request{request}, func(responses []*pb.Response, err error) error {
 		if err != nil {
 			return err
 		}
 		if len(responses) == 0 {
 			return ErrorNotFound
 		}
-		response := responses[0]
-		if !response.Write.Ok {
-			return errors.New(response.Write.Status)
-		}
-		return nil
+		return writeResponseError(responses[0].Write)
 	})
 
+	if errors.Is(err, ErrorThrottled) {
+		return ErrorThrottled
+	}
 	if err != nil {
-		return fmt.Errorf("delete error: %v", err)
+		return fmt.Errorf("delete error: %w", err)
 	}
 
 	return nil
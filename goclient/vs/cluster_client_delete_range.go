@@ -0,0 +1,85 @@
+package vs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// DeletePrefix deletes all entries keyed with the prefix, from all partitions.
+// Each store scans the keys with the prefix to keep the newer writes, so the cost grows with the number of keys,
+// and the writes to the prefix wait until the scan is done.
+func (c *ClusterClient) DeletePrefix(prefix []byte) error {
+	return c.DeletePrefixCtx(context.Background(), prefix)
+}
+
+// DeletePrefixCtx is DeletePrefix with a context for deadline and cancellation.
+func (c *ClusterClient) DeletePrefixCtx(ctx context.Context, prefix []byte) error {
+	return c.DeleteRangeCtx(ctx, prefix, prefixEnd(prefix))
+}
+
+// DeleteRange deletes all entries with keys in [start, end) from all partitions, or all keys from start if end is empty.
+// Each store deletes the range in one operation. Entries written after the range delete are kept.
+// Like DeletePrefix, it scans all keys in the range, and blocks the writes to the range meanwhile.
+func (c *ClusterClient) DeleteRange(start, end []byte) error {
+	return c.DeleteRangeCtx(context.Background(), start, end)
+}
+
+// DeleteRangeCtx is DeleteRange with a context for deadline and cancellation.
+func (c *ClusterClient) DeleteRangeCtx(ctx context.Context, start, end []byte) error {
+
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return err
+	}
+
+	shardIdToRequests := make(map[uint32][]*pb.Request)
+	for shardId := 0; shardId < cluster.ExpectedSize(); shardId++ {
+		shardIdToRequests[uint32(shardId)] = []*pb.Request{{
+			ShardId: uint32(shardId),
+			DeleteRange: &pb.DeleteRangeRequest{
+				Start:       start,
+				End:         end,
+				UpdatedAtNs: c.UpdatedAtNs,
+			},
+		}}
+	}
+
+	err = mapEachShard(shardIdToRequests, func(shardId uint32, requests []*pb.Request) error {
+
+		responses, err := c.sendRequestsToOneShard(ctx, int(shardId), requests)
+
+		c.invalidateNearCache(requests)
+
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 {
+			return fmt.Errorf("shard %d: no response", shardId)
+		}
+		return writeResponseError(responses[0].Write)
+	})
+
+	if errors.Is(err, ErrorThrottled) {
+		return ErrorThrottled
+	}
+	if err != nil {
+		return fmt.Errorf("delete range error: %w", err)
+	}
+
+	return nil
+}
+
+// prefixEnd returns the smallest key after all the keys with the prefix, or nil if there is no such key.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
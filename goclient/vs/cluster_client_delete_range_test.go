@@ -0,0 +1,23 @@
+package vs
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrefixEnd(t *testing.T) {
+	tests := []struct {
+		prefix []byte
+		end    []byte
+	}{
+		{[]byte("abc"), []byte("abd")},
+		{[]byte{'a', 0xff}, []byte("b")},
+		{[]byte{0xff, 0xff}, nil},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		if end := prefixEnd(tt.prefix); !bytes.Equal(end, tt.end) {
+			t.Errorf("prefix end of %x: %x, expecting %x", tt.prefix, end, tt.end)
+		}
+	}
+}
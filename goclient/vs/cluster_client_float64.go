@@ -1,6 +1,7 @@
 package vs
 
 import (
+	"context"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/util"
@@ -9,6 +10,19 @@ import (
 // GetFloat64 get the float64 value by the key
 // The value could have been set by PutFloat64, AddFloat64, PutMaxFloat64, or PutMinFloat64.
 func (c *ClusterClient) GetFloat64(key *KeyObject) (float64, error) {
+	return c.GetFloat64Ctx(context.Background(), key)
+}
+
+// GetFloat64Ctx is GetFloat64 with a context for deadline and cancellation.
+func (c *ClusterClient) GetFloat64Ctx(ctx context.Context, key *KeyObject) (float64, error) {
+
+	generation := c.nearCacheGeneration()
+	if value, _, found := c.getFromNearCache(key); found {
+		if len(value) != 8 {
+			return 0, ErrorWrongDataFormat
+		}
+		return util.BytesToFloat64(value), nil
+	}
 
 	request := &pb.Request{
 		Get: &pb.GetRequest{
@@ -18,7 +32,7 @@ func (c *ClusterClient) GetFloat64(key *KeyObject) (float64, error) {
 	}
 
 	var response *pb.Response
-	err := c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+	err := c.BatchProcessCtx(ctx, []*pb.Request{request}, func(responses []*pb.Response, err error) error {
 		if err != nil {
 			return err
 		}
@@ -30,9 +44,12 @@ func (c *ClusterClient) GetFloat64(key *KeyObject) (float64, error) {
 	})
 
 	if err != nil {
-		return 0, fmt.Errorf("get float64 error: %v", err)
+		return 0, fmt.Errorf("get float64 error: %w", err)
 	}
 
+	if response.Get.IsThrottled {
+		return 0, ErrorThrottled
+	}
 	if response.Get.Status != "" {
 		return 0, fmt.Errorf(response.Get.Status)
 	}
@@ -46,12 +63,19 @@ func (c *ClusterClient) GetFloat64(key *KeyObject) (float64, error) {
 		return 0, ErrorWrongDataFormat
 	}
 
+	c.setNearCache(key, kv.Value, kv.DataType, generation)
+
 	return util.BytesToFloat64(kv.Value), nil
 
 }
 
 // PutFloat64 sets a float64 value to the key
 func (c *ClusterClient) PutFloat64(key *KeyObject, value float64) error {
+	return c.PutFloat64Ctx(context.Background(), key, value)
+}
+
+// PutFloat64Ctx is PutFloat64 with a context for deadline and cancellation.
+func (c *ClusterClient) PutFloat64Ctx(ctx context.Context, key *KeyObject, value float64) error {
 
 	var requests []*pb.Request
 	request := &pb.Request{
@@ -66,13 +90,16 @@ func (c *ClusterClient) PutFloat64(key *KeyObject, value float64) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	return c.BatchProcessCtx(ctx, requests, writeError)
 }
 
 // AddFloat64 adds a float64 value to the key
 func (c *ClusterClient) AddFloat64(key *KeyObject, value float64) error {
+	return c.AddFloat64Ctx(context.Background(), key, value)
+}
+
+// AddFloat64Ctx is AddFloat64 with a context for deadline and cancellation.
+func (c *ClusterClient) AddFloat64Ctx(ctx context.Context, key *KeyObject, value float64) error {
 
 	var requests []*pb.Request
 	request := &pb.Request{
@@ -86,14 +113,17 @@ func (c *ClusterClient) AddFloat64(key *KeyObject, value float64) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	return c.BatchProcessCtx(ctx, requests, writeError)
 }
 
 // PutMaxFloat64 sets a float64 value to the key, and when getting by the key, the maximum value of all previous values
 // will be returned.
 func (c *ClusterClient) PutMaxFloat64(key *KeyObject, value float64) error {
+	return c.PutMaxFloat64Ctx(context.Background(), key, value)
+}
+
+// PutMaxFloat64Ctx is PutMaxFloat64 with a context for deadline and cancellation.
+func (c *ClusterClient) PutMaxFloat64Ctx(ctx context.Context, key *KeyObject, value float64) error {
 
 	var requests []*pb.Request
 	request := &pb.Request{
@@ -107,14 +137,17 @@ func (c *ClusterClient) PutMaxFloat64(key *KeyObject, value float64) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	return c.BatchProcessCtx(ctx, requests, writeError)
 }
 
 // PutMinFloat64 sets a float64 value to the key, and when getting by the key, the mininum value of all previous values
 // will be returned.
 func (c *ClusterClient) PutMinFloat64(key *KeyObject, value float64) error {
+	return c.PutMinFloat64Ctx(context.Background(), key, value)
+}
+
+// PutMinFloat64Ctx is PutMinFloat64 with a context for deadline and cancellation.
+func (c *ClusterClient) PutMinFloat64Ctx(ctx context.Context, key *KeyObject, value float64) error {
 
 	var requests []*pb.Request
 	request := &pb.Request{
@@ -128,7 +161,5 @@ func (c *ClusterClient) PutMinFloat64(key *KeyObject, value float64) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	return c.BatchProcessCtx(ctx, requests, writeError)
 }
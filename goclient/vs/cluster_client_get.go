@@ -1,6 +1,7 @@
 package vs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -12,10 +13,22 @@ var (
 	ErrorNotFound = errors.New("not found")
 	// ErrorWrongDataFormat error when data type is unexpected
 	ErrorWrongDataFormat = errors.New("wrong data format")
+	// ErrorThrottled error when the request is over the ops or bytes quota of the keyspace
+	ErrorThrottled = errors.New("throttled")
 )
 
 // Get gets the value bytes by the key
 func (c *ClusterClient) Get(key *KeyObject) ([]byte, pb.OpAndDataType, error) {
+	return c.GetCtx(context.Background(), key)
+}
+
+// GetCtx is Get with a context for deadline and cancellation.
+func (c *ClusterClient) GetCtx(ctx context.Context, key *KeyObject) ([]byte, pb.OpAndDataType, error) {
+
+	generation := c.nearCacheGeneration()
+	if value, dataType, found := c.getFromNearCache(key); found {
+		return value, dataType, nil
+	}
 
 	request := &pb.Request{
 		Get: &pb.GetRequest{
@@ -25,7 +38,7 @@ func (c *ClusterClient) Get(key *KeyObject) ([]byte, pb.OpAndDataType, error) {
 	}
 
 	var response *pb.Response
-	err := c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+	err := c.BatchProcessCtx(ctx, []*pb.Request{request}, func(responses []*pb.Response, err error) error {
 		if err != nil {
 			return err
 		}
@@ -37,9 +50,12 @@ func (c *ClusterClient) Get(key *KeyObject) ([]byte, pb.OpAndDataType, error) {
 	})
 
 	if err != nil {
-		return nil, pb.OpAndDataType_BYTES, fmt.Errorf("get error: %v", err)
+		return nil, pb.OpAndDataType_BYTES, fmt.Errorf("get error: %w", err)
 	}
 
+	if response.Get.IsThrottled {
+		return nil, pb.OpAndDataType_BYTES, ErrorThrottled
+	}
 	if response.Get.Status != "" {
 		return nil, pb.OpAndDataType_BYTES, fmt.Errorf(response.Get.Status)
 	}
@@ -49,5 +65,7 @@ func (c *ClusterClient) Get(key *KeyObject) ([]byte, pb.OpAndDataType, error) {
 		return nil, pb.OpAndDataType_BYTES, ErrorNotFound
 	}
 
+	c.setNearCache(key, kv.Value, kv.DataType, generation)
+
 	return kv.Value, kv.DataType, nil
 }
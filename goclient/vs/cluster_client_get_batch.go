@@ -1,6 +1,7 @@
 package vs
 
 import (
+	"context"
 	"github.com/chrislusf/vasto/pb"
 )
 
@@ -11,6 +12,11 @@ type answer struct {
 
 // BatchGet gets the key value pairs from different partitions by the keys
 func (c *ClusterClient) BatchGet(keys []*KeyObject) (ret []*KeyValue, err error) {
+	return c.BatchGetCtx(context.Background(), keys)
+}
+
+// BatchGetCtx is BatchGet with a context for deadline and cancellation.
+func (c *ClusterClient) BatchGetCtx(ctx context.Context, keys []*KeyObject) (ret []*KeyValue, err error) {
 
 	var requests []*pb.Request
 
@@ -26,7 +32,7 @@ func (c *ClusterClient) BatchGet(keys []*KeyObject) (ret []*KeyValue, err error)
 
 	outputChan := make(chan *answer, len(keys))
 	go func() {
-		err = c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
+		err = c.BatchProcessCtx(ctx, requests, func(responses []*pb.Response, err error) error {
 			if err != nil {
 				outputChan <- &answer{err: err}
 				return nil
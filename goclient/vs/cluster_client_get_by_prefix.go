@@ -1,6 +1,7 @@
 package vs
 
 import (
+	"context"
 	"github.com/chrislusf/vasto/pb"
 )
 
@@ -10,6 +11,11 @@ import (
 // limit: number of entries to return
 // lastSeenKey: the last key seen during pagination
 func (c *ClusterClient) GetByPrefix(partitionKey, prefix []byte, limit uint32, lastSeenKey []byte) ([]*KeyValue, error) {
+	return c.GetByPrefixCtx(context.Background(), partitionKey, prefix, limit, lastSeenKey)
+}
+
+// GetByPrefixCtx is GetByPrefix with a context for deadline and cancellation.
+func (c *ClusterClient) GetByPrefixCtx(ctx context.Context, partitionKey, prefix []byte, limit uint32, lastSeenKey []byte) ([]*KeyValue, error) {
 
 	prefixRequest := &pb.GetByPrefixRequest{
 		Prefix:      prefix,
@@ -18,7 +24,7 @@ func (c *ClusterClient) GetByPrefix(partitionKey, prefix []byte, limit uint32, l
 	}
 
 	shardId, _ := c.ClusterListener.GetShardId(c.keyspace, partitionKey)
-	return c.prefixQueryToSingleShard(shardId, prefixRequest)
+	return c.prefixQueryToSingleShard(ctx, shardId, prefixRequest)
 }
 
 // CollectByPrefix collects entries keyed by the prefix from all partitions
@@ -26,6 +32,11 @@ func (c *ClusterClient) GetByPrefix(partitionKey, prefix []byte, limit uint32, l
 // limit: number of entries to return
 // lastSeenKey: the last key seen during pagination
 func (c *ClusterClient) CollectByPrefix(prefix []byte, limit uint32, lastSeenKey []byte) ([]*KeyValue, error) {
+	return c.CollectByPrefixCtx(context.Background(), prefix, limit, lastSeenKey)
+}
+
+// CollectByPrefixCtx is CollectByPrefix with a context for deadline and cancellation.
+func (c *ClusterClient) CollectByPrefixCtx(ctx context.Context, prefix []byte, limit uint32, lastSeenKey []byte) ([]*KeyValue, error) {
 
 	prefixRequest := &pb.GetByPrefixRequest{
 		Prefix:      prefix,
@@ -33,10 +44,10 @@ func (c *ClusterClient) CollectByPrefix(prefix []byte, limit uint32, lastSeenKey
 		LastSeenKey: lastSeenKey,
 	}
 
-	return c.broadcastEachShard(prefixRequest)
+	return c.broadcastEachShard(ctx, prefixRequest)
 }
 
-func (c *ClusterClient) broadcastEachShard(prefixRequest *pb.GetByPrefixRequest) (results []*KeyValue, broadcastErr error) {
+func (c *ClusterClient) broadcastEachShard(ctx context.Context, prefixRequest *pb.GetByPrefixRequest) (results []*KeyValue, broadcastErr error) {
 	cluster, err := c.GetCluster()
 	if err != nil {
 		return nil, err
@@ -53,7 +64,7 @@ func (c *ClusterClient) broadcastEachShard(prefixRequest *pb.GetByPrefixRequest)
 
 			defer close(chans[shardId])
 
-			keyValues, err := c.prefixQueryToSingleShard(shardId, prefixRequest)
+			keyValues, err := c.prefixQueryToSingleShard(ctx, shardId, prefixRequest)
 
 			if err != nil {
 				broadcastErr = err
@@ -72,14 +83,17 @@ func (c *ClusterClient) broadcastEachShard(prefixRequest *pb.GetByPrefixRequest)
 
 }
 
-func (c *ClusterClient) prefixQueryToSingleShard(shardId int, prefixRequest *pb.GetByPrefixRequest) (results []*KeyValue, err error) {
+func (c *ClusterClient) prefixQueryToSingleShard(ctx context.Context, shardId int, prefixRequest *pb.GetByPrefixRequest) (results []*KeyValue, err error) {
 
-	responses, err := c.sendRequestsToOneShard(shardId, []*pb.Request{{
+	responses, err := c.sendRequestsToOneShard(ctx, shardId, []*pb.Request{{
 		ShardId:     uint32(shardId),
 		GetByPrefix: prefixRequest,
 	}})
 
 	if len(responses) == 1 {
+		if responses[0].GetByPrefix.GetIsThrottled() {
+			return nil, ErrorThrottled
+		}
 		for _, keyValue := range responses[0].GetByPrefix.KeyValues {
 			kv := fromPbKeyTypeValue(keyValue)
 			results = append(results, kv)
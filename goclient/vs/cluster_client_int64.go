@@ -0,0 +1,141 @@
+package vs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+)
+
+// GetInt64 get the int64 value by the key
+// The value could have been set by PutInt64, IncrBy, PutMaxInt64, or PutMinInt64.
+func (c *ClusterClient) GetInt64(key *KeyObject) (int64, error) {
+	return c.GetInt64Ctx(context.Background(), key)
+}
+
+// GetInt64Ctx is GetInt64 with a context for deadline and cancellation.
+func (c *ClusterClient) GetInt64Ctx(ctx context.Context, key *KeyObject) (int64, error) {
+
+	value, _, err := c.GetCtx(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(value) != 8 {
+		return 0, ErrorWrongDataFormat
+	}
+
+	return util.BytesToInt64(value), nil
+
+}
+
+// PutInt64 sets an int64 value to the key
+func (c *ClusterClient) PutInt64(key *KeyObject, value int64) error {
+	return c.PutInt64Ctx(context.Background(), key, value)
+}
+
+// PutInt64Ctx is PutInt64 with a context for deadline and cancellation.
+func (c *ClusterClient) PutInt64Ctx(ctx context.Context, key *KeyObject, value int64) error {
+
+	var requests []*pb.Request
+	request := &pb.Request{
+		Put: &pb.PutRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			TtlSecond:     c.TtlSecond,
+			OpAndDataType: pb.OpAndDataType_INT64,
+			Value:         util.Int64ToBytes(value),
+		},
+	}
+	requests = append(requests, request)
+
+	return c.BatchProcessCtx(ctx, requests, writeError)
+}
+
+// IncrBy adds the delta to the int64 value of the key, and returns the value right after this increment.
+// A missing key, or a key with a non-int64 value, starts from 0.
+func (c *ClusterClient) IncrBy(key *KeyObject, delta int64) (int64, error) {
+	return c.IncrByCtx(context.Background(), key, delta)
+}
+
+// IncrByCtx is IncrBy with a context for deadline and cancellation.
+func (c *ClusterClient) IncrByCtx(ctx context.Context, key *KeyObject, delta int64) (int64, error) {
+
+	request := &pb.Request{
+		Merge: &pb.MergeRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			OpAndDataType: pb.OpAndDataType_INT64,
+			Value:         util.Int64ToBytes(delta),
+			ReturnValue:   true,
+		},
+	}
+
+	var value []byte
+	err := c.BatchProcessCtx(ctx, []*pb.Request{request}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 {
+			return ErrorNotFound
+		}
+		value = responses[0].Write.GetValue()
+		return writeResponseError(responses[0].Write)
+	})
+
+	if errors.Is(err, ErrorThrottled) {
+		return 0, ErrorThrottled
+	}
+	if err != nil {
+		return 0, fmt.Errorf("incr error: %w", err)
+	}
+
+	if len(value) != 8 {
+		return 0, ErrorWrongDataFormat
+	}
+
+	return util.BytesToInt64(value), nil
+}
+
+// PutMaxInt64 sets an int64 value to the key, and when getting by the key, the maximum value of all previous values
+// will be returned.
+func (c *ClusterClient) PutMaxInt64(key *KeyObject, value int64) error {
+	return c.PutMaxInt64Ctx(context.Background(), key, value)
+}
+
+// PutMaxInt64Ctx is PutMaxInt64 with a context for deadline and cancellation.
+func (c *ClusterClient) PutMaxInt64Ctx(ctx context.Context, key *KeyObject, value int64) error {
+	return c.mergeInt64Ctx(ctx, key, pb.OpAndDataType_MAX_INT64, value)
+}
+
+// PutMinInt64 sets an int64 value to the key, and when getting by the key, the minimum value of all previous values
+// will be returned.
+func (c *ClusterClient) PutMinInt64(key *KeyObject, value int64) error {
+	return c.PutMinInt64Ctx(context.Background(), key, value)
+}
+
+// PutMinInt64Ctx is PutMinInt64 with a context for deadline and cancellation.
+func (c *ClusterClient) PutMinInt64Ctx(ctx context.Context, key *KeyObject, value int64) error {
+	return c.mergeInt64Ctx(ctx, key, pb.OpAndDataType_MIN_INT64, value)
+}
+
+func (c *ClusterClient) mergeInt64Ctx(ctx context.Context, key *KeyObject, dataType pb.OpAndDataType, value int64) error {
+
+	var requests []*pb.Request
+	request := &pb.Request{
+		Merge: &pb.MergeRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			OpAndDataType: dataType,
+			Value:         util.Int64ToBytes(value),
+		},
+	}
+	requests = append(requests, request)
+
+	return c.BatchProcessCtx(ctx, requests, writeError)
+}
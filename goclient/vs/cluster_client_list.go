@@ -0,0 +1,70 @@
+package vs
+
+import (
+	"context"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// LPush pushes the elements to the head of the list of the key, the last one first.
+// The list is merged on the store, without reading it first.
+func (c *ClusterClient) LPush(key *KeyObject, elements ...[]byte) error {
+	return c.LPushCtx(context.Background(), key, elements...)
+}
+
+// LPushCtx is LPush with a context for deadline and cancellation.
+func (c *ClusterClient) LPushCtx(ctx context.Context, key *KeyObject, elements ...[]byte) error {
+	return c.LPushCappedCtx(ctx, key, 0, 0, elements...)
+}
+
+// LPushCapped is LPush, and also caps the list to the newest maxLength elements, and drops elements older than maxAge.
+// The limits are kept with the list, and enforced on merges and compactions. 0 keeps the current limit.
+func (c *ClusterClient) LPushCapped(key *KeyObject, maxLength int, maxAge time.Duration, elements ...[]byte) error {
+	return c.LPushCappedCtx(context.Background(), key, maxLength, maxAge, elements...)
+}
+
+// LPushCappedCtx is LPushCapped with a context for deadline and cancellation.
+func (c *ClusterClient) LPushCappedCtx(ctx context.Context, key *KeyObject, maxLength int, maxAge time.Duration, elements ...[]byte) error {
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_LIST, codec.EncodeListPush(elements, maxLength, maxAge, time.Now()))
+}
+
+// LTrim keeps only the elements of the list from start to stop, both inclusive, 0 being the newest element.
+// Negative indexes count from the end, -1 being the oldest element.
+func (c *ClusterClient) LTrim(key *KeyObject, start, stop int64) error {
+	return c.LTrimCtx(context.Background(), key, start, stop)
+}
+
+// LTrimCtx is LTrim with a context for deadline and cancellation.
+func (c *ClusterClient) LTrimCtx(ctx context.Context, key *KeyObject, start, stop int64) error {
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_LIST, codec.EncodeListTrim(start, stop))
+}
+
+// LRange returns the elements of the list from start to stop, both inclusive, newest first.
+// Negative indexes count from the end, e.g., LRange(key, 0, -1) returns the whole list. A missing key is an empty list.
+func (c *ClusterClient) LRange(key *KeyObject, start, stop int64) ([][]byte, error) {
+	return c.LRangeCtx(context.Background(), key, start, stop)
+}
+
+// LRangeCtx is LRange with a context for deadline and cancellation.
+func (c *ClusterClient) LRangeCtx(ctx context.Context, key *KeyObject, start, stop int64) ([][]byte, error) {
+
+	value, dataType, err := c.GetCtx(ctx, key)
+	if err == ErrorNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dataType != pb.OpAndDataType_LIST {
+		return nil, ErrorWrongDataFormat
+	}
+
+	elements, err := codec.DecodeListRange(value, start, stop, time.Now())
+	if err != nil {
+		return nil, ErrorWrongDataFormat
+	}
+	return elements, nil
+}
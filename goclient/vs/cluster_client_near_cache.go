@@ -0,0 +1,168 @@
+package vs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"google.golang.org/grpc"
+)
+
+// EnableNearCache caches the values read by Get and GetFloat64 in a bounded LRU cache,
+// with at most maxEntries entries, each living at most ttl.
+// Writes through this client invalidate the cached keys. Changes from other clients are invalidated
+// by tailing the binlog of each shard, until the ctx is cancelled.
+// The cache is shared by the cloned cluster clients.
+func (c *ClusterClient) EnableNearCache(ctx context.Context, maxEntries int, ttl time.Duration) {
+	c.nearCache = newNearCache(maxEntries, ttl)
+	go c.followShardsForNearCache(ctx)
+}
+
+// NearCacheStats returns the hits, misses, and other counters of the near cache.
+func (c *ClusterClient) NearCacheStats() NearCacheStats {
+	if c.nearCache == nil {
+		return NearCacheStats{}
+	}
+	return c.nearCache.stats()
+}
+
+func (c *ClusterClient) getFromNearCache(key *KeyObject) ([]byte, pb.OpAndDataType, bool) {
+	if c.nearCache == nil {
+		return nil, pb.OpAndDataType_BYTES, false
+	}
+	return c.nearCache.get(c.keyspace, key.GetKey())
+}
+
+// nearCacheGeneration is read before the near cache lookup, and passed to setNearCache after the fetch
+func (c *ClusterClient) nearCacheGeneration() uint64 {
+	if c.nearCache == nil {
+		return 0
+	}
+	return c.nearCache.currentGeneration()
+}
+
+// setNearCache caches the fetched value, unless it may be invalidated during the fetch
+func (c *ClusterClient) setNearCache(key *KeyObject, value []byte, dataType pb.OpAndDataType, generation uint64) {
+	if c.nearCache == nil {
+		return
+	}
+	c.nearCache.set(c.keyspace, key.GetKey(), value, dataType, generation)
+}
+
+// invalidateNearCache removes the keys written by the requests
+func (c *ClusterClient) invalidateNearCache(requests []*pb.Request) {
+	if c.nearCache == nil {
+		return
+	}
+	for _, request := range requests {
+		if request.Put != nil {
+			c.nearCache.invalidate(c.keyspace, request.Put.Key)
+		} else if request.Merge != nil {
+			c.nearCache.invalidate(c.keyspace, request.Merge.Key)
+		} else if request.Delete != nil {
+			c.nearCache.invalidate(c.keyspace, request.Delete.Key)
+		} else if request.DeleteRange != nil {
+			c.nearCache.clear()
+		}
+	}
+}
+
+// followShardsForNearCache starts to tail the binlog of each shard, also the new shards after the cluster grows.
+func (c *ClusterClient) followShardsForNearCache(ctx context.Context) {
+
+	followedShards := 0
+
+	for {
+		if cluster, err := c.GetCluster(); err == nil {
+			for ; followedShards < cluster.ExpectedSize(); followedShards++ {
+				go c.followShardForNearCache(ctx, followedShards)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(10 * time.Second):
+		}
+	}
+
+}
+
+func (c *ClusterClient) followShardForNearCache(ctx context.Context, shardId int) {
+
+	var segment uint32
+	var offset uint64
+	hasPosition := false
+
+	for {
+		cluster, err := c.GetCluster()
+		if err != nil || shardId >= cluster.ExpectedSize() {
+			// the shard is gone after the cluster shrinks
+			return
+		}
+
+		err = cluster.WithConnection("near cache", shardId, func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
+
+			client := pb.NewVastoStoreClient(grpcConnection)
+
+			if !hasPosition {
+				// skip the old history, the cache is empty or cleared anyway
+				checkResponse, err := client.CheckBinlog(ctx, &pb.CheckBinlogRequest{
+					Keyspace: c.keyspace,
+					ShardId:  uint32(shardId),
+				})
+				if err != nil {
+					return fmt.Errorf("check binlog: %v", err)
+				}
+				segment, offset, hasPosition = checkResponse.LatestSegment, 0, true
+			}
+
+			stream, err := client.TailBinlog(ctx, &pb.PullUpdateRequest{
+				Keyspace: c.keyspace,
+				ShardId:  uint32(shardId),
+				Segment:  segment,
+				Offset:   offset,
+				Limit:    8096,
+				Origin:   "near cache",
+			})
+			if err != nil {
+				return fmt.Errorf("tail binlog: %v", err)
+			}
+
+			for {
+				changes, err := stream.Recv()
+				if err != nil {
+					return fmt.Errorf("pull changes: %v", err)
+				}
+				if changes.OutOfSync {
+					// some changes may be missed
+					c.nearCache.clear()
+					hasPosition = false
+					return fmt.Errorf("binlog out of sync")
+				}
+				for _, entry := range changes.Entries {
+					if entry.GetDeleteRange() != nil {
+						c.nearCache.clear()
+						continue
+					}
+					c.nearCache.invalidate(c.keyspace, entry.GetKey())
+				}
+				segment, offset = changes.NextSegment, changes.NextOffset
+			}
+
+		}, c.ClusterListener.DialOptions()...)
+
+		if err != nil {
+			glog.V(1).Infof("near cache follows %s shard %d: %v", c.keyspace, shardId, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(2 * time.Second):
+		}
+	}
+
+}
@@ -1,12 +1,18 @@
 package vs
 
 import (
+	"context"
 	"errors"
 	"github.com/chrislusf/vasto/pb"
 )
 
 // Put puts one key value pair to one partition
 func (c *ClusterClient) Put(key *KeyObject, value []byte) error {
+	return c.PutCtx(context.Background(), key, value)
+}
+
+// PutCtx is Put with a context for deadline and cancellation.
+func (c *ClusterClient) PutCtx(ctx context.Context, key *KeyObject, value []byte) error {
 
 	var requests []*pb.Request
 	request := &pb.Request{
@@ -21,13 +27,16 @@ func (c *ClusterClient) Put(key *KeyObject, value []byte) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	return c.BatchProcessCtx(ctx, requests, writeError)
 }
 
 // Append appends []byte to existing value
 func (c *ClusterClient) Append(key *KeyObject, value []byte) error {
+	return c.AppendCtx(context.Background(), key, value)
+}
+
+// AppendCtx is Append with a context for deadline and cancellation.
+func (c *ClusterClient) AppendCtx(ctx context.Context, key *KeyObject, value []byte) error {
 
 	var requests []*pb.Request
 	request := &pb.Request{
@@ -41,23 +50,24 @@ func (c *ClusterClient) Append(key *KeyObject, value []byte) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
+	return c.BatchProcessCtx(ctx, requests, func(responses []*pb.Response, err error) error {
 		if err != nil {
 			return err
 		}
 		if len(responses) == 0 {
 			return ErrorNotFound
 		}
-		response := responses[0]
-		if !response.Write.Ok {
-			return errors.New(response.Write.Status)
-		}
-		return nil
+		return writeResponseError(responses[0].Write)
 	})
 }
 
 // BatchPut puts the key value pairs to different partitions
 func (c *ClusterClient) BatchPut(rows []*KeyValue) error {
+	return c.BatchPutCtx(context.Background(), rows)
+}
+
+// BatchPutCtx is BatchPut with a context for deadline and cancellation.
+func (c *ClusterClient) BatchPutCtx(ctx context.Context, rows []*KeyValue) error {
 
 	var requests []*pb.Request
 	for _, row := range rows {
@@ -74,7 +84,29 @@ func (c *ClusterClient) BatchPut(rows []*KeyValue) error {
 		requests = append(requests, request)
 	}
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
+	return c.BatchProcessCtx(ctx, requests, writeError)
+}
+
+// writeError returns the error of the batch, or the error of the first failed write
+func writeError(responses []*pb.Response, err error) error {
+	if err != nil {
 		return err
-	})
+	}
+	for _, response := range responses {
+		if err = writeResponseError(response.Write); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeResponseError returns ErrorThrottled if the store rejected the write by the keyspace quota
+func writeResponseError(response *pb.WriteResponse) error {
+	if response.GetIsThrottled() {
+		return ErrorThrottled
+	}
+	if response != nil && !response.Ok {
+		return errors.New(response.Status)
+	}
+	return nil
 }
@@ -0,0 +1,299 @@
+package vs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology/clusterlistener"
+	"github.com/chrislusf/vasto/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// shardError remembers the kind of the error, to decide whether to retry.
+type shardError struct {
+	class ErrorClass
+	err   error
+	// the requests may have been received by the store, so writes are not safe to resend
+	mayBeSent bool
+}
+
+func (e *shardError) Error() string {
+	return e.err.Error()
+}
+
+func (e *shardError) Unwrap() error {
+	return e.err
+}
+
+type retryWritesKey struct{}
+
+// WithRetryWrites marks the writes sent with the context as idempotent, e.g., puts with a fixed UpdatedAtNs,
+// so that they are also retried when they may have reached the store.
+// Without it, writes are only retried if they have not been sent, since merges like IncrBy or LPush
+// would be applied twice.
+func WithRetryWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryWritesKey{}, true)
+}
+
+func isRetryWrites(ctx context.Context) bool {
+	retryWrites, _ := ctx.Value(retryWritesKey{}).(bool)
+	return retryWrites
+}
+
+// sendRequestsToOneShard send the requests to one partition
+// assuming the requests going to the same shard.
+// Failed attempts are retried according to the RetryConfig. Reads fail over to the next replica on connection errors,
+// and skip the replicas whose stores are stopped by the circuit breaker.
+// Writes are only retried if they have not reached the store, unless the context is marked by WithRetryWrites.
+func (c *ClusterClient) sendRequestsToOneShard(ctx context.Context, shardId int, requests []*pb.Request) (results []*pb.Response, err error) {
+
+	ctx, span := tracing.Start(ctx, "vasto.client.shard",
+		attribute.String("keyspace", c.keyspace),
+		attribute.Int("shard", shardId),
+		attribute.Int("requests", len(requests)),
+	)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	isRead := isReadOnly(requests)
+	replica := c.Replica
+	backoff := c.Backoff
+
+	for attempt := 1; ; attempt++ {
+
+		if isRead {
+			replica = c.availableReplica(shardId, replica)
+		}
+
+		if isRead && c.HedgeAfter > 0 {
+			results, err = c.sendHedgedReads(ctx, shardId, replica, requests)
+		} else {
+			results, err = c.sendRequestsToReplica(ctx, shardId, replica, requests)
+		}
+
+		if err == nil || attempt >= c.MaxAttempts || !c.isRetryable(err) {
+			break
+		}
+
+		if !isRead && isCircuitOpen(err) {
+			// fail fast, writes can not go to other replicas
+			break
+		}
+
+		if !isRead && mayBeSent(err) && !isRetryWrites(ctx) {
+			// the store may have applied the writes
+			break
+		}
+
+		if isRead && isErrorClass(err, RetryOnConnectionError) {
+			replica = c.nextReplica(shardId, replica)
+		}
+
+		glog.V(2).Infof("shard %d attempt %d failed, retrying with replica %d: %v", shardId, attempt, replica, err)
+
+		select {
+		case <-time.After(time.Duration((rand.Float64() + 1) * float64(backoff))):
+		case <-ctx.Done():
+			glog.V(2).Infof("shard %d stops retrying: %v", shardId, ctx.Err())
+			return nil, ctx.Err()
+		}
+
+		backoff *= 2
+		if c.MaxBackoff > 0 && backoff > c.MaxBackoff {
+			backoff = c.MaxBackoff
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("shard %d process error: %w", shardId, err)
+	}
+
+	return results, nil
+
+}
+
+func (c *ClusterClient) sendRequestsToReplica(ctx context.Context, shardId int, replica int, requests []*pb.Request) (results []*pb.Response, err error) {
+
+	ctx, span := tracing.Start(ctx, "vasto.client.replica", attribute.Int("replica", replica))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return nil, &shardError{class: RetryOnTopologyError, err: err}
+	}
+	if _, found := cluster.GetNode(shardId, replica); !found {
+		return nil, &shardError{class: RetryOnTopologyError, err: fmt.Errorf("shard %d replica %d not found", shardId, replica)}
+	}
+
+	conn, err := c.ClusterListener.GetConnectionByShardIdCtx(ctx, c.keyspace, shardId, replica)
+	if err != nil {
+		return nil, &shardError{class: RetryOnConnectionError, err: err}
+	}
+
+	responses, err := conn.SendRequestsCtx(ctx, &pb.Requests{
+		Keyspace:     c.keyspace,
+		Requests:     requests,
+		TraceContext: tracing.Inject(ctx),
+		Token:        c.token(ctx),
+	})
+
+	if err != nil {
+		if ctx.Err() != nil {
+			// the caller has given up, no need to retry
+			return nil, err
+		}
+		var notSent *clusterlistener.NotSentError
+		return nil, &shardError{class: RetryOnConnectionError, err: err, mayBeSent: !errors.As(err, &notSent)}
+	}
+
+	return responses.Responses, nil
+
+}
+
+// sendHedgedReads sends the reads to one replica, and if there are no responses after HedgeAfter,
+// sends the same reads to the next replica. The first successful responses win.
+func (c *ClusterClient) sendHedgedReads(ctx context.Context, shardId int, replica int, requests []*pb.Request) (results []*pb.Response, err error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type answer struct {
+		responses []*pb.Response
+		err       error
+	}
+	answers := make(chan answer, 2)
+	send := func(replica int) {
+		go func() {
+			responses, err := c.sendRequestsToReplica(ctx, shardId, replica, requests)
+			answers <- answer{responses, err}
+		}()
+	}
+
+	send(replica)
+	inFlight, hasHedged := 1, false
+
+	hedgeTimer := time.NewTimer(c.HedgeAfter)
+	defer hedgeTimer.Stop()
+
+	for inFlight > 0 {
+		select {
+		case ans := <-answers:
+			inFlight--
+			if ans.err == nil {
+				return ans.responses, nil
+			}
+			err = ans.err
+		case <-hedgeTimer.C:
+			if !hasHedged {
+				hasHedged = true
+				if next := c.nextReplica(shardId, replica); next != replica {
+					glog.V(2).Infof("shard %d replica %d is slow, hedging to replica %d", shardId, replica, next)
+					send(next)
+					inFlight++
+				}
+			}
+		}
+	}
+
+	return nil, err
+}
+
+// nextReplica returns the next replica of the shard known to the cluster topology
+func (c *ClusterClient) nextReplica(shardId int, replica int) int {
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return replica
+	}
+	replicationFactor := cluster.ReplicationFactor()
+	for i := 1; i < replicationFactor; i++ {
+		next := (replica + i) % replicationFactor
+		if _, found := cluster.GetNode(shardId, next); found {
+			return next
+		}
+	}
+	return replica
+}
+
+// availableReplica starts from the replica, and returns the first one not stopped by the circuit breaker,
+// and not marked stale by the master. The stale replicas are still used if nothing else is available.
+func (c *ClusterClient) availableReplica(shardId int, replica int) int {
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return replica
+	}
+	replicationFactor := cluster.ReplicationFactor()
+	firstAvailable := -1
+	for i := 0; i < replicationFactor; i++ {
+		next := (replica + i) % replicationFactor
+		node, found := cluster.GetNode(shardId, next)
+		if !found || !c.ClusterListener.IsStoreAvailable(node.StoreResource.Address) {
+			continue
+		}
+		if !node.ShardInfo.IsStale {
+			return next
+		}
+		if firstAvailable < 0 {
+			firstAvailable = next
+		}
+	}
+	if firstAvailable >= 0 {
+		return firstAvailable
+	}
+	return replica
+}
+
+func (c *ClusterClient) isRetryable(err error) bool {
+	retryOn := c.RetryOn
+	if retryOn == 0 {
+		retryOn = RetryOnConnectionError
+	}
+	return isErrorClass(err, retryOn)
+}
+
+func isErrorClass(err error, class ErrorClass) bool {
+	if e, ok := err.(*shardError); ok {
+		return e.class&class != 0
+	}
+	return false
+}
+
+func mayBeSent(err error) bool {
+	if e, ok := err.(*shardError); ok {
+		return e.mayBeSent
+	}
+	return false
+}
+
+func isCircuitOpen(err error) bool {
+	if e, ok := err.(*shardError); ok {
+		_, isOpen := e.err.(*clusterlistener.CircuitOpenError)
+		return isOpen
+	}
+	return false
+}
+
+func isReadOnly(requests []*pb.Request) bool {
+	for _, request := range requests {
+		if request.Get == nil && request.GetByPrefix == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// token returns the token passed on by auth.WithToken, or the token of the client
+func (c *ClusterClient) token(ctx context.Context) string {
+	if token, found := auth.TokenFromContext(ctx); found {
+		return token
+	}
+	return c.credentials.Token()
+}
@@ -0,0 +1,250 @@
+package vs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology/clusterlistener"
+	"github.com/chrislusf/vasto/util"
+	"github.com/golang/protobuf/proto"
+)
+
+// fakeStore answers the requests with handle, or drops the connection if handle returns nil
+type fakeStore struct {
+	listener net.Listener
+	handle   func(requests *pb.Requests) *pb.Responses
+	received int32
+}
+
+func newFakeStore(t *testing.T, handle func(requests *pb.Requests) *pb.Responses) *fakeStore {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeStore{listener: listener, handle: handle}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeStore) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		input, err := util.ReadMessage(reader)
+		if err != nil {
+			return
+		}
+		requests := &pb.Requests{}
+		if err = proto.Unmarshal(input, requests); err != nil {
+			return
+		}
+		atomic.AddInt32(&s.received, 1)
+		responses := s.handle(requests)
+		if responses == nil {
+			return
+		}
+		responses.RequestId = requests.RequestId
+		output, _ := proto.Marshal(responses)
+		if err = util.WriteMessage(conn, output); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeStore) address() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeStore) close() {
+	s.listener.Close()
+}
+
+// unusedAddress is an address nothing listens on
+func unusedAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+	return address
+}
+
+func answerAll(requests *pb.Requests) *pb.Responses {
+	responses := &pb.Responses{}
+	for _, request := range requests.Requests {
+		if request.Get != nil {
+			responses.Responses = append(responses.Responses, &pb.Response{Get: &pb.GetResponse{
+				Ok:       true,
+				KeyValue: &pb.KeyTypeValue{Key: request.Get.Key, Value: []byte("v")},
+			}})
+		} else {
+			responses.Responses = append(responses.Responses, &pb.Response{Write: &pb.WriteResponse{
+				Ok:    true,
+				Value: util.Int64ToBytes(1),
+			}})
+		}
+	}
+	return responses
+}
+
+// newTestClusterClient creates a client to one shard with the replicas at the addresses
+func newTestClusterClient(retryConfig RetryConfig, addresses ...string) *ClusterClient {
+	listener := clusterlistener.NewClusterListener("test")
+	cluster := listener.GetOrSetCluster("ks", 1, len(addresses))
+	for i, address := range addresses {
+		cluster.SetShard(&pb.StoreResource{
+			Network: "tcp",
+			Address: address,
+		}, &pb.ShardInfo{
+			KeyspaceName:      "ks",
+			ServerId:          uint32(i),
+			ShardId:           0,
+			ClusterSize:       1,
+			ReplicationFactor: uint32(len(addresses)),
+		})
+	}
+	return &ClusterClient{
+		keyspace:        "ks",
+		ClusterListener: listener,
+		RetryConfig:     retryConfig,
+	}
+}
+
+func TestRetryReadFailover(t *testing.T) {
+
+	store := newFakeStore(t, answerAll)
+	defer store.close()
+
+	c := newTestClusterClient(RetryConfig{MaxAttempts: 2, Backoff: time.Millisecond}, unusedAddress(t), store.address())
+
+	value, _, err := c.Get(Key([]byte("k")))
+	if err != nil || string(value) != "v" {
+		t.Errorf("get with the first replica down: %s, %v", value, err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+
+	// the store drops every connection after reading the requests
+	store := newFakeStore(t, func(requests *pb.Requests) *pb.Responses {
+		return nil
+	})
+	defer store.close()
+
+	c := newTestClusterClient(RetryConfig{MaxAttempts: 3, Backoff: 20 * time.Millisecond}, store.address())
+
+	startTime := time.Now()
+	if _, _, err := c.Get(Key([]byte("k"))); err == nil {
+		t.Errorf("get from a failing store succeeded")
+	}
+	if received := atomic.LoadInt32(&store.received); received != 3 {
+		t.Errorf("read attempts %d, expecting 3", received)
+	}
+	// 20ms and then 40ms, each with up to 100% jitter
+	if elapsed := time.Since(startTime); elapsed < 60*time.Millisecond {
+		t.Errorf("retried without backoff, took %v", elapsed)
+	}
+}
+
+func TestRetryBackoffCancelled(t *testing.T) {
+
+	store := newFakeStore(t, func(requests *pb.Requests) *pb.Responses {
+		return nil
+	})
+	defer store.close()
+
+	c := newTestClusterClient(RetryConfig{MaxAttempts: 3, Backoff: time.Second}, store.address())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	startTime := time.Now()
+	if _, _, err := c.GetCtx(ctx, Key([]byte("k"))); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("get cancelled during the backoff: %v", err)
+	}
+	if elapsed := time.Since(startTime); elapsed > 500*time.Millisecond {
+		t.Errorf("waited for the backoff after the deadline, took %v", elapsed)
+	}
+}
+
+func TestHedgedRead(t *testing.T) {
+
+	// the first replica never answers
+	slowStore := newFakeStore(t, func(requests *pb.Requests) *pb.Responses {
+		time.Sleep(time.Second)
+		return answerAll(requests)
+	})
+	defer slowStore.close()
+	store := newFakeStore(t, answerAll)
+	defer store.close()
+
+	c := newTestClusterClient(RetryConfig{HedgeAfter: 20 * time.Millisecond}, slowStore.address(), store.address())
+
+	startTime := time.Now()
+	value, _, err := c.Get(Key([]byte("k")))
+	if err != nil || string(value) != "v" {
+		t.Errorf("hedged get: %s, %v", value, err)
+	}
+	if elapsed := time.Since(startTime); elapsed > 500*time.Millisecond {
+		t.Errorf("hedged get waited for the slow replica, took %v", elapsed)
+	}
+}
+
+func TestRetryWriteNotAppliedTwice(t *testing.T) {
+
+	// the store applies the merge, but the connection breaks before the response
+	var applied int32
+	store := newFakeStore(t, func(requests *pb.Requests) *pb.Responses {
+		atomic.AddInt32(&applied, 1)
+		return nil
+	})
+	defer store.close()
+
+	c := newTestClusterClient(RetryConfig{MaxAttempts: 3, Backoff: time.Millisecond}, store.address())
+
+	if _, err := c.IncrBy(Key([]byte("counter")), 1); err == nil {
+		t.Errorf("incr with a broken connection succeeded")
+	}
+	if n := atomic.LoadInt32(&applied); n != 1 {
+		t.Errorf("merge is applied %d times, expecting once", n)
+	}
+
+	// idempotent writes can opt in to be resent
+	atomic.StoreInt32(&applied, 0)
+	if err := c.PutCtx(WithRetryWrites(context.Background()), Key([]byte("k")), []byte("v")); err == nil {
+		t.Errorf("put with a broken connection succeeded")
+	}
+	if n := atomic.LoadInt32(&applied); n != 3 {
+		t.Errorf("idempotent put is sent %d times, expecting 3", n)
+	}
+}
+
+func TestRetryWriteNotSent(t *testing.T) {
+
+	// nothing listens on the address, so the write never reaches a store
+	c := newTestClusterClient(RetryConfig{MaxAttempts: 3, Backoff: 10 * time.Millisecond}, unusedAddress(t))
+
+	startTime := time.Now()
+	if err := c.Put(Key([]byte("k")), []byte("v")); err == nil {
+		t.Errorf("put to a missing store succeeded")
+	}
+	// retried twice, after 10ms and 20ms
+	if elapsed := time.Since(startTime); elapsed < 30*time.Millisecond {
+		t.Errorf("unsent write is not retried, took %v", elapsed)
+	}
+}
@@ -0,0 +1,134 @@
+package vs
+
+import (
+	"context"
+	"math"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// SAdd adds the members to the set of the key. The set is merged on the store, without reading it first.
+func (c *ClusterClient) SAdd(key *KeyObject, members ...[]byte) error {
+	return c.SAddCtx(context.Background(), key, members...)
+}
+
+// SAddCtx is SAdd with a context for deadline and cancellation.
+func (c *ClusterClient) SAddCtx(ctx context.Context, key *KeyObject, members ...[]byte) error {
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_SET, codec.EncodeSetOps(members, nil))
+}
+
+// SRem removes the members from the set of the key.
+func (c *ClusterClient) SRem(key *KeyObject, members ...[]byte) error {
+	return c.SRemCtx(context.Background(), key, members...)
+}
+
+// SRemCtx is SRem with a context for deadline and cancellation.
+func (c *ClusterClient) SRemCtx(ctx context.Context, key *KeyObject, members ...[]byte) error {
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_SET, codec.EncodeSetOps(nil, members))
+}
+
+// SMembers returns the members of the set of the key, sorted by the member bytes.
+// A missing key is an empty set.
+func (c *ClusterClient) SMembers(key *KeyObject) ([][]byte, error) {
+	return c.SMembersCtx(context.Background(), key)
+}
+
+// SMembersCtx is SMembers with a context for deadline and cancellation.
+func (c *ClusterClient) SMembersCtx(ctx context.Context, key *KeyObject) ([][]byte, error) {
+
+	value, dataType, err := c.GetCtx(ctx, key)
+	if err == ErrorNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dataType != pb.OpAndDataType_SET {
+		return nil, ErrorWrongDataFormat
+	}
+
+	members, err := codec.DecodeSetMembers(value)
+	if err != nil {
+		return nil, ErrorWrongDataFormat
+	}
+	return members, nil
+}
+
+// ZAdd adds the members to the sorted set of the key, or updates the scores of existing members.
+func (c *ClusterClient) ZAdd(key *KeyObject, members ...codec.ScoredMember) error {
+	return c.ZAddCtx(context.Background(), key, members...)
+}
+
+// ZAddCtx is ZAdd with a context for deadline and cancellation.
+func (c *ClusterClient) ZAddCtx(ctx context.Context, key *KeyObject, members ...codec.ScoredMember) error {
+	ops, err := codec.EncodeSortedSetOps(members, nil)
+	if err != nil {
+		return err
+	}
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_SORTED_SET, ops)
+}
+
+// ZRem removes the members from the sorted set of the key.
+func (c *ClusterClient) ZRem(key *KeyObject, members ...[]byte) error {
+	return c.ZRemCtx(context.Background(), key, members...)
+}
+
+// ZRemCtx is ZRem with a context for deadline and cancellation.
+func (c *ClusterClient) ZRemCtx(ctx context.Context, key *KeyObject, members ...[]byte) error {
+	ops, err := codec.EncodeSortedSetOps(nil, members)
+	if err != nil {
+		return err
+	}
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_SORTED_SET, ops)
+}
+
+// ZRangeByScore returns the members of the sorted set of the key with scores in [min, max], sorted by score.
+// Use math.Inf(-1) and math.Inf(1) for an open range. A missing key is an empty sorted set.
+func (c *ClusterClient) ZRangeByScore(key *KeyObject, min, max float64) ([]codec.ScoredMember, error) {
+	return c.ZRangeByScoreCtx(context.Background(), key, min, max)
+}
+
+// ZRangeByScoreCtx is ZRangeByScore with a context for deadline and cancellation.
+func (c *ClusterClient) ZRangeByScoreCtx(ctx context.Context, key *KeyObject, min, max float64) ([]codec.ScoredMember, error) {
+
+	if math.IsNaN(min) || math.IsNaN(max) {
+		return nil, nil
+	}
+
+	value, dataType, err := c.GetCtx(ctx, key)
+	if err == ErrorNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dataType != pb.OpAndDataType_SORTED_SET {
+		return nil, ErrorWrongDataFormat
+	}
+
+	members, err := codec.DecodeSortedSetMembers(value, min, max)
+	if err != nil {
+		return nil, ErrorWrongDataFormat
+	}
+	return members, nil
+}
+
+func (c *ClusterClient) mergeCollectionCtx(ctx context.Context, key *KeyObject, dataType pb.OpAndDataType, ops []byte) error {
+
+	var requests []*pb.Request
+	request := &pb.Request{
+		Merge: &pb.MergeRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			OpAndDataType: dataType,
+			Value:         ops,
+		},
+	}
+	requests = append(requests, request)
+
+	return c.BatchProcessCtx(ctx, requests, writeError)
+}
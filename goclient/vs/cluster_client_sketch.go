@@ -0,0 +1,127 @@
+package vs
+
+import (
+	"context"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// PFAdd adds the items to the HyperLogLog of the key, to count the distinct items later.
+// The sketch is merged on the store, and is at most 16KB no matter how many items are added.
+func (c *ClusterClient) PFAdd(key *KeyObject, items ...[]byte) error {
+	return c.PFAddCtx(context.Background(), key, items...)
+}
+
+// PFAddCtx is PFAdd with a context for deadline and cancellation.
+func (c *ClusterClient) PFAddCtx(ctx context.Context, key *KeyObject, items ...[]byte) error {
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_HLL, codec.EncodeHllOps(items))
+}
+
+// PFCount estimates the number of distinct items added to the HyperLogLogs of the keys.
+// Missing keys count as empty.
+func (c *ClusterClient) PFCount(keys ...*KeyObject) (uint64, error) {
+	return c.PFCountCtx(context.Background(), keys...)
+}
+
+// PFCountCtx is PFCount with a context for deadline and cancellation.
+func (c *ClusterClient) PFCountCtx(ctx context.Context, keys ...*KeyObject) (uint64, error) {
+
+	values, err := c.getSketchesCtx(ctx, keys, pb.OpAndDataType_HLL)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := codec.HllCount(values...)
+	if err != nil {
+		return 0, ErrorWrongDataFormat
+	}
+	return count, nil
+}
+
+// PFMerge merges the HyperLogLogs of the source keys into the HyperLogLog of the destination key.
+func (c *ClusterClient) PFMerge(destination *KeyObject, sources ...*KeyObject) error {
+	return c.PFMergeCtx(context.Background(), destination, sources...)
+}
+
+// PFMergeCtx is PFMerge with a context for deadline and cancellation.
+func (c *ClusterClient) PFMergeCtx(ctx context.Context, destination *KeyObject, sources ...*KeyObject) error {
+
+	values, err := c.getSketchesCtx(ctx, sources, pb.OpAndDataType_HLL)
+	if err != nil {
+		return err
+	}
+
+	// the source sketches are merge operands as they are
+	var requests []*pb.Request
+	for _, value := range values {
+		requests = append(requests, &pb.Request{
+			Merge: &pb.MergeRequest{
+				Key:           destination.GetKey(),
+				PartitionHash: destination.GetPartitionHash(),
+				UpdatedAtNs:   c.UpdatedAtNs,
+				OpAndDataType: pb.OpAndDataType_HLL,
+				Value:         value,
+			},
+		})
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	return c.BatchProcessCtx(ctx, requests, writeError)
+}
+
+// BloomAdd adds the items to the bloom filter of the key.
+// The filter has codec.DefaultBloomBits bits, for about 100 thousand items at 1% false positives.
+func (c *ClusterClient) BloomAdd(key *KeyObject, items ...[]byte) error {
+	return c.BloomAddCtx(context.Background(), key, items...)
+}
+
+// BloomAddCtx is BloomAdd with a context for deadline and cancellation.
+func (c *ClusterClient) BloomAddCtx(ctx context.Context, key *KeyObject, items ...[]byte) error {
+	ops, err := codec.EncodeBloomOps(codec.DefaultBloomBits, codec.DefaultBloomHashes, items)
+	if err != nil {
+		return err
+	}
+	return c.mergeCollectionCtx(ctx, key, pb.OpAndDataType_BLOOM, ops)
+}
+
+// BloomMightContain checks whether the item might have been added to the bloom filter of the key.
+// False positives are possible, but false negatives are not. A missing key contains nothing.
+func (c *ClusterClient) BloomMightContain(key *KeyObject, item []byte) (bool, error) {
+	return c.BloomMightContainCtx(context.Background(), key, item)
+}
+
+// BloomMightContainCtx is BloomMightContain with a context for deadline and cancellation.
+func (c *ClusterClient) BloomMightContainCtx(ctx context.Context, key *KeyObject, item []byte) (bool, error) {
+
+	values, err := c.getSketchesCtx(ctx, []*KeyObject{key}, pb.OpAndDataType_BLOOM)
+	if err != nil || len(values) == 0 {
+		return false, err
+	}
+
+	found, err := codec.BloomMightContain(values[0], item)
+	if err != nil {
+		return false, ErrorWrongDataFormat
+	}
+	return found, nil
+}
+
+// getSketchesCtx reads the sketch values of the keys, skipping missing keys
+func (c *ClusterClient) getSketchesCtx(ctx context.Context, keys []*KeyObject, dataType pb.OpAndDataType) (values [][]byte, err error) {
+	for _, key := range keys {
+		value, valueType, err := c.GetCtx(ctx, key)
+		if err == ErrorNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if valueType != dataType {
+			return nil, ErrorWrongDataFormat
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
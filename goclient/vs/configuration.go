@@ -1,5 +1,9 @@
 package vs
 
+import (
+	"time"
+)
+
 // WriteConfig stores options for writing
 type WriteConfig struct {
 	UpdatedAtNs uint64 // the update timestamp in nano seconds. Newer entries overwrite older ones. O means now.
@@ -10,3 +14,22 @@ type WriteConfig struct {
 type AccessConfig struct {
 	Replica int // control which replica instance to read from or write to. 0 means the primary copy.
 }
+
+// ErrorClass is a bit set of error kinds that can be retried
+type ErrorClass int
+
+const (
+	// RetryOnConnectionError retries when the store can not be dialed, or the connection breaks
+	RetryOnConnectionError ErrorClass = 1 << iota
+	// RetryOnTopologyError retries when the shard is not found in the cluster topology, e.g., during cluster changes
+	RetryOnTopologyError
+)
+
+// RetryConfig stores options for retrying failed requests to one shard
+type RetryConfig struct {
+	MaxAttempts int           // total number of attempts. 0 or 1 means no retry.
+	Backoff     time.Duration // wait time before the second attempt, doubled for each following attempt, with jitter.
+	MaxBackoff  time.Duration // upper limit of the wait time between attempts. 0 means no limit.
+	RetryOn     ErrorClass    // the kinds of errors to retry. 0 means RetryOnConnectionError.
+	HedgeAfter  time.Duration // for reads, also ask the next replica if no response after this duration. 0 means no hedging.
+}
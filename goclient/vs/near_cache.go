@@ -0,0 +1,160 @@
+package vs
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// NearCacheStats reports the near cache usage
+type NearCacheStats struct {
+	Hits          uint64
+	Misses        uint64
+	Evictions     uint64
+	Invalidations uint64
+	Size          int
+}
+
+// nearCache is a bounded LRU cache with TTL, keyed by keyspace and key.
+// The values are copied in and out, so the callers can not change the cached values.
+type nearCache struct {
+	sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	entries    map[string]*list.Element
+	lru        *list.List
+	// generation changes on every invalidation, so a value read before an invalidation is not cached after it
+	generation uint64
+
+	hits          uint64
+	misses        uint64
+	evictions     uint64
+	invalidations uint64
+}
+
+type nearCacheEntry struct {
+	cacheKey string
+	value    []byte
+	dataType pb.OpAndDataType
+	expireAt time.Time
+}
+
+func newNearCache(maxEntries int, ttl time.Duration) *nearCache {
+	return &nearCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+func toCacheKey(keyspace string, key []byte) string {
+	return keyspace + "\x00" + string(key)
+}
+
+func (nc *nearCache) get(keyspace string, key []byte) (value []byte, dataType pb.OpAndDataType, found bool) {
+	cacheKey := toCacheKey(keyspace, key)
+
+	nc.Lock()
+	defer nc.Unlock()
+
+	element, found := nc.entries[cacheKey]
+	if !found {
+		atomic.AddUint64(&nc.misses, 1)
+		return nil, pb.OpAndDataType_BYTES, false
+	}
+
+	entry := element.Value.(*nearCacheEntry)
+	if nc.ttl > 0 && time.Now().After(entry.expireAt) {
+		nc.removeElement(element)
+		atomic.AddUint64(&nc.misses, 1)
+		return nil, pb.OpAndDataType_BYTES, false
+	}
+
+	nc.lru.MoveToFront(element)
+	atomic.AddUint64(&nc.hits, 1)
+	return append([]byte(nil), entry.value...), entry.dataType, true
+}
+
+// currentGeneration is read before fetching a value to cache
+func (nc *nearCache) currentGeneration() uint64 {
+	nc.Lock()
+	defer nc.Unlock()
+	return nc.generation
+}
+
+// set caches the value, unless any key is invalidated since the generation, which may be this key.
+func (nc *nearCache) set(keyspace string, key []byte, value []byte, dataType pb.OpAndDataType, generation uint64) {
+	cacheKey := toCacheKey(keyspace, key)
+
+	nc.Lock()
+	defer nc.Unlock()
+
+	if nc.generation != generation {
+		return
+	}
+
+	entry := &nearCacheEntry{
+		cacheKey: cacheKey,
+		value:    append([]byte(nil), value...),
+		dataType: dataType,
+		expireAt: time.Now().Add(nc.ttl),
+	}
+
+	if element, found := nc.entries[cacheKey]; found {
+		element.Value = entry
+		nc.lru.MoveToFront(element)
+		return
+	}
+
+	nc.entries[cacheKey] = nc.lru.PushFront(entry)
+
+	for nc.maxEntries > 0 && nc.lru.Len() > nc.maxEntries {
+		nc.removeElement(nc.lru.Back())
+		atomic.AddUint64(&nc.evictions, 1)
+	}
+}
+
+func (nc *nearCache) invalidate(keyspace string, key []byte) {
+	cacheKey := toCacheKey(keyspace, key)
+
+	nc.Lock()
+	defer nc.Unlock()
+
+	nc.generation++
+	if element, found := nc.entries[cacheKey]; found {
+		nc.removeElement(element)
+		atomic.AddUint64(&nc.invalidations, 1)
+	}
+}
+
+func (nc *nearCache) clear() {
+	nc.Lock()
+	defer nc.Unlock()
+
+	nc.generation++
+	atomic.AddUint64(&nc.invalidations, uint64(nc.lru.Len()))
+	nc.entries = make(map[string]*list.Element)
+	nc.lru.Init()
+}
+
+func (nc *nearCache) removeElement(element *list.Element) {
+	nc.lru.Remove(element)
+	delete(nc.entries, element.Value.(*nearCacheEntry).cacheKey)
+}
+
+func (nc *nearCache) stats() NearCacheStats {
+	nc.Lock()
+	size := nc.lru.Len()
+	nc.Unlock()
+	return NearCacheStats{
+		Hits:          atomic.LoadUint64(&nc.hits),
+		Misses:        atomic.LoadUint64(&nc.misses),
+		Evictions:     atomic.LoadUint64(&nc.evictions),
+		Invalidations: atomic.LoadUint64(&nc.invalidations),
+		Size:          size,
+	}
+}
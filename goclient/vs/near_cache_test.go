@@ -0,0 +1,84 @@
+package vs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestNearCacheEviction(t *testing.T) {
+
+	nc := newNearCache(2, time.Minute)
+
+	nc.set("ks1", []byte("a"), []byte("1"), pb.OpAndDataType_BYTES, nc.currentGeneration())
+	nc.set("ks1", []byte("b"), []byte("2"), pb.OpAndDataType_BYTES, nc.currentGeneration())
+	nc.get("ks1", []byte("a"))
+	nc.set("ks1", []byte("c"), []byte("3"), pb.OpAndDataType_BYTES, nc.currentGeneration())
+
+	if _, _, found := nc.get("ks1", []byte("b")); found {
+		t.Errorf("least recently used key b should be evicted")
+	}
+	if value, _, found := nc.get("ks1", []byte("a")); !found || string(value) != "1" {
+		t.Errorf("unexpected key a: %s %v", value, found)
+	}
+	if _, _, found := nc.get("ks2", []byte("a")); found {
+		t.Errorf("keys should not be shared across keyspaces")
+	}
+
+	nc.invalidate("ks1", []byte("a"))
+	if _, _, found := nc.get("ks1", []byte("a")); found {
+		t.Errorf("key a should be invalidated")
+	}
+
+	stats := nc.stats()
+	if stats.Hits != 2 || stats.Misses != 3 || stats.Evictions != 1 || stats.Invalidations != 1 || stats.Size != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+}
+
+func TestNearCacheTtl(t *testing.T) {
+
+	nc := newNearCache(10, 10*time.Millisecond)
+
+	nc.set("ks1", []byte("a"), []byte("1"), pb.OpAndDataType_BYTES, nc.currentGeneration())
+	time.Sleep(20 * time.Millisecond)
+
+	if _, _, found := nc.get("ks1", []byte("a")); found {
+		t.Errorf("key a should be expired")
+	}
+
+}
+
+func TestNearCacheInvalidatedDuringFetch(t *testing.T) {
+
+	nc := newNearCache(10, time.Minute)
+
+	// the value is read before the key is invalidated, and arrives after it
+	generation := nc.currentGeneration()
+	nc.invalidate("ks1", []byte("a"))
+	nc.set("ks1", []byte("a"), []byte("stale"), pb.OpAndDataType_BYTES, generation)
+
+	if value, _, found := nc.get("ks1", []byte("a")); found {
+		t.Errorf("stale value is cached: %s", value)
+	}
+
+}
+
+func TestNearCacheCopiesValues(t *testing.T) {
+
+	nc := newNearCache(10, time.Minute)
+
+	value := []byte("1")
+	nc.set("ks1", []byte("a"), value, pb.OpAndDataType_BYTES, nc.currentGeneration())
+	value[0] = '2'
+
+	returned, _, _ := nc.get("ks1", []byte("a"))
+	returned[0] = '3'
+
+	if cached, _, _ := nc.get("ks1", []byte("a")); string(cached) != "1" {
+		t.Errorf("cached value is changed to %s", cached)
+	}
+
+}
@@ -34,6 +34,14 @@ func Float64Value(value float64) *ValueObject {
 	}
 }
 
+// Int64Value creates a ValueObject with an int64 value
+func Int64Value(value int64) *ValueObject {
+	return &ValueObject{
+		value:     util.Int64ToBytes(value),
+		valueType: pb.OpAndDataType_INT64,
+	}
+}
+
 // GetValue returns the value bytes
 func (v *ValueObject) GetValue() []byte {
 	return v.value
@@ -44,6 +52,11 @@ func (v *ValueObject) GetFloat64() float64 {
 	return util.BytesToFloat64(v.value)
 }
 
+// GetInt64 returns the value int64
+func (v *ValueObject) GetInt64() int64 {
+	return util.BytesToInt64(v.value)
+}
+
 // GetValueType returns the data type of the value
 func (v *ValueObject) GetValueType() pb.OpAndDataType {
 	return v.valueType
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/auth"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/security"
 	"github.com/chrislusf/vasto/topology/clusterlistener"
 	"google.golang.org/grpc"
 	"time"
@@ -17,20 +19,36 @@ type VastoClient struct {
 	ClientName      string
 	ClusterListener *clusterlistener.ClusterListener
 	MasterClient    pb.VastoMasterClient
+	credentials     *auth.TokenCredentials
+}
+
+// VastoClientOption changes how the vasto client connects to the servers
+type VastoClientOption func(*VastoClient)
+
+// WithTls connects to the master and the stores with TLS. A nil Tls means plaintext.
+func WithTls(tls *security.Tls) VastoClientOption {
+	return func(c *VastoClient) {
+		c.ClusterListener.SetTls(tls)
+	}
 }
 
 // NewVastoClient creates a vasto client which contains a listener for the vasto system topology changes
-func NewVastoClient(ctx context.Context, clientName, master string) *VastoClient {
+func NewVastoClient(ctx context.Context, clientName, master string, options ...VastoClientOption) *VastoClient {
 	c := &VastoClient{
 		ctx:             ctx,
 		ClusterListener: clusterlistener.NewClusterListener(clientName),
 		Master:          master,
 		ClientName:      clientName,
+		credentials:     auth.NewTokenCredentials(""),
+	}
+	for _, option := range options {
+		option(c)
 	}
+	c.ClusterListener.SetDialOptions(grpc.WithPerRPCCredentials(c.credentials))
 	// c.ClusterListener.RegisterShardEventProcessor(&clusterlistener.ClusterEventLogger{Prefix: clientName + " "})
 	c.ClusterListener.StartListener(ctx, c.Master)
 
-	conn, err := grpc.Dial(c.Master, grpc.WithInsecure())
+	conn, err := grpc.Dial(c.Master, c.ClusterListener.DialOptions()...)
 	if err != nil {
 		glog.Fatalf("%s fail to dial %v: %v", c.ClientName, c.Master, err)
 	}
@@ -50,12 +68,32 @@ func (c *VastoClient) NewClusterClient(keyspace string) (clusterClient *ClusterC
 	return &ClusterClient{
 		keyspace:        keyspace,
 		ClusterListener: c.ClusterListener,
+		credentials:     c.credentials,
+	}
+
+}
+
+// CreateClusterOption changes the keyspace settings when creating the cluster
+type CreateClusterOption func(*pb.CreateClusterRequest)
+
+// WithEngine sets the storage engine of the keyspace, e.g., rocksdb, leveldb, or memory.
+// The stores use their default engine if not set.
+func WithEngine(engine string) CreateClusterOption {
+	return func(req *pb.CreateClusterRequest) {
+		req.Engine = engine
 	}
+}
 
+// WithStorageProfile tunes the db of each shard of the keyspace.
+// The profile can be a preset, e.g., point-lookup, scan-heavy, or write-heavy, with the non-zero fields overriding the preset.
+func WithStorageProfile(profile *pb.StorageProfile) CreateClusterOption {
+	return func(req *pb.CreateClusterRequest) {
+		req.StorageProfile = profile
+	}
 }
 
 // CreateCluster creates a new cluster of the keyspace in the data center, with size and replication factor
-func (c *VastoClient) CreateCluster(keyspace string, clusterSize, replicationFactor int) (*pb.Cluster, error) {
+func (c *VastoClient) CreateCluster(keyspace string, clusterSize, replicationFactor int, options ...CreateClusterOption) (*pb.Cluster, error) {
 
 	if replicationFactor == 0 {
 		return nil, fmt.Errorf("replication factor %d should be greater than 0", replicationFactor)
@@ -65,14 +103,16 @@ func (c *VastoClient) CreateCluster(keyspace string, clusterSize, replicationFac
 		return nil, fmt.Errorf("replication factor %d should not be bigger than cluster size %d", replicationFactor, clusterSize)
 	}
 
-	resp, err := c.MasterClient.CreateCluster(
-		c.ctx,
-		&pb.CreateClusterRequest{
-			Keyspace:          keyspace,
-			ClusterSize:       uint32(clusterSize),
-			ReplicationFactor: uint32(replicationFactor),
-		},
-	)
+	req := &pb.CreateClusterRequest{
+		Keyspace:          keyspace,
+		ClusterSize:       uint32(clusterSize),
+		ReplicationFactor: uint32(replicationFactor),
+	}
+	for _, option := range options {
+		option(req)
+	}
+
+	resp, err := c.MasterClient.CreateCluster(c.ctx, req)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s create cluster request: %v", c.ClientName, err)
@@ -171,3 +211,48 @@ func (c *VastoClient) ReplaceNode(keyspace string, nodeId uint32, newAddress str
 	return nil
 
 }
+
+// SetKeyspaceQuota changes the ops/sec, bytes/sec and disk limits of the keyspace. 0 means unlimited.
+func (c *VastoClient) SetKeyspaceQuota(quota *pb.KeyspaceQuota) error {
+
+	resp, err := c.MasterClient.SetKeyspaceQuota(
+		c.ctx,
+		&pb.SetKeyspaceQuotaRequest{
+			Quota: quota,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("set keyspace quota request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("set keyspace quota: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// SetToken sets the token sent to the master and the stores, for the following requests.
+func (c *VastoClient) SetToken(token string) {
+	c.credentials.SetToken(token)
+}
+
+// Login checks the token with the master, and uses it for the following requests if it is valid.
+func (c *VastoClient) Login(token string) (*pb.LoginResponse, error) {
+
+	resp, err := c.MasterClient.Login(c.ctx, &pb.LoginRequest{},
+		grpc.PerRPCCredentials(auth.NewTokenCredentials(token)))
+
+	if err != nil {
+		return nil, fmt.Errorf("login request: %v", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("login: %v", resp.Error)
+	}
+
+	c.credentials.SetToken(token)
+
+	return resp, nil
+
+}
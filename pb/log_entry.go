@@ -16,9 +16,11 @@ func (entry *LogEntry) GetKey() []byte {
 }
 
 func (entry *LogEntry) getWriteRequest() (request writeRequest) {
-	request = entry.GetPut()
-	if request == nil {
-		request = entry.GetDelete()
+	if entry.GetPut() != nil {
+		return entry.GetPut()
 	}
-	return request
+	if entry.GetMerge() != nil {
+		return entry.GetMerge()
+	}
+	return entry.GetDelete()
 }
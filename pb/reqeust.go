@@ -27,3 +27,21 @@ func (r *Request) GetPartitionHash() uint64 {
 	glog.Fatalf("unexpected request without partition hash %v", r)
 	return 0
 }
+
+// OpName returns the op type of the request, for metrics labels and span names.
+func (r *Request) OpName() string {
+	if r.GetGet() != nil {
+		return "get"
+	} else if r.GetPut() != nil {
+		return "put"
+	} else if r.GetMerge() != nil {
+		return "merge"
+	} else if r.GetDelete() != nil {
+		return "delete"
+	} else if r.GetDeleteRange() != nil {
+		return "delete_range"
+	} else if r.GetGetByPrefix() != nil {
+		return "prefix"
+	}
+	return "unknown"
+}
@@ -0,0 +1,13 @@
+package pb
+
+import (
+	"errors"
+)
+
+// StatusError returns the failed status of the response as an error, or nil if it is ok
+func (r *Response) StatusError() error {
+	if status := r.GetGet().GetStatus() + r.GetWrite().GetStatus() + r.GetGetByPrefix().GetStatus(); status != "" {
+		return errors.New(status)
+	}
+	return nil
+}
@@ -17,5 +17,6 @@ func (s *ShardInfo) Clone() *ShardInfo {
 		ReplicationFactor: s.ReplicationFactor,
 		IsCandidate:       s.IsCandidate,
 		Status:            s.Status,
+		IsStale:           s.IsStale,
 	}
 }
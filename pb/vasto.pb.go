@@ -1,16 +1,18 @@
-// Code generated by protoc-gen-go.
+// Code generated by protoc-gen-go. DO NOT EDIT.
 // source: vasto.proto
-// DO NOT EDIT!
 
 /*
 Package pb is a generated protocol buffer package.
 
 It is generated from these files:
+
 	vasto.proto
 
 It has these top-level messages:
+
 	BalanceRequest
 	StoreHeartbeat
+	ReplicationLag
 	StoreMessage
 	ClientHeartbeat
 	ClientMessage
@@ -18,6 +20,12 @@ It has these top-level messages:
 	ClusterNode
 	StoreResource
 	LocalShardsInCluster
+	AccessControlList
+	AclUser
+	AclGrant
+	KeyspaceQuota
+	KeyspaceStorage
+	StorageProfile
 	ShardInfo
 	Empty
 	KeyTypeValue
@@ -28,6 +36,7 @@ It has these top-level messages:
 	MergeRequest
 	WriteResponse
 	DeleteRequest
+	DeleteRangeRequest
 	GetRequest
 	GetResponse
 	GetByPrefixRequest
@@ -52,12 +61,24 @@ It has these top-level messages:
 	CompactClusterResponse
 	ReplaceNodeRequest
 	ReplaceNodeResponse
+	LoginRequest
+	LoginResponse
+	AuditRecord
+	SetKeyspaceQuotaRequest
+	SetKeyspaceQuotaResponse
 	CreateShardRequest
 	CreateShardResponse
 	DeleteKeyspaceRequest
 	DeleteKeyspaceResponse
 	CompactKeyspaceRequest
 	CompactKeyspaceResponse
+	HotspotsRequest
+	HotspotsResponse
+	ShardStatsRequest
+	ShardStatsResponse
+	DbStats
+	HotKey
+	SlowOp
 	ReplicateNodePrepareRequest
 	ReplicateNodePrepareResponse
 	ReplicateNodeCommitRequest
@@ -102,19 +123,43 @@ const (
 	OpAndDataType_FLOAT64     OpAndDataType = 1
 	OpAndDataType_MAX_FLOAT64 OpAndDataType = 2
 	OpAndDataType_MIN_FLOAT64 OpAndDataType = 3
+	OpAndDataType_INT64       OpAndDataType = 4
+	OpAndDataType_MAX_INT64   OpAndDataType = 5
+	OpAndDataType_MIN_INT64   OpAndDataType = 6
+	OpAndDataType_SET         OpAndDataType = 7
+	OpAndDataType_SORTED_SET  OpAndDataType = 8
+	OpAndDataType_HLL         OpAndDataType = 9
+	OpAndDataType_BLOOM       OpAndDataType = 10
+	OpAndDataType_LIST        OpAndDataType = 11
 )
 
 var OpAndDataType_name = map[int32]string{
-	0: "BYTES",
-	1: "FLOAT64",
-	2: "MAX_FLOAT64",
-	3: "MIN_FLOAT64",
+	0:  "BYTES",
+	1:  "FLOAT64",
+	2:  "MAX_FLOAT64",
+	3:  "MIN_FLOAT64",
+	4:  "INT64",
+	5:  "MAX_INT64",
+	6:  "MIN_INT64",
+	7:  "SET",
+	8:  "SORTED_SET",
+	9:  "HLL",
+	10: "BLOOM",
+	11: "LIST",
 }
 var OpAndDataType_value = map[string]int32{
 	"BYTES":       0,
 	"FLOAT64":     1,
 	"MAX_FLOAT64": 2,
 	"MIN_FLOAT64": 3,
+	"INT64":       4,
+	"MAX_INT64":   5,
+	"MIN_INT64":   6,
+	"SET":         7,
+	"SORTED_SET":  8,
+	"HLL":         9,
+	"BLOOM":       10,
+	"LIST":        11,
 }
 
 func (x OpAndDataType) String() string {
@@ -122,6 +167,47 @@ func (x OpAndDataType) String() string {
 }
 func (OpAndDataType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+type StorageProfile_Compression int32
+
+const (
+	StorageProfile_DEFAULT StorageProfile_Compression = 0
+	StorageProfile_NONE    StorageProfile_Compression = 1
+	StorageProfile_SNAPPY  StorageProfile_Compression = 2
+	StorageProfile_ZLIB    StorageProfile_Compression = 3
+	StorageProfile_BZ2     StorageProfile_Compression = 4
+	StorageProfile_LZ4     StorageProfile_Compression = 5
+	StorageProfile_LZ4HC   StorageProfile_Compression = 6
+	StorageProfile_ZSTD    StorageProfile_Compression = 7
+)
+
+var StorageProfile_Compression_name = map[int32]string{
+	0: "DEFAULT",
+	1: "NONE",
+	2: "SNAPPY",
+	3: "ZLIB",
+	4: "BZ2",
+	5: "LZ4",
+	6: "LZ4HC",
+	7: "ZSTD",
+}
+var StorageProfile_Compression_value = map[string]int32{
+	"DEFAULT": 0,
+	"NONE":    1,
+	"SNAPPY":  2,
+	"ZLIB":    3,
+	"BZ2":     4,
+	"LZ4":     5,
+	"LZ4HC":   6,
+	"ZSTD":    7,
+}
+
+func (x StorageProfile_Compression) String() string {
+	return proto.EnumName(StorageProfile_Compression_name, int32(x))
+}
+func (StorageProfile_Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{15, 0}
+}
+
 type ShardInfo_Status int32
 
 const (
@@ -147,7 +233,7 @@ var ShardInfo_Status_value = map[string]int32{
 func (x ShardInfo_Status) String() string {
 	return proto.EnumName(ShardInfo_Status_name, int32(x))
 }
-func (ShardInfo_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9, 0} }
+func (ShardInfo_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{16, 0} }
 
 // ////////////////////////////////////////////////
 // 1. master received request to balance the data
@@ -180,6 +266,12 @@ type StoreHeartbeat struct {
 	StoreResource *StoreResource `protobuf:"bytes,1,opt,name=store_resource,json=storeResource" json:"store_resource,omitempty"`
 	// sent to master one at a time, after the initial heartbeat
 	ShardInfo *ShardInfo `protobuf:"bytes,2,opt,name=ShardInfo" json:"ShardInfo,omitempty"`
+	// sent to master periodically, after the initial heartbeat
+	ReplicationLags []*ReplicationLag `protobuf:"bytes,3,rep,name=replication_lags,json=replicationLags" json:"replication_lags,omitempty"`
+	// only in the initial heartbeat, to restore the quotas after the master restarts
+	KeyspaceQuotas []*KeyspaceQuota `protobuf:"bytes,4,rep,name=keyspace_quotas,json=keyspaceQuotas" json:"keyspace_quotas,omitempty"`
+	// only in the initial heartbeat, to restore the storage engines after the master restarts
+	KeyspaceStorages []*KeyspaceStorage `protobuf:"bytes,5,rep,name=keyspace_storages,json=keyspaceStorages" json:"keyspace_storages,omitempty"`
 }
 
 func (m *StoreHeartbeat) Reset()                    { *m = StoreHeartbeat{} }
@@ -201,13 +293,128 @@ func (m *StoreHeartbeat) GetShardInfo() *ShardInfo {
 	return nil
 }
 
+func (m *StoreHeartbeat) GetReplicationLags() []*ReplicationLag {
+	if m != nil {
+		return m.ReplicationLags
+	}
+	return nil
+}
+
+func (m *StoreHeartbeat) GetKeyspaceQuotas() []*KeyspaceQuota {
+	if m != nil {
+		return m.KeyspaceQuotas
+	}
+	return nil
+}
+
+func (m *StoreHeartbeat) GetKeyspaceStorages() []*KeyspaceStorage {
+	if m != nil {
+		return m.KeyspaceStorages
+	}
+	return nil
+}
+
+// ReplicationLag is how far one shard is behind one peer shard it follows
+type ReplicationLag struct {
+	Keyspace       string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId       uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId        uint32 `protobuf:"varint,3,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	SourceServerId uint32 `protobuf:"varint,4,opt,name=source_server_id,json=sourceServerId" json:"source_server_id,omitempty"`
+	SourceShardId  uint32 `protobuf:"varint,5,opt,name=source_shard_id,json=sourceShardId" json:"source_shard_id,omitempty"`
+	// estimated by the average binlog entry size of the source
+	Entries uint64 `protobuf:"varint,6,opt,name=entries" json:"entries,omitempty"`
+	Bytes   uint64 `protobuf:"varint,7,opt,name=bytes" json:"bytes,omitempty"`
+	TimeNs  uint64 `protobuf:"varint,8,opt,name=time_ns,json=timeNs" json:"time_ns,omitempty"`
+}
+
+func (m *ReplicationLag) Reset()                    { *m = ReplicationLag{} }
+func (m *ReplicationLag) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationLag) ProtoMessage()               {}
+func (*ReplicationLag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ReplicationLag) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReplicationLag) GetServerId() uint32 {
+	if m != nil {
+		return m.ServerId
+	}
+	return 0
+}
+
+func (m *ReplicationLag) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ReplicationLag) GetSourceServerId() uint32 {
+	if m != nil {
+		return m.SourceServerId
+	}
+	return 0
+}
+
+func (m *ReplicationLag) GetSourceShardId() uint32 {
+	if m != nil {
+		return m.SourceShardId
+	}
+	return 0
+}
+
+func (m *ReplicationLag) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *ReplicationLag) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *ReplicationLag) GetTimeNs() uint64 {
+	if m != nil {
+		return m.TimeNs
+	}
+	return 0
+}
+
 type StoreMessage struct {
+	// the access control list to check the tokens.
+	// The stores deny all calls until the first message from the master.
+	// The user tokens are in plaintext unless TLS is enabled.
+	AccessControlList *AccessControlList `protobuf:"bytes,1,opt,name=access_control_list,json=accessControlList" json:"access_control_list,omitempty"`
+	// the master has no access control list, so the stores allow all calls
+	AccessControlDisabled bool `protobuf:"varint,2,opt,name=access_control_disabled,json=accessControlDisabled" json:"access_control_disabled,omitempty"`
 }
 
 func (m *StoreMessage) Reset()                    { *m = StoreMessage{} }
 func (m *StoreMessage) String() string            { return proto.CompactTextString(m) }
 func (*StoreMessage) ProtoMessage()               {}
-func (*StoreMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*StoreMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *StoreMessage) GetAccessControlList() *AccessControlList {
+	if m != nil {
+		return m.AccessControlList
+	}
+	return nil
+}
+
+func (m *StoreMessage) GetAccessControlDisabled() bool {
+	if m != nil {
+		return m.AccessControlDisabled
+	}
+	return false
+}
 
 type ClientHeartbeat struct {
 	ClientName    string                                `protobuf:"bytes,2,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
@@ -217,7 +424,7 @@ type ClientHeartbeat struct {
 func (m *ClientHeartbeat) Reset()                    { *m = ClientHeartbeat{} }
 func (m *ClientHeartbeat) String() string            { return proto.CompactTextString(m) }
 func (*ClientHeartbeat) ProtoMessage()               {}
-func (*ClientHeartbeat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*ClientHeartbeat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *ClientHeartbeat) GetClientName() string {
 	if m != nil {
@@ -242,7 +449,7 @@ func (m *ClientHeartbeat_ClusterFollowMessage) Reset()         { *m = ClientHear
 func (m *ClientHeartbeat_ClusterFollowMessage) String() string { return proto.CompactTextString(m) }
 func (*ClientHeartbeat_ClusterFollowMessage) ProtoMessage()    {}
 func (*ClientHeartbeat_ClusterFollowMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{3, 0}
+	return fileDescriptor0, []int{4, 0}
 }
 
 func (m *ClientHeartbeat_ClusterFollowMessage) GetKeyspace() string {
@@ -268,7 +475,7 @@ type ClientMessage struct {
 func (m *ClientMessage) Reset()                    { *m = ClientMessage{} }
 func (m *ClientMessage) String() string            { return proto.CompactTextString(m) }
 func (*ClientMessage) ProtoMessage()               {}
-func (*ClientMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*ClientMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *ClientMessage) GetCluster() *Cluster {
 	if m != nil {
@@ -302,7 +509,7 @@ func (m *ClientMessage_StoreResourceUpdate) Reset()         { *m = ClientMessage
 func (m *ClientMessage_StoreResourceUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClientMessage_StoreResourceUpdate) ProtoMessage()    {}
 func (*ClientMessage_StoreResourceUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{4, 0}
+	return fileDescriptor0, []int{5, 0}
 }
 
 func (m *ClientMessage_StoreResourceUpdate) GetNodes() []*ClusterNode {
@@ -342,7 +549,7 @@ type ClientMessage_Resize struct {
 func (m *ClientMessage_Resize) Reset()                    { *m = ClientMessage_Resize{} }
 func (m *ClientMessage_Resize) String() string            { return proto.CompactTextString(m) }
 func (*ClientMessage_Resize) ProtoMessage()               {}
-func (*ClientMessage_Resize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 1} }
+func (*ClientMessage_Resize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5, 1} }
 
 func (m *ClientMessage_Resize) GetCurrentClusterSize() uint32 {
 	if m != nil {
@@ -376,7 +583,7 @@ type Cluster struct {
 func (m *Cluster) Reset()                    { *m = Cluster{} }
 func (m *Cluster) String() string            { return proto.CompactTextString(m) }
 func (*Cluster) ProtoMessage()               {}
-func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *Cluster) GetKeyspace() string {
 	if m != nil {
@@ -422,7 +629,7 @@ type ClusterNode struct {
 func (m *ClusterNode) Reset()                    { *m = ClusterNode{} }
 func (m *ClusterNode) String() string            { return proto.CompactTextString(m) }
 func (*ClusterNode) ProtoMessage()               {}
-func (*ClusterNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*ClusterNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *ClusterNode) GetStoreResource() *StoreResource {
 	if m != nil {
@@ -450,7 +657,7 @@ type StoreResource struct {
 func (m *StoreResource) Reset()                    { *m = StoreResource{} }
 func (m *StoreResource) String() string            { return proto.CompactTextString(m) }
 func (*StoreResource) ProtoMessage()               {}
-func (*StoreResource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*StoreResource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *StoreResource) GetNetwork() string {
 	if m != nil {
@@ -501,13 +708,15 @@ type LocalShardsInCluster struct {
 	// duplicated info, need to validate on master when reconvene
 	ClusterSize uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
 	// duplicated info, need to validate on master when reconvene
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	Quota             *KeyspaceQuota   `protobuf:"bytes,5,opt,name=quota" json:"quota,omitempty"`
+	Storage           *KeyspaceStorage `protobuf:"bytes,6,opt,name=storage" json:"storage,omitempty"`
 }
 
 func (m *LocalShardsInCluster) Reset()                    { *m = LocalShardsInCluster{} }
 func (m *LocalShardsInCluster) String() string            { return proto.CompactTextString(m) }
 func (*LocalShardsInCluster) ProtoMessage()               {}
-func (*LocalShardsInCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*LocalShardsInCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *LocalShardsInCluster) GetId() uint32 {
 	if m != nil {
@@ -537,6 +746,256 @@ func (m *LocalShardsInCluster) GetReplicationFactor() uint32 {
 	return 0
 }
 
+func (m *LocalShardsInCluster) GetQuota() *KeyspaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *LocalShardsInCluster) GetStorage() *KeyspaceStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+// AccessControlList is loaded on the master and pushed to the stores
+type AccessControlList struct {
+	Users []*AclUser `protobuf:"bytes,1,rep,name=users" json:"users,omitempty"`
+}
+
+func (m *AccessControlList) Reset()                    { *m = AccessControlList{} }
+func (m *AccessControlList) String() string            { return proto.CompactTextString(m) }
+func (*AccessControlList) ProtoMessage()               {}
+func (*AccessControlList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *AccessControlList) GetUsers() []*AclUser {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type AclUser struct {
+	Name   string      `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Token  string      `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	Grants []*AclGrant `protobuf:"bytes,3,rep,name=grants" json:"grants,omitempty"`
+}
+
+func (m *AclUser) Reset()                    { *m = AclUser{} }
+func (m *AclUser) String() string            { return proto.CompactTextString(m) }
+func (*AclUser) ProtoMessage()               {}
+func (*AclUser) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *AclUser) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AclUser) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AclUser) GetGrants() []*AclGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+type AclGrant struct {
+	// "*" applies to all keyspaces
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Read     bool   `protobuf:"varint,2,opt,name=read" json:"read,omitempty"`
+	Write    bool   `protobuf:"varint,3,opt,name=write" json:"write,omitempty"`
+	// admin also allows read and write
+	Admin bool `protobuf:"varint,4,opt,name=admin" json:"admin,omitempty"`
+}
+
+func (m *AclGrant) Reset()                    { *m = AclGrant{} }
+func (m *AclGrant) String() string            { return proto.CompactTextString(m) }
+func (*AclGrant) ProtoMessage()               {}
+func (*AclGrant) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *AclGrant) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *AclGrant) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *AclGrant) GetWrite() bool {
+	if m != nil {
+		return m.Write
+	}
+	return false
+}
+
+func (m *AclGrant) GetAdmin() bool {
+	if m != nil {
+		return m.Admin
+	}
+	return false
+}
+
+// KeyspaceQuota limits for the whole cluster of a keyspace. 0 means unlimited.
+// Each store enforces its share, 1/cluster_size of the limits.
+type KeyspaceQuota struct {
+	Keyspace        string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	OpsPerSecond    uint64 `protobuf:"varint,2,opt,name=ops_per_second,json=opsPerSecond" json:"ops_per_second,omitempty"`
+	BytesPerSecond  uint64 `protobuf:"varint,3,opt,name=bytes_per_second,json=bytesPerSecond" json:"bytes_per_second,omitempty"`
+	TotalDiskSizeGb uint32 `protobuf:"varint,4,opt,name=total_disk_size_gb,json=totalDiskSizeGb" json:"total_disk_size_gb,omitempty"`
+}
+
+func (m *KeyspaceQuota) Reset()                    { *m = KeyspaceQuota{} }
+func (m *KeyspaceQuota) String() string            { return proto.CompactTextString(m) }
+func (*KeyspaceQuota) ProtoMessage()               {}
+func (*KeyspaceQuota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *KeyspaceQuota) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *KeyspaceQuota) GetOpsPerSecond() uint64 {
+	if m != nil {
+		return m.OpsPerSecond
+	}
+	return 0
+}
+
+func (m *KeyspaceQuota) GetBytesPerSecond() uint64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+func (m *KeyspaceQuota) GetTotalDiskSizeGb() uint32 {
+	if m != nil {
+		return m.TotalDiskSizeGb
+	}
+	return 0
+}
+
+// KeyspaceStorage is how the stores keep the data of a keyspace, chosen when the keyspace is created.
+type KeyspaceStorage struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	// storage engine, e.g., rocksdb, leveldb, or memory. Empty means the default engine of each store.
+	Engine  string          `protobuf:"bytes,2,opt,name=engine" json:"engine,omitempty"`
+	Profile *StorageProfile `protobuf:"bytes,3,opt,name=profile" json:"profile,omitempty"`
+}
+
+func (m *KeyspaceStorage) Reset()                    { *m = KeyspaceStorage{} }
+func (m *KeyspaceStorage) String() string            { return proto.CompactTextString(m) }
+func (*KeyspaceStorage) ProtoMessage()               {}
+func (*KeyspaceStorage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *KeyspaceStorage) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *KeyspaceStorage) GetEngine() string {
+	if m != nil {
+		return m.Engine
+	}
+	return ""
+}
+
+func (m *KeyspaceStorage) GetProfile() *StorageProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// StorageProfile tunes the db of each shard, applied when the shard opens. 0 or empty fields use the engine defaults.
+type StorageProfile struct {
+	// the preset the other fields are resolved from, e.g., point-lookup, scan-heavy, or write-heavy
+	Preset           string `protobuf:"bytes,1,opt,name=preset" json:"preset,omitempty"`
+	BlockCacheSizeMb uint32 `protobuf:"varint,2,opt,name=block_cache_size_mb,json=blockCacheSizeMb" json:"block_cache_size_mb,omitempty"`
+	// bits per key of the bloom filters
+	BloomFilterBits uint32 `protobuf:"varint,3,opt,name=bloom_filter_bits,json=bloomFilterBits" json:"bloom_filter_bits,omitempty"`
+	// from level 0 to the bottommost level
+	CompressionPerLevel []StorageProfile_Compression `protobuf:"varint,4,rep,packed,name=compression_per_level,json=compressionPerLevel,enum=pb.StorageProfile_Compression" json:"compression_per_level,omitempty"`
+	WriteBufferSizeMb   uint32                       `protobuf:"varint,5,opt,name=write_buffer_size_mb,json=writeBufferSizeMb" json:"write_buffer_size_mb,omitempty"`
+	UseDirectIo         bool                         `protobuf:"varint,6,opt,name=use_direct_io,json=useDirectIo" json:"use_direct_io,omitempty"`
+	// only for data that can be derived again, since unflushed writes are lost if the store crashes
+	DisableWal bool `protobuf:"varint,7,opt,name=disable_wal,json=disableWal" json:"disable_wal,omitempty"`
+}
+
+func (m *StorageProfile) Reset()                    { *m = StorageProfile{} }
+func (m *StorageProfile) String() string            { return proto.CompactTextString(m) }
+func (*StorageProfile) ProtoMessage()               {}
+func (*StorageProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *StorageProfile) GetPreset() string {
+	if m != nil {
+		return m.Preset
+	}
+	return ""
+}
+
+func (m *StorageProfile) GetBlockCacheSizeMb() uint32 {
+	if m != nil {
+		return m.BlockCacheSizeMb
+	}
+	return 0
+}
+
+func (m *StorageProfile) GetBloomFilterBits() uint32 {
+	if m != nil {
+		return m.BloomFilterBits
+	}
+	return 0
+}
+
+func (m *StorageProfile) GetCompressionPerLevel() []StorageProfile_Compression {
+	if m != nil {
+		return m.CompressionPerLevel
+	}
+	return nil
+}
+
+func (m *StorageProfile) GetWriteBufferSizeMb() uint32 {
+	if m != nil {
+		return m.WriteBufferSizeMb
+	}
+	return 0
+}
+
+func (m *StorageProfile) GetUseDirectIo() bool {
+	if m != nil {
+		return m.UseDirectIo
+	}
+	return false
+}
+
+func (m *StorageProfile) GetDisableWal() bool {
+	if m != nil {
+		return m.DisableWal
+	}
+	return false
+}
+
 type ShardInfo struct {
 	KeyspaceName      string           `protobuf:"bytes,1,opt,name=keyspace_name,json=keyspaceName" json:"keyspace_name,omitempty"`
 	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
@@ -546,12 +1005,14 @@ type ShardInfo struct {
 	Status            ShardInfo_Status `protobuf:"varint,6,opt,name=status,enum=pb.ShardInfo_Status" json:"status,omitempty"`
 	IsCandidate       bool             `protobuf:"varint,7,opt,name=is_candidate,json=isCandidate" json:"is_candidate,omitempty"`
 	IsPermanentDelete bool             `protobuf:"varint,8,opt,name=is_permanent_delete,json=isPermanentDelete" json:"is_permanent_delete,omitempty"`
+	// set by the master if the replication lag is over the threshold
+	IsStale bool `protobuf:"varint,9,opt,name=is_stale,json=isStale" json:"is_stale,omitempty"`
 }
 
 func (m *ShardInfo) Reset()                    { *m = ShardInfo{} }
 func (m *ShardInfo) String() string            { return proto.CompactTextString(m) }
 func (*ShardInfo) ProtoMessage()               {}
-func (*ShardInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ShardInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *ShardInfo) GetKeyspaceName() string {
 	if m != nil {
@@ -609,13 +1070,20 @@ func (m *ShardInfo) GetIsPermanentDelete() bool {
 	return false
 }
 
+func (m *ShardInfo) GetIsStale() bool {
+	if m != nil {
+		return m.IsStale
+	}
+	return false
+}
+
 type Empty struct {
 }
 
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 type KeyTypeValue struct {
 	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -627,7 +1095,7 @@ type KeyTypeValue struct {
 func (m *KeyTypeValue) Reset()                    { *m = KeyTypeValue{} }
 func (m *KeyTypeValue) String() string            { return proto.CompactTextString(m) }
 func (*KeyTypeValue) ProtoMessage()               {}
-func (*KeyTypeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*KeyTypeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *KeyTypeValue) GetKey() []byte {
 	if m != nil {
@@ -663,12 +1131,21 @@ func (m *KeyTypeValue) GetValue() []byte {
 type Requests struct {
 	Keyspace string     `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
 	Requests []*Request `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+	// echoed back in Responses, so many Requests can be in flight on one connection
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	// the remaining time budget when sent, 0 means no deadline.
+	// relative to avoid depending on clock skew between the client and the store.
+	TimeoutNs uint64 `protobuf:"varint,4,opt,name=timeout_ns,json=timeoutNs" json:"timeout_ns,omitempty"`
+	// W3C trace context of the caller's span, e.g. traceparent
+	TraceContext map[string]string `protobuf:"bytes,5,rep,name=trace_context,json=traceContext" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// checked against the access control list of the keyspace
+	Token string `protobuf:"bytes,6,opt,name=token" json:"token,omitempty"`
 }
 
 func (m *Requests) Reset()                    { *m = Requests{} }
 func (m *Requests) String() string            { return proto.CompactTextString(m) }
 func (*Requests) ProtoMessage()               {}
-func (*Requests) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*Requests) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *Requests) GetKeyspace() string {
 	if m != nil {
@@ -684,14 +1161,43 @@ func (m *Requests) GetRequests() []*Request {
 	return nil
 }
 
+func (m *Requests) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *Requests) GetTimeoutNs() uint64 {
+	if m != nil {
+		return m.TimeoutNs
+	}
+	return 0
+}
+
+func (m *Requests) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
+func (m *Requests) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 type Responses struct {
 	Responses []*Response `protobuf:"bytes,1,rep,name=responses" json:"responses,omitempty"`
+	RequestId uint64      `protobuf:"varint,2,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
 }
 
 func (m *Responses) Reset()                    { *m = Responses{} }
 func (m *Responses) String() string            { return proto.CompactTextString(m) }
 func (*Responses) ProtoMessage()               {}
-func (*Responses) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*Responses) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *Responses) GetResponses() []*Response {
 	if m != nil {
@@ -700,6 +1206,13 @@ func (m *Responses) GetResponses() []*Response {
 	return nil
 }
 
+func (m *Responses) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
 type Request struct {
 	ShardId     uint32              `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
 	Put         *PutRequest         `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
@@ -707,12 +1220,13 @@ type Request struct {
 	GetByPrefix *GetByPrefixRequest `protobuf:"bytes,4,opt,name=get_by_prefix,json=getByPrefix" json:"get_by_prefix,omitempty"`
 	Delete      *DeleteRequest      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
 	Merge       *MergeRequest       `protobuf:"bytes,6,opt,name=merge" json:"merge,omitempty"`
+	DeleteRange *DeleteRangeRequest `protobuf:"bytes,7,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
 func (m *Request) String() string            { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()               {}
-func (*Request) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *Request) GetShardId() uint32 {
 	if m != nil {
@@ -756,6 +1270,13 @@ func (m *Request) GetMerge() *MergeRequest {
 	return nil
 }
 
+func (m *Request) GetDeleteRange() *DeleteRangeRequest {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
 type PutRequest struct {
 	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
@@ -768,7 +1289,7 @@ type PutRequest struct {
 func (m *PutRequest) Reset()                    { *m = PutRequest{} }
 func (m *PutRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()               {}
-func (*PutRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*PutRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *PutRequest) GetKey() []byte {
 	if m != nil {
@@ -818,12 +1339,14 @@ type MergeRequest struct {
 	UpdatedAtNs   uint64        `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
 	OpAndDataType OpAndDataType `protobuf:"varint,4,opt,name=op_and_data_type,json=opAndDataType,enum=pb.OpAndDataType" json:"op_and_data_type,omitempty"`
 	Value         []byte        `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// return the merged value in the response
+	ReturnValue bool `protobuf:"varint,6,opt,name=return_value,json=returnValue" json:"return_value,omitempty"`
 }
 
 func (m *MergeRequest) Reset()                    { *m = MergeRequest{} }
 func (m *MergeRequest) String() string            { return proto.CompactTextString(m) }
 func (*MergeRequest) ProtoMessage()               {}
-func (*MergeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*MergeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *MergeRequest) GetKey() []byte {
 	if m != nil {
@@ -860,15 +1383,25 @@ func (m *MergeRequest) GetValue() []byte {
 	return nil
 }
 
+func (m *MergeRequest) GetReturnValue() bool {
+	if m != nil {
+		return m.ReturnValue
+	}
+	return false
+}
+
 type WriteResponse struct {
-	Ok     bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Ok          bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status      string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	IsThrottled bool   `protobuf:"varint,3,opt,name=is_throttled,json=isThrottled" json:"is_throttled,omitempty"`
+	// the merged value, if the merge request asks for it
+	Value []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *WriteResponse) Reset()                    { *m = WriteResponse{} }
 func (m *WriteResponse) String() string            { return proto.CompactTextString(m) }
 func (*WriteResponse) ProtoMessage()               {}
-func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *WriteResponse) GetOk() bool {
 	if m != nil {
@@ -884,6 +1417,20 @@ func (m *WriteResponse) GetStatus() string {
 	return ""
 }
 
+func (m *WriteResponse) GetIsThrottled() bool {
+	if m != nil {
+		return m.IsThrottled
+	}
+	return false
+}
+
+func (m *WriteResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type DeleteRequest struct {
 	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
@@ -893,7 +1440,7 @@ type DeleteRequest struct {
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *DeleteRequest) GetKey() []byte {
 	if m != nil {
@@ -916,6 +1463,40 @@ func (m *DeleteRequest) GetUpdatedAtNs() uint64 {
 	return 0
 }
 
+// DeleteRangeRequest deletes the keys in [start, end) on one shard, or all keys from start if end is empty.
+// Entries updated after updated_at_ns are kept.
+type DeleteRangeRequest struct {
+	Start       []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End         []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	UpdatedAtNs uint64 `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+}
+
+func (m *DeleteRangeRequest) Reset()                    { *m = DeleteRangeRequest{} }
+func (m *DeleteRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteRangeRequest) ProtoMessage()               {}
+func (*DeleteRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *DeleteRangeRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *DeleteRangeRequest) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *DeleteRangeRequest) GetUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.UpdatedAtNs
+	}
+	return 0
+}
+
 type GetRequest struct {
 	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
@@ -924,7 +1505,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *GetRequest) GetKey() []byte {
 	if m != nil {
@@ -941,15 +1522,16 @@ func (m *GetRequest) GetPartitionHash() uint64 {
 }
 
 type GetResponse struct {
-	Ok       bool          `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
-	Status   string        `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
-	KeyValue *KeyTypeValue `protobuf:"bytes,3,opt,name=key_value,json=keyValue" json:"key_value,omitempty"`
+	Ok          bool          `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status      string        `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	KeyValue    *KeyTypeValue `protobuf:"bytes,3,opt,name=key_value,json=keyValue" json:"key_value,omitempty"`
+	IsThrottled bool          `protobuf:"varint,4,opt,name=is_throttled,json=isThrottled" json:"is_throttled,omitempty"`
 }
 
 func (m *GetResponse) Reset()                    { *m = GetResponse{} }
 func (m *GetResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()               {}
-func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *GetResponse) GetOk() bool {
 	if m != nil {
@@ -972,6 +1554,13 @@ func (m *GetResponse) GetKeyValue() *KeyTypeValue {
 	return nil
 }
 
+func (m *GetResponse) GetIsThrottled() bool {
+	if m != nil {
+		return m.IsThrottled
+	}
+	return false
+}
+
 type GetByPrefixRequest struct {
 	Prefix      []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	Limit       uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
@@ -981,7 +1570,7 @@ type GetByPrefixRequest struct {
 func (m *GetByPrefixRequest) Reset()                    { *m = GetByPrefixRequest{} }
 func (m *GetByPrefixRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetByPrefixRequest) ProtoMessage()               {}
-func (*GetByPrefixRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*GetByPrefixRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *GetByPrefixRequest) GetPrefix() []byte {
 	if m != nil {
@@ -1005,15 +1594,16 @@ func (m *GetByPrefixRequest) GetLastSeenKey() []byte {
 }
 
 type GetByPrefixResponse struct {
-	Ok        bool            `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
-	Status    string          `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
-	KeyValues []*KeyTypeValue `protobuf:"bytes,3,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
+	Ok          bool            `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status      string          `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	KeyValues   []*KeyTypeValue `protobuf:"bytes,3,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
+	IsThrottled bool            `protobuf:"varint,4,opt,name=is_throttled,json=isThrottled" json:"is_throttled,omitempty"`
 }
 
 func (m *GetByPrefixResponse) Reset()                    { *m = GetByPrefixResponse{} }
 func (m *GetByPrefixResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetByPrefixResponse) ProtoMessage()               {}
-func (*GetByPrefixResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*GetByPrefixResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *GetByPrefixResponse) GetOk() bool {
 	if m != nil {
@@ -1036,6 +1626,13 @@ func (m *GetByPrefixResponse) GetKeyValues() []*KeyTypeValue {
 	return nil
 }
 
+func (m *GetByPrefixResponse) GetIsThrottled() bool {
+	if m != nil {
+		return m.IsThrottled
+	}
+	return false
+}
+
 type Response struct {
 	Write       *WriteResponse       `protobuf:"bytes,1,opt,name=write" json:"write,omitempty"`
 	Get         *GetResponse         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
@@ -1045,7 +1642,7 @@ type Response struct {
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *Response) GetWrite() *WriteResponse {
 	if m != nil {
@@ -1076,7 +1673,7 @@ type RawKeyValue struct {
 func (m *RawKeyValue) Reset()                    { *m = RawKeyValue{} }
 func (m *RawKeyValue) String() string            { return proto.CompactTextString(m) }
 func (*RawKeyValue) ProtoMessage()               {}
-func (*RawKeyValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*RawKeyValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *RawKeyValue) GetKey() []byte {
 	if m != nil {
@@ -1093,16 +1690,17 @@ func (m *RawKeyValue) GetValue() []byte {
 }
 
 type LogEntry struct {
-	UpdatedAtNs uint64         `protobuf:"varint,1,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
-	Put         *PutRequest    `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
-	Delete      *DeleteRequest `protobuf:"bytes,3,opt,name=delete" json:"delete,omitempty"`
-	Merge       *MergeRequest  `protobuf:"bytes,4,opt,name=merge" json:"merge,omitempty"`
+	UpdatedAtNs uint64              `protobuf:"varint,1,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+	Put         *PutRequest         `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
+	Delete      *DeleteRequest      `protobuf:"bytes,3,opt,name=delete" json:"delete,omitempty"`
+	Merge       *MergeRequest       `protobuf:"bytes,4,opt,name=merge" json:"merge,omitempty"`
+	DeleteRange *DeleteRangeRequest `protobuf:"bytes,5,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
 }
 
 func (m *LogEntry) Reset()                    { *m = LogEntry{} }
 func (m *LogEntry) String() string            { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()               {}
-func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *LogEntry) GetUpdatedAtNs() uint64 {
 	if m != nil {
@@ -1132,6 +1730,13 @@ func (m *LogEntry) GetMerge() *MergeRequest {
 	return nil
 }
 
+func (m *LogEntry) GetDeleteRange() *DeleteRangeRequest {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
 // ////////////////////////////////////////////////
 // // data copying
 // ////////////////////////////////////////////////
@@ -1143,7 +1748,7 @@ type CopyDoneMessge struct {
 func (m *CopyDoneMessge) Reset()                    { *m = CopyDoneMessge{} }
 func (m *CopyDoneMessge) String() string            { return proto.CompactTextString(m) }
 func (*CopyDoneMessge) ProtoMessage()               {}
-func (*CopyDoneMessge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*CopyDoneMessge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *CopyDoneMessge) GetShard() int32 {
 	if m != nil {
@@ -1172,7 +1777,7 @@ type BootstrapCopyRequest struct {
 func (m *BootstrapCopyRequest) Reset()                    { *m = BootstrapCopyRequest{} }
 func (m *BootstrapCopyRequest) String() string            { return proto.CompactTextString(m) }
 func (*BootstrapCopyRequest) ProtoMessage()               {}
-func (*BootstrapCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*BootstrapCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *BootstrapCopyRequest) GetKeyspace() string {
 	if m != nil {
@@ -1231,7 +1836,7 @@ type BootstrapCopyResponse struct {
 func (m *BootstrapCopyResponse) Reset()                    { *m = BootstrapCopyResponse{} }
 func (m *BootstrapCopyResponse) String() string            { return proto.CompactTextString(m) }
 func (*BootstrapCopyResponse) ProtoMessage()               {}
-func (*BootstrapCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*BootstrapCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *BootstrapCopyResponse) GetKeyValues() []*RawKeyValue {
 	if m != nil {
@@ -1259,7 +1864,7 @@ func (m *BootstrapCopyResponse_BinlogTailProgress) Reset() {
 func (m *BootstrapCopyResponse_BinlogTailProgress) String() string { return proto.CompactTextString(m) }
 func (*BootstrapCopyResponse_BinlogTailProgress) ProtoMessage()    {}
 func (*BootstrapCopyResponse_BinlogTailProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{28, 0}
+	return fileDescriptor0, []int{36, 0}
 }
 
 func (m *BootstrapCopyResponse_BinlogTailProgress) GetSegment() uint32 {
@@ -1290,7 +1895,7 @@ type PullUpdateRequest struct {
 func (m *PullUpdateRequest) Reset()                    { *m = PullUpdateRequest{} }
 func (m *PullUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PullUpdateRequest) ProtoMessage()               {}
-func (*PullUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*PullUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *PullUpdateRequest) GetKeyspace() string {
 	if m != nil {
@@ -1353,12 +1958,16 @@ type PullUpdateResponse struct {
 	NextOffset  uint64      `protobuf:"varint,2,opt,name=next_offset,json=nextOffset" json:"next_offset,omitempty"`
 	Entries     []*LogEntry `protobuf:"bytes,3,rep,name=entries" json:"entries,omitempty"`
 	OutOfSync   bool        `protobuf:"varint,4,opt,name=out_of_sync,json=outOfSync" json:"out_of_sync,omitempty"`
+	// how far next_segment and next_offset are behind the latest binlog entry
+	LagEntries        uint64 `protobuf:"varint,5,opt,name=lag_entries,json=lagEntries" json:"lag_entries,omitempty"`
+	LagBytes          uint64 `protobuf:"varint,6,opt,name=lag_bytes,json=lagBytes" json:"lag_bytes,omitempty"`
+	LatestUpdatedAtNs uint64 `protobuf:"varint,7,opt,name=latest_updated_at_ns,json=latestUpdatedAtNs" json:"latest_updated_at_ns,omitempty"`
 }
 
 func (m *PullUpdateResponse) Reset()                    { *m = PullUpdateResponse{} }
 func (m *PullUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PullUpdateResponse) ProtoMessage()               {}
-func (*PullUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*PullUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *PullUpdateResponse) GetNextSegment() uint32 {
 	if m != nil {
@@ -1388,6 +1997,27 @@ func (m *PullUpdateResponse) GetOutOfSync() bool {
 	return false
 }
 
+func (m *PullUpdateResponse) GetLagEntries() uint64 {
+	if m != nil {
+		return m.LagEntries
+	}
+	return 0
+}
+
+func (m *PullUpdateResponse) GetLagBytes() uint64 {
+	if m != nil {
+		return m.LagBytes
+	}
+	return 0
+}
+
+func (m *PullUpdateResponse) GetLatestUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.LatestUpdatedAtNs
+	}
+	return 0
+}
+
 type CheckBinlogRequest struct {
 	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
 	ShardId  uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
@@ -1396,7 +2026,7 @@ type CheckBinlogRequest struct {
 func (m *CheckBinlogRequest) Reset()                    { *m = CheckBinlogRequest{} }
 func (m *CheckBinlogRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckBinlogRequest) ProtoMessage()               {}
-func (*CheckBinlogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*CheckBinlogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *CheckBinlogRequest) GetKeyspace() string {
 	if m != nil {
@@ -1421,7 +2051,7 @@ type CheckBinlogResponse struct {
 func (m *CheckBinlogResponse) Reset()                    { *m = CheckBinlogResponse{} }
 func (m *CheckBinlogResponse) String() string            { return proto.CompactTextString(m) }
 func (*CheckBinlogResponse) ProtoMessage()               {}
-func (*CheckBinlogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*CheckBinlogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *CheckBinlogResponse) GetShardId() uint32 {
 	if m != nil {
@@ -1452,12 +2082,13 @@ type DescribeRequest struct {
 	DescKeyspaces   *DescribeRequest_DescKeyspaces   `protobuf:"bytes,2,opt,name=desc_keyspaces,json=descKeyspaces" json:"desc_keyspaces,omitempty"`
 	DescCluster     *DescribeRequest_DescCluster     `protobuf:"bytes,3,opt,name=desc_cluster,json=descCluster" json:"desc_cluster,omitempty"`
 	DescClients     *DescribeRequest_DescClients     `protobuf:"bytes,4,opt,name=desc_clients,json=descClients" json:"desc_clients,omitempty"`
+	DescAuditLog    *DescribeRequest_DescAuditLog    `protobuf:"bytes,5,opt,name=desc_audit_log,json=descAuditLog" json:"desc_audit_log,omitempty"`
 }
 
 func (m *DescribeRequest) Reset()                    { *m = DescribeRequest{} }
 func (m *DescribeRequest) String() string            { return proto.CompactTextString(m) }
 func (*DescribeRequest) ProtoMessage()               {}
-func (*DescribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*DescribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *DescribeRequest) GetDescDataCenters() *DescribeRequest_DescDataCenters {
 	if m != nil {
@@ -1487,6 +2118,13 @@ func (m *DescribeRequest) GetDescClients() *DescribeRequest_DescClients {
 	return nil
 }
 
+func (m *DescribeRequest) GetDescAuditLog() *DescribeRequest_DescAuditLog {
+	if m != nil {
+		return m.DescAuditLog
+	}
+	return nil
+}
+
 type DescribeRequest_DescDataCenters struct {
 }
 
@@ -1494,7 +2132,7 @@ func (m *DescribeRequest_DescDataCenters) Reset()         { *m = DescribeRequest
 func (m *DescribeRequest_DescDataCenters) String() string { return proto.CompactTextString(m) }
 func (*DescribeRequest_DescDataCenters) ProtoMessage()    {}
 func (*DescribeRequest_DescDataCenters) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{33, 0}
+	return fileDescriptor0, []int{41, 0}
 }
 
 type DescribeRequest_DescKeyspaces struct {
@@ -1504,17 +2142,19 @@ func (m *DescribeRequest_DescKeyspaces) Reset()         { *m = DescribeRequest_D
 func (m *DescribeRequest_DescKeyspaces) String() string { return proto.CompactTextString(m) }
 func (*DescribeRequest_DescKeyspaces) ProtoMessage()    {}
 func (*DescribeRequest_DescKeyspaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{33, 1}
+	return fileDescriptor0, []int{41, 1}
 }
 
 type DescribeRequest_DescCluster struct {
 	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
 }
 
-func (m *DescribeRequest_DescCluster) Reset()                    { *m = DescribeRequest_DescCluster{} }
-func (m *DescribeRequest_DescCluster) String() string            { return proto.CompactTextString(m) }
-func (*DescribeRequest_DescCluster) ProtoMessage()               {}
-func (*DescribeRequest_DescCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33, 2} }
+func (m *DescribeRequest_DescCluster) Reset()         { *m = DescribeRequest_DescCluster{} }
+func (m *DescribeRequest_DescCluster) String() string { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescCluster) ProtoMessage()    {}
+func (*DescribeRequest_DescCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{41, 2}
+}
 
 func (m *DescribeRequest_DescCluster) GetKeyspace() string {
 	if m != nil {
@@ -1526,22 +2166,53 @@ func (m *DescribeRequest_DescCluster) GetKeyspace() string {
 type DescribeRequest_DescClients struct {
 }
 
-func (m *DescribeRequest_DescClients) Reset()                    { *m = DescribeRequest_DescClients{} }
-func (m *DescribeRequest_DescClients) String() string            { return proto.CompactTextString(m) }
-func (*DescribeRequest_DescClients) ProtoMessage()               {}
-func (*DescribeRequest_DescClients) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33, 3} }
+func (m *DescribeRequest_DescClients) Reset()         { *m = DescribeRequest_DescClients{} }
+func (m *DescribeRequest_DescClients) String() string { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescClients) ProtoMessage()    {}
+func (*DescribeRequest_DescClients) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{41, 3}
+}
+
+type DescribeRequest_DescAuditLog struct {
+	// empty for all keyspaces
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	// the latest records, 0 for all
+	Limit uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *DescribeRequest_DescAuditLog) Reset()         { *m = DescribeRequest_DescAuditLog{} }
+func (m *DescribeRequest_DescAuditLog) String() string { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescAuditLog) ProtoMessage()    {}
+func (*DescribeRequest_DescAuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{41, 4}
+}
+
+func (m *DescribeRequest_DescAuditLog) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DescribeRequest_DescAuditLog) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
 
 type DescribeResponse struct {
 	DescDataCenter *DescribeResponse_DescDataCenter `protobuf:"bytes,1,opt,name=desc_data_center,json=descDataCenter" json:"desc_data_center,omitempty"`
 	DescKeyspaces  *DescribeResponse_DescKeyspaces  `protobuf:"bytes,2,opt,name=desc_keyspaces,json=descKeyspaces" json:"desc_keyspaces,omitempty"`
 	DescCluster    *DescribeResponse_DescCluster    `protobuf:"bytes,3,opt,name=desc_cluster,json=descCluster" json:"desc_cluster,omitempty"`
 	ClientCount    uint32                           `protobuf:"varint,4,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+	DescAuditLog   *DescribeResponse_DescAuditLog   `protobuf:"bytes,5,opt,name=desc_audit_log,json=descAuditLog" json:"desc_audit_log,omitempty"`
 }
 
 func (m *DescribeResponse) Reset()                    { *m = DescribeResponse{} }
 func (m *DescribeResponse) String() string            { return proto.CompactTextString(m) }
 func (*DescribeResponse) ProtoMessage()               {}
-func (*DescribeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*DescribeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *DescribeResponse) GetDescDataCenter() *DescribeResponse_DescDataCenter {
 	if m != nil {
@@ -1571,6 +2242,13 @@ func (m *DescribeResponse) GetClientCount() uint32 {
 	return 0
 }
 
+func (m *DescribeResponse) GetDescAuditLog() *DescribeResponse_DescAuditLog {
+	if m != nil {
+		return m.DescAuditLog
+	}
+	return nil
+}
+
 type DescribeResponse_DescDataCenter struct {
 	DataCenter *DescribeResponse_DescDataCenter_DataCenter `protobuf:"bytes,1,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
 }
@@ -1579,7 +2257,7 @@ func (m *DescribeResponse_DescDataCenter) Reset()         { *m = DescribeRespons
 func (m *DescribeResponse_DescDataCenter) String() string { return proto.CompactTextString(m) }
 func (*DescribeResponse_DescDataCenter) ProtoMessage()    {}
 func (*DescribeResponse_DescDataCenter) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 0}
+	return fileDescriptor0, []int{42, 0}
 }
 
 func (m *DescribeResponse_DescDataCenter) GetDataCenter() *DescribeResponse_DescDataCenter_DataCenter {
@@ -1601,7 +2279,7 @@ func (m *DescribeResponse_DescDataCenter_DataCenter) String() string {
 }
 func (*DescribeResponse_DescDataCenter_DataCenter) ProtoMessage() {}
 func (*DescribeResponse_DescDataCenter_DataCenter) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 0, 0}
+	return fileDescriptor0, []int{42, 0, 0}
 }
 
 func (m *DescribeResponse_DescDataCenter_DataCenter) GetStoreResources() []*StoreResource {
@@ -1619,7 +2297,7 @@ func (m *DescribeResponse_DescKeyspaces) Reset()         { *m = DescribeResponse
 func (m *DescribeResponse_DescKeyspaces) String() string { return proto.CompactTextString(m) }
 func (*DescribeResponse_DescKeyspaces) ProtoMessage()    {}
 func (*DescribeResponse_DescKeyspaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 1}
+	return fileDescriptor0, []int{42, 1}
 }
 
 func (m *DescribeResponse_DescKeyspaces) GetKeyspaces() []*DescribeResponse_DescKeyspaces_Keyspace {
@@ -1641,7 +2319,7 @@ func (m *DescribeResponse_DescKeyspaces_Keyspace) Reset() {
 func (m *DescribeResponse_DescKeyspaces_Keyspace) String() string { return proto.CompactTextString(m) }
 func (*DescribeResponse_DescKeyspaces_Keyspace) ProtoMessage()    {}
 func (*DescribeResponse_DescKeyspaces_Keyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 1, 0}
+	return fileDescriptor0, []int{42, 1, 0}
 }
 
 func (m *DescribeResponse_DescKeyspaces_Keyspace) GetKeyspace() string {
@@ -1666,16 +2344,19 @@ func (m *DescribeResponse_DescKeyspaces_Keyspace) GetClientCount() uint32 {
 }
 
 type DescribeResponse_DescCluster struct {
-	Cluster     *Cluster `protobuf:"bytes,1,opt,name=cluster" json:"cluster,omitempty"`
-	NextCluster *Cluster `protobuf:"bytes,2,opt,name=next_cluster,json=nextCluster" json:"next_cluster,omitempty"`
-	ClientCount uint32   `protobuf:"varint,3,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+	Cluster         *Cluster          `protobuf:"bytes,1,opt,name=cluster" json:"cluster,omitempty"`
+	NextCluster     *Cluster          `protobuf:"bytes,2,opt,name=next_cluster,json=nextCluster" json:"next_cluster,omitempty"`
+	ClientCount     uint32            `protobuf:"varint,3,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+	ReplicationLags []*ReplicationLag `protobuf:"bytes,4,rep,name=replication_lags,json=replicationLags" json:"replication_lags,omitempty"`
+	Quota           *KeyspaceQuota    `protobuf:"bytes,5,opt,name=quota" json:"quota,omitempty"`
+	Storage         *KeyspaceStorage  `protobuf:"bytes,6,opt,name=storage" json:"storage,omitempty"`
 }
 
 func (m *DescribeResponse_DescCluster) Reset()         { *m = DescribeResponse_DescCluster{} }
 func (m *DescribeResponse_DescCluster) String() string { return proto.CompactTextString(m) }
 func (*DescribeResponse_DescCluster) ProtoMessage()    {}
 func (*DescribeResponse_DescCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 2}
+	return fileDescriptor0, []int{42, 2}
 }
 
 func (m *DescribeResponse_DescCluster) GetCluster() *Cluster {
@@ -1699,18 +2380,60 @@ func (m *DescribeResponse_DescCluster) GetClientCount() uint32 {
 	return 0
 }
 
+func (m *DescribeResponse_DescCluster) GetReplicationLags() []*ReplicationLag {
+	if m != nil {
+		return m.ReplicationLags
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescCluster) GetQuota() *KeyspaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescCluster) GetStorage() *KeyspaceStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+type DescribeResponse_DescAuditLog struct {
+	Records []*AuditRecord `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *DescribeResponse_DescAuditLog) Reset()         { *m = DescribeResponse_DescAuditLog{} }
+func (m *DescribeResponse_DescAuditLog) String() string { return proto.CompactTextString(m) }
+func (*DescribeResponse_DescAuditLog) ProtoMessage()    {}
+func (*DescribeResponse_DescAuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{42, 3}
+}
+
+func (m *DescribeResponse_DescAuditLog) GetRecords() []*AuditRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 type CreateClusterRequest struct {
 	Keyspace          string   `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
 	ClusterSize       uint32   `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
 	ReplicationFactor uint32   `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
 	TotalDiskSizeGb   uint32   `protobuf:"varint,5,opt,name=total_disk_size_gb,json=totalDiskSizeGb" json:"total_disk_size_gb,omitempty"`
 	Tags              []string `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+	// storage engine of the keyspace, empty for the default engine of each store
+	Engine         string          `protobuf:"bytes,7,opt,name=engine" json:"engine,omitempty"`
+	StorageProfile *StorageProfile `protobuf:"bytes,8,opt,name=storage_profile,json=storageProfile" json:"storage_profile,omitempty"`
 }
 
 func (m *CreateClusterRequest) Reset()                    { *m = CreateClusterRequest{} }
 func (m *CreateClusterRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateClusterRequest) ProtoMessage()               {}
-func (*CreateClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*CreateClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *CreateClusterRequest) GetKeyspace() string {
 	if m != nil {
@@ -1747,6 +2470,20 @@ func (m *CreateClusterRequest) GetTags() []string {
 	return nil
 }
 
+func (m *CreateClusterRequest) GetEngine() string {
+	if m != nil {
+		return m.Engine
+	}
+	return ""
+}
+
+func (m *CreateClusterRequest) GetStorageProfile() *StorageProfile {
+	if m != nil {
+		return m.StorageProfile
+	}
+	return nil
+}
+
 type CreateClusterResponse struct {
 	Error   string   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	Cluster *Cluster `protobuf:"bytes,2,opt,name=cluster" json:"cluster,omitempty"`
@@ -1755,7 +2492,7 @@ type CreateClusterResponse struct {
 func (m *CreateClusterResponse) Reset()                    { *m = CreateClusterResponse{} }
 func (m *CreateClusterResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateClusterResponse) ProtoMessage()               {}
-func (*CreateClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*CreateClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *CreateClusterResponse) GetError() string {
 	if m != nil {
@@ -1778,7 +2515,7 @@ type DeleteClusterRequest struct {
 func (m *DeleteClusterRequest) Reset()                    { *m = DeleteClusterRequest{} }
 func (m *DeleteClusterRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteClusterRequest) ProtoMessage()               {}
-func (*DeleteClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*DeleteClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *DeleteClusterRequest) GetKeyspace() string {
 	if m != nil {
@@ -1794,7 +2531,7 @@ type DeleteClusterResponse struct {
 func (m *DeleteClusterResponse) Reset()                    { *m = DeleteClusterResponse{} }
 func (m *DeleteClusterResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteClusterResponse) ProtoMessage()               {}
-func (*DeleteClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*DeleteClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *DeleteClusterResponse) GetError() string {
 	if m != nil {
@@ -1810,7 +2547,7 @@ type CompactClusterRequest struct {
 func (m *CompactClusterRequest) Reset()                    { *m = CompactClusterRequest{} }
 func (m *CompactClusterRequest) String() string            { return proto.CompactTextString(m) }
 func (*CompactClusterRequest) ProtoMessage()               {}
-func (*CompactClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*CompactClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *CompactClusterRequest) GetKeyspace() string {
 	if m != nil {
@@ -1823,206 +2560,692 @@ type CompactClusterResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *CompactClusterResponse) Reset()                    { *m = CompactClusterResponse{} }
-func (m *CompactClusterResponse) String() string            { return proto.CompactTextString(m) }
-func (*CompactClusterResponse) ProtoMessage()               {}
-func (*CompactClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
-
-func (m *CompactClusterResponse) GetError() string {
+func (m *CompactClusterResponse) Reset()                    { *m = CompactClusterResponse{} }
+func (m *CompactClusterResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactClusterResponse) ProtoMessage()               {}
+func (*CompactClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *CompactClusterResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ReplaceNodeRequest struct {
+	Keyspace   string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	NodeId     uint32 `protobuf:"varint,3,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
+	NewAddress string `protobuf:"bytes,4,opt,name=new_address,json=newAddress" json:"new_address,omitempty"`
+}
+
+func (m *ReplaceNodeRequest) Reset()                    { *m = ReplaceNodeRequest{} }
+func (m *ReplaceNodeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplaceNodeRequest) ProtoMessage()               {}
+func (*ReplaceNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *ReplaceNodeRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReplaceNodeRequest) GetNodeId() uint32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *ReplaceNodeRequest) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+type ReplaceNodeResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ReplaceNodeResponse) Reset()                    { *m = ReplaceNodeResponse{} }
+func (m *ReplaceNodeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplaceNodeResponse) ProtoMessage()               {}
+func (*ReplaceNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *ReplaceNodeResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type LoginRequest struct {
+}
+
+func (m *LoginRequest) Reset()                    { *m = LoginRequest{} }
+func (m *LoginRequest) String() string            { return proto.CompactTextString(m) }
+func (*LoginRequest) ProtoMessage()               {}
+func (*LoginRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+type LoginResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// empty if the master does not enforce access control
+	User   string      `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
+	Grants []*AclGrant `protobuf:"bytes,3,rep,name=grants" json:"grants,omitempty"`
+}
+
+func (m *LoginResponse) Reset()                    { *m = LoginResponse{} }
+func (m *LoginResponse) String() string            { return proto.CompactTextString(m) }
+func (*LoginResponse) ProtoMessage()               {}
+func (*LoginResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *LoginResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetGrants() []*AclGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+// AuditRecord is one administrative operation on the master
+type AuditRecord struct {
+	Method   string `protobuf:"bytes,1,opt,name=method" json:"method,omitempty"`
+	Keyspace string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	// the user of the token, empty if the master does not enforce access control
+	Caller        string `protobuf:"bytes,3,opt,name=caller" json:"caller,omitempty"`
+	CallerAddress string `protobuf:"bytes,4,opt,name=caller_address,json=callerAddress" json:"caller_address,omitempty"`
+	Arguments     string `protobuf:"bytes,5,opt,name=arguments" json:"arguments,omitempty"`
+	StartTimeNs   int64  `protobuf:"varint,6,opt,name=start_time_ns,json=startTimeNs" json:"start_time_ns,omitempty"`
+	EndTimeNs     int64  `protobuf:"varint,7,opt,name=end_time_ns,json=endTimeNs" json:"end_time_ns,omitempty"`
+	Error         string `protobuf:"bytes,8,opt,name=error" json:"error,omitempty"`
+	// the cluster layout after the operation
+	Cluster *Cluster `protobuf:"bytes,9,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *AuditRecord) Reset()                    { *m = AuditRecord{} }
+func (m *AuditRecord) String() string            { return proto.CompactTextString(m) }
+func (*AuditRecord) ProtoMessage()               {}
+func (*AuditRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *AuditRecord) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetCallerAddress() string {
+	if m != nil {
+		return m.CallerAddress
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetArguments() string {
+	if m != nil {
+		return m.Arguments
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetStartTimeNs() int64 {
+	if m != nil {
+		return m.StartTimeNs
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetEndTimeNs() int64 {
+	if m != nil {
+		return m.EndTimeNs
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetCluster() *Cluster {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type SetKeyspaceQuotaRequest struct {
+	Quota *KeyspaceQuota `protobuf:"bytes,1,opt,name=quota" json:"quota,omitempty"`
+}
+
+func (m *SetKeyspaceQuotaRequest) Reset()                    { *m = SetKeyspaceQuotaRequest{} }
+func (m *SetKeyspaceQuotaRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetKeyspaceQuotaRequest) ProtoMessage()               {}
+func (*SetKeyspaceQuotaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *SetKeyspaceQuotaRequest) GetQuota() *KeyspaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type SetKeyspaceQuotaResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *SetKeyspaceQuotaResponse) Reset()                    { *m = SetKeyspaceQuotaResponse{} }
+func (m *SetKeyspaceQuotaResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetKeyspaceQuotaResponse) ProtoMessage()               {}
+func (*SetKeyspaceQuotaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *SetKeyspaceQuotaResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// //////  request response with store
+type CreateShardRequest struct {
+	Keyspace          string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	ShardDiskSizeGb   uint32           `protobuf:"varint,5,opt,name=shard_disk_size_gb,json=shardDiskSizeGb" json:"shard_disk_size_gb,omitempty"`
+	Quota             *KeyspaceQuota   `protobuf:"bytes,6,opt,name=quota" json:"quota,omitempty"`
+	Storage           *KeyspaceStorage `protobuf:"bytes,7,opt,name=storage" json:"storage,omitempty"`
+}
+
+func (m *CreateShardRequest) Reset()                    { *m = CreateShardRequest{} }
+func (m *CreateShardRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateShardRequest) ProtoMessage()               {}
+func (*CreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *CreateShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CreateShardRequest) GetServerId() uint32 {
+	if m != nil {
+		return m.ServerId
+	}
+	return 0
+}
+
+func (m *CreateShardRequest) GetClusterSize() uint32 {
+	if m != nil {
+		return m.ClusterSize
+	}
+	return 0
+}
+
+func (m *CreateShardRequest) GetReplicationFactor() uint32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *CreateShardRequest) GetShardDiskSizeGb() uint32 {
+	if m != nil {
+		return m.ShardDiskSizeGb
+	}
+	return 0
+}
+
+func (m *CreateShardRequest) GetQuota() *KeyspaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *CreateShardRequest) GetStorage() *KeyspaceStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+type CreateShardResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *CreateShardResponse) Reset()                    { *m = CreateShardResponse{} }
+func (m *CreateShardResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateShardResponse) ProtoMessage()               {}
+func (*CreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *CreateShardResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DeleteKeyspaceRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+}
+
+func (m *DeleteKeyspaceRequest) Reset()                    { *m = DeleteKeyspaceRequest{} }
+func (m *DeleteKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceRequest) ProtoMessage()               {}
+func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *DeleteKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type DeleteKeyspaceResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *DeleteKeyspaceResponse) Reset()                    { *m = DeleteKeyspaceResponse{} }
+func (m *DeleteKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceResponse) ProtoMessage()               {}
+func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *DeleteKeyspaceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CompactKeyspaceRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+}
+
+func (m *CompactKeyspaceRequest) Reset()                    { *m = CompactKeyspaceRequest{} }
+func (m *CompactKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompactKeyspaceRequest) ProtoMessage()               {}
+func (*CompactKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *CompactKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type CompactKeyspaceResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *CompactKeyspaceResponse) Reset()                    { *m = CompactKeyspaceResponse{} }
+func (m *CompactKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactKeyspaceResponse) ProtoMessage()               {}
+func (*CompactKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *CompactKeyspaceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type HotspotsRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	// max hot keys per shard and max slow ops, 0 means all
+	Limit uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *HotspotsRequest) Reset()                    { *m = HotspotsRequest{} }
+func (m *HotspotsRequest) String() string            { return proto.CompactTextString(m) }
+func (*HotspotsRequest) ProtoMessage()               {}
+func (*HotspotsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *HotspotsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *HotspotsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type HotspotsResponse struct {
+	Shards []*HotspotsResponse_ShardHotKeys `protobuf:"bytes,1,rep,name=shards" json:"shards,omitempty"`
+	// newest first
+	SlowOps []*SlowOp `protobuf:"bytes,2,rep,name=slow_ops,json=slowOps" json:"slow_ops,omitempty"`
+	Error   string    `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *HotspotsResponse) Reset()                    { *m = HotspotsResponse{} }
+func (m *HotspotsResponse) String() string            { return proto.CompactTextString(m) }
+func (*HotspotsResponse) ProtoMessage()               {}
+func (*HotspotsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *HotspotsResponse) GetShards() []*HotspotsResponse_ShardHotKeys {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *HotspotsResponse) GetSlowOps() []*SlowOp {
+	if m != nil {
+		return m.SlowOps
+	}
+	return nil
+}
+
+func (m *HotspotsResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type HotspotsResponse_ShardHotKeys struct {
+	ShardId uint32    `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	HotKeys []*HotKey `protobuf:"bytes,2,rep,name=hot_keys,json=hotKeys" json:"hot_keys,omitempty"`
+}
+
+func (m *HotspotsResponse_ShardHotKeys) Reset()         { *m = HotspotsResponse_ShardHotKeys{} }
+func (m *HotspotsResponse_ShardHotKeys) String() string { return proto.CompactTextString(m) }
+func (*HotspotsResponse_ShardHotKeys) ProtoMessage()    {}
+func (*HotspotsResponse_ShardHotKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{63, 0}
+}
+
+func (m *HotspotsResponse_ShardHotKeys) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HotspotsResponse_ShardHotKeys) GetHotKeys() []*HotKey {
+	if m != nil {
+		return m.HotKeys
+	}
+	return nil
+}
+
+type ShardStatsRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+}
+
+func (m *ShardStatsRequest) Reset()                    { *m = ShardStatsRequest{} }
+func (m *ShardStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ShardStatsRequest) ProtoMessage()               {}
+func (*ShardStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *ShardStatsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type ShardStatsResponse struct {
+	Shards []*DbStats `protobuf:"bytes,1,rep,name=shards" json:"shards,omitempty"`
+	Error  string     `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ShardStatsResponse) Reset()                    { *m = ShardStatsResponse{} }
+func (m *ShardStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ShardStatsResponse) ProtoMessage()               {}
+func (*ShardStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *ShardStatsResponse) GetShards() []*DbStats {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *ShardStatsResponse) GetError() string {
 	if m != nil {
 		return m.Error
 	}
 	return ""
 }
 
-type ReplaceNodeRequest struct {
-	Keyspace   string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
-	NodeId     uint32 `protobuf:"varint,3,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
-	NewAddress string `protobuf:"bytes,4,opt,name=new_address,json=newAddress" json:"new_address,omitempty"`
+// DbStats are read from the db of one shard. Engines without such statistics only report the sizes.
+type DbStats struct {
+	ShardId                uint32 `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	EstimatedKeys          uint64 `protobuf:"varint,2,opt,name=estimated_keys,json=estimatedKeys" json:"estimated_keys,omitempty"`
+	LiveSstSize            uint64 `protobuf:"varint,3,opt,name=live_sst_size,json=liveSstSize" json:"live_sst_size,omitempty"`
+	TotalSstSize           uint64 `protobuf:"varint,4,opt,name=total_sst_size,json=totalSstSize" json:"total_sst_size,omitempty"`
+	MemtableSize           uint64 `protobuf:"varint,5,opt,name=memtable_size,json=memtableSize" json:"memtable_size,omitempty"`
+	PendingCompactionBytes uint64 `protobuf:"varint,6,opt,name=pending_compaction_bytes,json=pendingCompactionBytes" json:"pending_compaction_bytes,omitempty"`
+	// indexed by level
+	LevelFileCounts []uint64 `protobuf:"varint,7,rep,packed,name=level_file_counts,json=levelFileCounts" json:"level_file_counts,omitempty"`
+	// counted since the db is opened, 0 unless the store runs with --dbStatistics
+	BlockCacheHits   uint64 `protobuf:"varint,8,opt,name=block_cache_hits,json=blockCacheHits" json:"block_cache_hits,omitempty"`
+	BlockCacheMisses uint64 `protobuf:"varint,9,opt,name=block_cache_misses,json=blockCacheMisses" json:"block_cache_misses,omitempty"`
+	StallMicros      uint64 `protobuf:"varint,10,opt,name=stall_micros,json=stallMicros" json:"stall_micros,omitempty"`
 }
 
-func (m *ReplaceNodeRequest) Reset()                    { *m = ReplaceNodeRequest{} }
-func (m *ReplaceNodeRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReplaceNodeRequest) ProtoMessage()               {}
-func (*ReplaceNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (m *DbStats) Reset()                    { *m = DbStats{} }
+func (m *DbStats) String() string            { return proto.CompactTextString(m) }
+func (*DbStats) ProtoMessage()               {}
+func (*DbStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
-func (m *ReplaceNodeRequest) GetKeyspace() string {
+func (m *DbStats) GetShardId() uint32 {
 	if m != nil {
-		return m.Keyspace
+		return m.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (m *ReplaceNodeRequest) GetNodeId() uint32 {
+func (m *DbStats) GetEstimatedKeys() uint64 {
 	if m != nil {
-		return m.NodeId
+		return m.EstimatedKeys
 	}
 	return 0
 }
 
-func (m *ReplaceNodeRequest) GetNewAddress() string {
+func (m *DbStats) GetLiveSstSize() uint64 {
 	if m != nil {
-		return m.NewAddress
+		return m.LiveSstSize
 	}
-	return ""
+	return 0
 }
 
-type ReplaceNodeResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *DbStats) GetTotalSstSize() uint64 {
+	if m != nil {
+		return m.TotalSstSize
+	}
+	return 0
 }
 
-func (m *ReplaceNodeResponse) Reset()                    { *m = ReplaceNodeResponse{} }
-func (m *ReplaceNodeResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReplaceNodeResponse) ProtoMessage()               {}
-func (*ReplaceNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
-
-func (m *ReplaceNodeResponse) GetError() string {
+func (m *DbStats) GetMemtableSize() uint64 {
 	if m != nil {
-		return m.Error
+		return m.MemtableSize
 	}
-	return ""
+	return 0
 }
 
-// //////  request response with store
-type CreateShardRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ServerId          uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
-	ShardDiskSizeGb   uint32 `protobuf:"varint,5,opt,name=shard_disk_size_gb,json=shardDiskSizeGb" json:"shard_disk_size_gb,omitempty"`
+func (m *DbStats) GetPendingCompactionBytes() uint64 {
+	if m != nil {
+		return m.PendingCompactionBytes
+	}
+	return 0
 }
 
-func (m *CreateShardRequest) Reset()                    { *m = CreateShardRequest{} }
-func (m *CreateShardRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateShardRequest) ProtoMessage()               {}
-func (*CreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
-
-func (m *CreateShardRequest) GetKeyspace() string {
+func (m *DbStats) GetLevelFileCounts() []uint64 {
 	if m != nil {
-		return m.Keyspace
+		return m.LevelFileCounts
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateShardRequest) GetServerId() uint32 {
+func (m *DbStats) GetBlockCacheHits() uint64 {
 	if m != nil {
-		return m.ServerId
+		return m.BlockCacheHits
 	}
 	return 0
 }
 
-func (m *CreateShardRequest) GetClusterSize() uint32 {
+func (m *DbStats) GetBlockCacheMisses() uint64 {
 	if m != nil {
-		return m.ClusterSize
+		return m.BlockCacheMisses
 	}
 	return 0
 }
 
-func (m *CreateShardRequest) GetReplicationFactor() uint32 {
+func (m *DbStats) GetStallMicros() uint64 {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.StallMicros
 	}
 	return 0
 }
 
-func (m *CreateShardRequest) GetShardDiskSizeGb() uint32 {
+// HotKey counts are estimated, the real count is between count - error and count
+type HotKey struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Error uint64 `protobuf:"varint,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *HotKey) Reset()                    { *m = HotKey{} }
+func (m *HotKey) String() string            { return proto.CompactTextString(m) }
+func (*HotKey) ProtoMessage()               {}
+func (*HotKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *HotKey) GetKey() []byte {
 	if m != nil {
-		return m.ShardDiskSizeGb
+		return m.Key
 	}
-	return 0
+	return nil
 }
 
-type CreateShardResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *HotKey) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *CreateShardResponse) Reset()                    { *m = CreateShardResponse{} }
-func (m *CreateShardResponse) String() string            { return proto.CompactTextString(m) }
-func (*CreateShardResponse) ProtoMessage()               {}
-func (*CreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
-
-func (m *CreateShardResponse) GetError() string {
+func (m *HotKey) GetError() uint64 {
 	if m != nil {
 		return m.Error
 	}
-	return ""
+	return 0
 }
 
-type DeleteKeyspaceRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+type SlowOp struct {
+	Keyspace    string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ShardId     uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Op          string `protobuf:"bytes,3,opt,name=op" json:"op,omitempty"`
+	KeyPrefix   []byte `protobuf:"bytes,4,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	LatencyNs   uint64 `protobuf:"varint,5,opt,name=latency_ns,json=latencyNs" json:"latency_ns,omitempty"`
+	ValueSize   uint64 `protobuf:"varint,6,opt,name=value_size,json=valueSize" json:"value_size,omitempty"`
+	StartedAtNs uint64 `protobuf:"varint,7,opt,name=started_at_ns,json=startedAtNs" json:"started_at_ns,omitempty"`
 }
 
-func (m *DeleteKeyspaceRequest) Reset()                    { *m = DeleteKeyspaceRequest{} }
-func (m *DeleteKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteKeyspaceRequest) ProtoMessage()               {}
-func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (m *SlowOp) Reset()                    { *m = SlowOp{} }
+func (m *SlowOp) String() string            { return proto.CompactTextString(m) }
+func (*SlowOp) ProtoMessage()               {}
+func (*SlowOp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
-func (m *DeleteKeyspaceRequest) GetKeyspace() string {
+func (m *SlowOp) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-type DeleteKeyspaceResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *SlowOp) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
 }
 
-func (m *DeleteKeyspaceResponse) Reset()                    { *m = DeleteKeyspaceResponse{} }
-func (m *DeleteKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteKeyspaceResponse) ProtoMessage()               {}
-func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
-
-func (m *DeleteKeyspaceResponse) GetError() string {
+func (m *SlowOp) GetOp() string {
 	if m != nil {
-		return m.Error
+		return m.Op
 	}
 	return ""
 }
 
-type CompactKeyspaceRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+func (m *SlowOp) GetKeyPrefix() []byte {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return nil
 }
 
-func (m *CompactKeyspaceRequest) Reset()                    { *m = CompactKeyspaceRequest{} }
-func (m *CompactKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
-func (*CompactKeyspaceRequest) ProtoMessage()               {}
-func (*CompactKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
-
-func (m *CompactKeyspaceRequest) GetKeyspace() string {
+func (m *SlowOp) GetLatencyNs() uint64 {
 	if m != nil {
-		return m.Keyspace
+		return m.LatencyNs
 	}
-	return ""
+	return 0
 }
 
-type CompactKeyspaceResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *SlowOp) GetValueSize() uint64 {
+	if m != nil {
+		return m.ValueSize
+	}
+	return 0
 }
 
-func (m *CompactKeyspaceResponse) Reset()                    { *m = CompactKeyspaceResponse{} }
-func (m *CompactKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
-func (*CompactKeyspaceResponse) ProtoMessage()               {}
-func (*CompactKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
-
-func (m *CompactKeyspaceResponse) GetError() string {
+func (m *SlowOp) GetStartedAtNs() uint64 {
 	if m != nil {
-		return m.Error
+		return m.StartedAtNs
 	}
-	return ""
+	return 0
 }
 
 type ReplicateNodePrepareRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ServerId          uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	Keyspace          string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	Quota             *KeyspaceQuota   `protobuf:"bytes,5,opt,name=quota" json:"quota,omitempty"`
+	Storage           *KeyspaceStorage `protobuf:"bytes,6,opt,name=storage" json:"storage,omitempty"`
 }
 
 func (m *ReplicateNodePrepareRequest) Reset()                    { *m = ReplicateNodePrepareRequest{} }
 func (m *ReplicateNodePrepareRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReplicateNodePrepareRequest) ProtoMessage()               {}
-func (*ReplicateNodePrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*ReplicateNodePrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 func (m *ReplicateNodePrepareRequest) GetKeyspace() string {
 	if m != nil {
@@ -2052,6 +3275,20 @@ func (m *ReplicateNodePrepareRequest) GetReplicationFactor() uint32 {
 	return 0
 }
 
+func (m *ReplicateNodePrepareRequest) GetQuota() *KeyspaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *ReplicateNodePrepareRequest) GetStorage() *KeyspaceStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 type ReplicateNodePrepareResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
@@ -2059,7 +3296,7 @@ type ReplicateNodePrepareResponse struct {
 func (m *ReplicateNodePrepareResponse) Reset()                    { *m = ReplicateNodePrepareResponse{} }
 func (m *ReplicateNodePrepareResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReplicateNodePrepareResponse) ProtoMessage()               {}
-func (*ReplicateNodePrepareResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*ReplicateNodePrepareResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 func (m *ReplicateNodePrepareResponse) GetError() string {
 	if m != nil {
@@ -2075,7 +3312,7 @@ type ReplicateNodeCommitRequest struct {
 func (m *ReplicateNodeCommitRequest) Reset()                    { *m = ReplicateNodeCommitRequest{} }
 func (m *ReplicateNodeCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReplicateNodeCommitRequest) ProtoMessage()               {}
-func (*ReplicateNodeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*ReplicateNodeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 func (m *ReplicateNodeCommitRequest) GetKeyspace() string {
 	if m != nil {
@@ -2091,7 +3328,7 @@ type ReplicateNodeCommitResponse struct {
 func (m *ReplicateNodeCommitResponse) Reset()                    { *m = ReplicateNodeCommitResponse{} }
 func (m *ReplicateNodeCommitResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReplicateNodeCommitResponse) ProtoMessage()               {}
-func (*ReplicateNodeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*ReplicateNodeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 func (m *ReplicateNodeCommitResponse) GetError() string {
 	if m != nil {
@@ -2107,7 +3344,7 @@ type ReplicateNodeCleanupRequest struct {
 func (m *ReplicateNodeCleanupRequest) Reset()                    { *m = ReplicateNodeCleanupRequest{} }
 func (m *ReplicateNodeCleanupRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReplicateNodeCleanupRequest) ProtoMessage()               {}
-func (*ReplicateNodeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*ReplicateNodeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *ReplicateNodeCleanupRequest) GetKeyspace() string {
 	if m != nil {
@@ -2123,7 +3360,7 @@ type ReplicateNodeCleanupResponse struct {
 func (m *ReplicateNodeCleanupResponse) Reset()                    { *m = ReplicateNodeCleanupResponse{} }
 func (m *ReplicateNodeCleanupResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReplicateNodeCleanupResponse) ProtoMessage()               {}
-func (*ReplicateNodeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*ReplicateNodeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *ReplicateNodeCleanupResponse) GetError() string {
 	if m != nil {
@@ -2133,17 +3370,19 @@ func (m *ReplicateNodeCleanupResponse) GetError() string {
 }
 
 type ResizeCreateShardRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ServerId          uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	Keyspace          string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	TargetClusterSize uint32           `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	Quota             *KeyspaceQuota   `protobuf:"bytes,6,opt,name=quota" json:"quota,omitempty"`
+	Storage           *KeyspaceStorage `protobuf:"bytes,7,opt,name=storage" json:"storage,omitempty"`
 }
 
 func (m *ResizeCreateShardRequest) Reset()                    { *m = ResizeCreateShardRequest{} }
 func (m *ResizeCreateShardRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResizeCreateShardRequest) ProtoMessage()               {}
-func (*ResizeCreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*ResizeCreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *ResizeCreateShardRequest) GetKeyspace() string {
 	if m != nil {
@@ -2180,6 +3419,20 @@ func (m *ResizeCreateShardRequest) GetTargetClusterSize() uint32 {
 	return 0
 }
 
+func (m *ResizeCreateShardRequest) GetQuota() *KeyspaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *ResizeCreateShardRequest) GetStorage() *KeyspaceStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 type ResizeCreateShardResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
@@ -2187,7 +3440,7 @@ type ResizeCreateShardResponse struct {
 func (m *ResizeCreateShardResponse) Reset()                    { *m = ResizeCreateShardResponse{} }
 func (m *ResizeCreateShardResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResizeCreateShardResponse) ProtoMessage()               {}
-func (*ResizeCreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*ResizeCreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *ResizeCreateShardResponse) GetError() string {
 	if m != nil {
@@ -2204,7 +3457,7 @@ type ResizeCommitRequest struct {
 func (m *ResizeCommitRequest) Reset()                    { *m = ResizeCommitRequest{} }
 func (m *ResizeCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResizeCommitRequest) ProtoMessage()               {}
-func (*ResizeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*ResizeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *ResizeCommitRequest) GetKeyspace() string {
 	if m != nil {
@@ -2227,7 +3480,7 @@ type ResizeCommitResponse struct {
 func (m *ResizeCommitResponse) Reset()                    { *m = ResizeCommitResponse{} }
 func (m *ResizeCommitResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResizeCommitResponse) ProtoMessage()               {}
-func (*ResizeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*ResizeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *ResizeCommitResponse) GetError() string {
 	if m != nil {
@@ -2244,7 +3497,7 @@ type ResizeCleanupRequest struct {
 func (m *ResizeCleanupRequest) Reset()                    { *m = ResizeCleanupRequest{} }
 func (m *ResizeCleanupRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResizeCleanupRequest) ProtoMessage()               {}
-func (*ResizeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*ResizeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *ResizeCleanupRequest) GetKeyspace() string {
 	if m != nil {
@@ -2267,7 +3520,7 @@ type ResizeCleanupResponse struct {
 func (m *ResizeCleanupResponse) Reset()                    { *m = ResizeCleanupResponse{} }
 func (m *ResizeCleanupResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResizeCleanupResponse) ProtoMessage()               {}
-func (*ResizeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*ResizeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *ResizeCleanupResponse) GetError() string {
 	if m != nil {
@@ -2284,7 +3537,7 @@ type ResizeRequest struct {
 func (m *ResizeRequest) Reset()                    { *m = ResizeRequest{} }
 func (m *ResizeRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResizeRequest) ProtoMessage()               {}
-func (*ResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*ResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *ResizeRequest) GetKeyspace() string {
 	if m != nil {
@@ -2307,7 +3560,7 @@ type ResizeResponse struct {
 func (m *ResizeResponse) Reset()                    { *m = ResizeResponse{} }
 func (m *ResizeResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResizeResponse) ProtoMessage()               {}
-func (*ResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*ResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *ResizeResponse) GetError() string {
 	if m != nil {
@@ -2319,6 +3572,7 @@ func (m *ResizeResponse) GetError() string {
 func init() {
 	proto.RegisterType((*BalanceRequest)(nil), "pb.BalanceRequest")
 	proto.RegisterType((*StoreHeartbeat)(nil), "pb.StoreHeartbeat")
+	proto.RegisterType((*ReplicationLag)(nil), "pb.ReplicationLag")
 	proto.RegisterType((*StoreMessage)(nil), "pb.StoreMessage")
 	proto.RegisterType((*ClientHeartbeat)(nil), "pb.ClientHeartbeat")
 	proto.RegisterType((*ClientHeartbeat_ClusterFollowMessage)(nil), "pb.ClientHeartbeat.ClusterFollowMessage")
@@ -2329,6 +3583,12 @@ func init() {
 	proto.RegisterType((*ClusterNode)(nil), "pb.ClusterNode")
 	proto.RegisterType((*StoreResource)(nil), "pb.StoreResource")
 	proto.RegisterType((*LocalShardsInCluster)(nil), "pb.LocalShardsInCluster")
+	proto.RegisterType((*AccessControlList)(nil), "pb.AccessControlList")
+	proto.RegisterType((*AclUser)(nil), "pb.AclUser")
+	proto.RegisterType((*AclGrant)(nil), "pb.AclGrant")
+	proto.RegisterType((*KeyspaceQuota)(nil), "pb.KeyspaceQuota")
+	proto.RegisterType((*KeyspaceStorage)(nil), "pb.KeyspaceStorage")
+	proto.RegisterType((*StorageProfile)(nil), "pb.StorageProfile")
 	proto.RegisterType((*ShardInfo)(nil), "pb.ShardInfo")
 	proto.RegisterType((*Empty)(nil), "pb.Empty")
 	proto.RegisterType((*KeyTypeValue)(nil), "pb.KeyTypeValue")
@@ -2339,6 +3599,7 @@ func init() {
 	proto.RegisterType((*MergeRequest)(nil), "pb.MergeRequest")
 	proto.RegisterType((*WriteResponse)(nil), "pb.WriteResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "pb.DeleteRequest")
+	proto.RegisterType((*DeleteRangeRequest)(nil), "pb.DeleteRangeRequest")
 	proto.RegisterType((*GetRequest)(nil), "pb.GetRequest")
 	proto.RegisterType((*GetResponse)(nil), "pb.GetResponse")
 	proto.RegisterType((*GetByPrefixRequest)(nil), "pb.GetByPrefixRequest")
@@ -2359,12 +3620,14 @@ func init() {
 	proto.RegisterType((*DescribeRequest_DescKeyspaces)(nil), "pb.DescribeRequest.DescKeyspaces")
 	proto.RegisterType((*DescribeRequest_DescCluster)(nil), "pb.DescribeRequest.DescCluster")
 	proto.RegisterType((*DescribeRequest_DescClients)(nil), "pb.DescribeRequest.DescClients")
+	proto.RegisterType((*DescribeRequest_DescAuditLog)(nil), "pb.DescribeRequest.DescAuditLog")
 	proto.RegisterType((*DescribeResponse)(nil), "pb.DescribeResponse")
 	proto.RegisterType((*DescribeResponse_DescDataCenter)(nil), "pb.DescribeResponse.DescDataCenter")
 	proto.RegisterType((*DescribeResponse_DescDataCenter_DataCenter)(nil), "pb.DescribeResponse.DescDataCenter.DataCenter")
 	proto.RegisterType((*DescribeResponse_DescKeyspaces)(nil), "pb.DescribeResponse.DescKeyspaces")
 	proto.RegisterType((*DescribeResponse_DescKeyspaces_Keyspace)(nil), "pb.DescribeResponse.DescKeyspaces.Keyspace")
 	proto.RegisterType((*DescribeResponse_DescCluster)(nil), "pb.DescribeResponse.DescCluster")
+	proto.RegisterType((*DescribeResponse_DescAuditLog)(nil), "pb.DescribeResponse.DescAuditLog")
 	proto.RegisterType((*CreateClusterRequest)(nil), "pb.CreateClusterRequest")
 	proto.RegisterType((*CreateClusterResponse)(nil), "pb.CreateClusterResponse")
 	proto.RegisterType((*DeleteClusterRequest)(nil), "pb.DeleteClusterRequest")
@@ -2373,12 +3636,25 @@ func init() {
 	proto.RegisterType((*CompactClusterResponse)(nil), "pb.CompactClusterResponse")
 	proto.RegisterType((*ReplaceNodeRequest)(nil), "pb.ReplaceNodeRequest")
 	proto.RegisterType((*ReplaceNodeResponse)(nil), "pb.ReplaceNodeResponse")
+	proto.RegisterType((*LoginRequest)(nil), "pb.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "pb.LoginResponse")
+	proto.RegisterType((*AuditRecord)(nil), "pb.AuditRecord")
+	proto.RegisterType((*SetKeyspaceQuotaRequest)(nil), "pb.SetKeyspaceQuotaRequest")
+	proto.RegisterType((*SetKeyspaceQuotaResponse)(nil), "pb.SetKeyspaceQuotaResponse")
 	proto.RegisterType((*CreateShardRequest)(nil), "pb.CreateShardRequest")
 	proto.RegisterType((*CreateShardResponse)(nil), "pb.CreateShardResponse")
 	proto.RegisterType((*DeleteKeyspaceRequest)(nil), "pb.DeleteKeyspaceRequest")
 	proto.RegisterType((*DeleteKeyspaceResponse)(nil), "pb.DeleteKeyspaceResponse")
 	proto.RegisterType((*CompactKeyspaceRequest)(nil), "pb.CompactKeyspaceRequest")
 	proto.RegisterType((*CompactKeyspaceResponse)(nil), "pb.CompactKeyspaceResponse")
+	proto.RegisterType((*HotspotsRequest)(nil), "pb.HotspotsRequest")
+	proto.RegisterType((*HotspotsResponse)(nil), "pb.HotspotsResponse")
+	proto.RegisterType((*HotspotsResponse_ShardHotKeys)(nil), "pb.HotspotsResponse.ShardHotKeys")
+	proto.RegisterType((*ShardStatsRequest)(nil), "pb.ShardStatsRequest")
+	proto.RegisterType((*ShardStatsResponse)(nil), "pb.ShardStatsResponse")
+	proto.RegisterType((*DbStats)(nil), "pb.DbStats")
+	proto.RegisterType((*HotKey)(nil), "pb.HotKey")
+	proto.RegisterType((*SlowOp)(nil), "pb.SlowOp")
 	proto.RegisterType((*ReplicateNodePrepareRequest)(nil), "pb.ReplicateNodePrepareRequest")
 	proto.RegisterType((*ReplicateNodePrepareResponse)(nil), "pb.ReplicateNodePrepareResponse")
 	proto.RegisterType((*ReplicateNodeCommitRequest)(nil), "pb.ReplicateNodeCommitRequest")
@@ -2394,6 +3670,7 @@ func init() {
 	proto.RegisterType((*ResizeRequest)(nil), "pb.ResizeRequest")
 	proto.RegisterType((*ResizeResponse)(nil), "pb.ResizeResponse")
 	proto.RegisterEnum("pb.OpAndDataType", OpAndDataType_name, OpAndDataType_value)
+	proto.RegisterEnum("pb.StorageProfile_Compression", StorageProfile_Compression_name, StorageProfile_Compression_value)
 	proto.RegisterEnum("pb.ShardInfo_Status", ShardInfo_Status_name, ShardInfo_Status_value)
 }
 
@@ -2416,6 +3693,8 @@ type VastoMasterClient interface {
 	CompactCluster(ctx context.Context, in *CompactClusterRequest, opts ...grpc.CallOption) (*CompactClusterResponse, error)
 	ResizeCluster(ctx context.Context, in *ResizeRequest, opts ...grpc.CallOption) (*ResizeResponse, error)
 	ReplaceNode(ctx context.Context, in *ReplaceNodeRequest, opts ...grpc.CallOption) (*ReplaceNodeResponse, error)
+	SetKeyspaceQuota(ctx context.Context, in *SetKeyspaceQuotaRequest, opts ...grpc.CallOption) (*SetKeyspaceQuotaResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	DebugMaster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -2543,6 +3822,24 @@ func (c *vastoMasterClient) ReplaceNode(ctx context.Context, in *ReplaceNodeRequ
 	return out, nil
 }
 
+func (c *vastoMasterClient) SetKeyspaceQuota(ctx context.Context, in *SetKeyspaceQuotaRequest, opts ...grpc.CallOption) (*SetKeyspaceQuotaResponse, error) {
+	out := new(SetKeyspaceQuotaResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/SetKeyspaceQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/Login", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vastoMasterClient) DebugMaster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := grpc.Invoke(ctx, "/pb.VastoMaster/DebugMaster", in, out, c.cc, opts...)
@@ -2563,6 +3860,8 @@ type VastoMasterServer interface {
 	CompactCluster(context.Context, *CompactClusterRequest) (*CompactClusterResponse, error)
 	ResizeCluster(context.Context, *ResizeRequest) (*ResizeResponse, error)
 	ReplaceNode(context.Context, *ReplaceNodeRequest) (*ReplaceNodeResponse, error)
+	SetKeyspaceQuota(context.Context, *SetKeyspaceQuotaRequest) (*SetKeyspaceQuotaResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	DebugMaster(context.Context, *Empty) (*Empty, error)
 }
 
@@ -2730,6 +4029,42 @@ func _VastoMaster_ReplaceNode_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VastoMaster_SetKeyspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeyspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).SetKeyspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/SetKeyspaceQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).SetKeyspaceQuota(ctx, req.(*SetKeyspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VastoMaster_DebugMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -2776,6 +4111,14 @@ var _VastoMaster_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReplaceNode",
 			Handler:    _VastoMaster_ReplaceNode_Handler,
 		},
+		{
+			MethodName: "SetKeyspaceQuota",
+			Handler:    _VastoMaster_SetKeyspaceQuota_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _VastoMaster_Login_Handler,
+		},
 		{
 			MethodName: "DebugMaster",
 			Handler:    _VastoMaster_DebugMaster_Handler,
@@ -2813,6 +4156,9 @@ type VastoStoreClient interface {
 	ResizePrepare(ctx context.Context, in *ResizeCreateShardRequest, opts ...grpc.CallOption) (*ResizeCreateShardResponse, error)
 	ResizeCommit(ctx context.Context, in *ResizeCommitRequest, opts ...grpc.CallOption) (*ResizeCommitResponse, error)
 	ResizeCleanup(ctx context.Context, in *ResizeCleanupRequest, opts ...grpc.CallOption) (*ResizeCleanupResponse, error)
+	Hotspots(ctx context.Context, in *HotspotsRequest, opts ...grpc.CallOption) (*HotspotsResponse, error)
+	ShardStats(ctx context.Context, in *ShardStatsRequest, opts ...grpc.CallOption) (*ShardStatsResponse, error)
+	SetKeyspaceQuota(ctx context.Context, in *SetKeyspaceQuotaRequest, opts ...grpc.CallOption) (*SetKeyspaceQuotaResponse, error)
 	DebugStore(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -2978,6 +4324,33 @@ func (c *vastoStoreClient) ResizeCleanup(ctx context.Context, in *ResizeCleanupR
 	return out, nil
 }
 
+func (c *vastoStoreClient) Hotspots(ctx context.Context, in *HotspotsRequest, opts ...grpc.CallOption) (*HotspotsResponse, error) {
+	out := new(HotspotsResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/Hotspots", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoStoreClient) ShardStats(ctx context.Context, in *ShardStatsRequest, opts ...grpc.CallOption) (*ShardStatsResponse, error) {
+	out := new(ShardStatsResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/ShardStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoStoreClient) SetKeyspaceQuota(ctx context.Context, in *SetKeyspaceQuotaRequest, opts ...grpc.CallOption) (*SetKeyspaceQuotaResponse, error) {
+	out := new(SetKeyspaceQuotaResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/SetKeyspaceQuota", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vastoStoreClient) DebugStore(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := grpc.Invoke(ctx, "/pb.VastoStore/DebugStore", in, out, c.cc, opts...)
@@ -3002,6 +4375,9 @@ type VastoStoreServer interface {
 	ResizePrepare(context.Context, *ResizeCreateShardRequest) (*ResizeCreateShardResponse, error)
 	ResizeCommit(context.Context, *ResizeCommitRequest) (*ResizeCommitResponse, error)
 	ResizeCleanup(context.Context, *ResizeCleanupRequest) (*ResizeCleanupResponse, error)
+	Hotspots(context.Context, *HotspotsRequest) (*HotspotsResponse, error)
+	ShardStats(context.Context, *ShardStatsRequest) (*ShardStatsResponse, error)
+	SetKeyspaceQuota(context.Context, *SetKeyspaceQuotaRequest) (*SetKeyspaceQuotaResponse, error)
 	DebugStore(context.Context, *Empty) (*Empty, error)
 }
 
@@ -3231,6 +4607,60 @@ func _VastoStore_ResizeCleanup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VastoStore_Hotspots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotspotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).Hotspots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/Hotspots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).Hotspots(ctx, req.(*HotspotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoStore_ShardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).ShardStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/ShardStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).ShardStats(ctx, req.(*ShardStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoStore_SetKeyspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeyspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).SetKeyspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/SetKeyspaceQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).SetKeyspaceQuota(ctx, req.(*SetKeyspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VastoStore_DebugStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -3293,6 +4723,18 @@ var _VastoStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResizeCleanup",
 			Handler:    _VastoStore_ResizeCleanup_Handler,
 		},
+		{
+			MethodName: "Hotspots",
+			Handler:    _VastoStore_Hotspots_Handler,
+		},
+		{
+			MethodName: "ShardStats",
+			Handler:    _VastoStore_ShardStats_Handler,
+		},
+		{
+			MethodName: "SetKeyspaceQuota",
+			Handler:    _VastoStore_SetKeyspaceQuota_Handler,
+		},
 		{
 			MethodName: "DebugStore",
 			Handler:    _VastoStore_DebugStore_Handler,
@@ -3316,191 +4758,296 @@ var _VastoStore_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("vasto.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2970 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
-	0xf1, 0xe7, 0xe2, 0x41, 0x00, 0xbd, 0x04, 0x40, 0x0e, 0x49, 0x89, 0x5a, 0xd9, 0x16, 0xb5, 0xfe,
-	0x4b, 0xa6, 0x2d, 0x09, 0xd6, 0x9f, 0x76, 0x62, 0x45, 0xae, 0x8a, 0x4d, 0x82, 0x94, 0xc5, 0x48,
-	0x14, 0x59, 0x0b, 0xda, 0xb1, 0xcb, 0xa9, 0xda, 0x5a, 0x62, 0x87, 0xd0, 0x86, 0xc0, 0xee, 0x66,
-	0x67, 0x60, 0x1a, 0x39, 0xfa, 0x90, 0x54, 0x0e, 0xb9, 0x24, 0x97, 0x5c, 0x52, 0x95, 0x4a, 0x2e,
-	0xa9, 0xca, 0x67, 0xc8, 0x21, 0x87, 0x5c, 0xf2, 0xba, 0xa5, 0x2a, 0x95, 0x5b, 0x3e, 0x40, 0xae,
-	0xf1, 0x35, 0x35, 0xaf, 0x7d, 0x00, 0x0b, 0x90, 0xb4, 0xe3, 0x2a, 0xdf, 0x30, 0xdd, 0x3d, 0x3d,
-	0xfd, 0xf8, 0x4d, 0x4f, 0xcf, 0x60, 0x41, 0xff, 0xc4, 0x21, 0x34, 0x68, 0x85, 0x51, 0x40, 0x03,
-	0x54, 0x08, 0x8f, 0x4d, 0x0b, 0x1a, 0xdb, 0x4e, 0xdf, 0xf1, 0xbb, 0xd8, 0xc2, 0x3f, 0x18, 0x62,
-	0x42, 0xd1, 0x0d, 0xd0, 0x09, 0x0d, 0x22, 0x6c, 0xf7, 0xa2, 0x60, 0x18, 0xae, 0x15, 0xd6, 0xb5,
-	0x8d, 0x9a, 0x05, 0x9c, 0xf4, 0x1e, 0xa3, 0x24, 0x02, 0xdd, 0x60, 0xe8, 0xd3, 0xb5, 0xe2, 0xba,
-	0xb6, 0x51, 0x97, 0x02, 0x6d, 0x46, 0x31, 0xcf, 0xa0, 0xd1, 0x61, 0xa3, 0xc7, 0xd8, 0x89, 0xe8,
-	0x31, 0x76, 0x28, 0x7a, 0x00, 0x0d, 0x31, 0x25, 0xc2, 0x24, 0x18, 0x46, 0x5d, 0xbc, 0xa6, 0xad,
-	0x6b, 0x1b, 0xfa, 0xe6, 0x52, 0x2b, 0x3c, 0x6e, 0x71, 0x59, 0x4b, 0x32, 0xac, 0x3a, 0x49, 0x0f,
-	0xd1, 0x1d, 0xa8, 0x75, 0x9e, 0x3b, 0x91, 0xbb, 0xe7, 0x9f, 0x04, 0xdc, 0x16, 0x7d, 0xb3, 0xce,
-	0x27, 0x29, 0xa2, 0x95, 0xf0, 0xcd, 0x06, 0x2c, 0x70, 0x65, 0xfb, 0x98, 0x10, 0xa7, 0x87, 0xcd,
-	0x7f, 0x68, 0xd0, 0x6c, 0xf7, 0x3d, 0xec, 0xd3, 0xc4, 0x94, 0x1b, 0xa0, 0x77, 0x39, 0xc9, 0xf6,
-	0x9d, 0x01, 0x56, 0xee, 0x09, 0xd2, 0x33, 0x67, 0x80, 0xd1, 0x01, 0x34, 0xba, 0xfd, 0x21, 0xa1,
-	0x38, 0xb2, 0x4f, 0x82, 0x7e, 0x3f, 0x38, 0xe3, 0x1e, 0xea, 0x9b, 0x1b, 0x6c, 0xd9, 0x31, 0x6d,
-	0xad, 0xb6, 0x90, 0x7c, 0xc4, 0x05, 0xe5, 0xb2, 0x56, 0xbd, 0x9b, 0xa6, 0x1a, 0x1d, 0x58, 0xc9,
-	0x13, 0x43, 0x06, 0x54, 0x4f, 0xf1, 0x88, 0x84, 0x8e, 0x0c, 0x47, 0xcd, 0x8a, 0xc7, 0xcc, 0x4a,
-	0x8f, 0xd8, 0x43, 0x5f, 0x5a, 0xc0, 0xac, 0xac, 0x5a, 0xe0, 0x91, 0xf7, 0x25, 0xc5, 0xfc, 0x6b,
-	0x11, 0xea, 0xc2, 0x18, 0xa5, 0xee, 0x16, 0x54, 0xe4, 0xba, 0x32, 0xb8, 0xba, 0x30, 0x98, 0x93,
-	0x2c, 0xc5, 0x43, 0xef, 0x40, 0x65, 0x18, 0xba, 0x0e, 0xc5, 0x44, 0x86, 0xf3, 0x56, 0xe2, 0x97,
-	0x54, 0x95, 0xcd, 0xc8, 0xfb, 0x5c, 0xda, 0x52, 0xb3, 0xd0, 0x7d, 0x98, 0x8f, 0x30, 0xf1, 0x7e,
-	0x88, 0x65, 0x5c, 0xd6, 0x26, 0xe7, 0x5b, 0x9c, 0x6f, 0x49, 0x39, 0xe3, 0x17, 0x1a, 0x2c, 0xe7,
-	0xa8, 0x44, 0xb7, 0xa0, 0xec, 0x07, 0x2e, 0x26, 0x6b, 0xda, 0x7a, 0x71, 0x43, 0xdf, 0x6c, 0xa6,
-	0xec, 0x7d, 0x16, 0xb8, 0xd8, 0x12, 0x5c, 0x74, 0x1d, 0x6a, 0x1e, 0xb1, 0x5d, 0xdc, 0xc7, 0x14,
-	0xcb, 0x48, 0x54, 0x3d, 0xb2, 0xc3, 0xc7, 0x99, 0x20, 0x16, 0xc7, 0x82, 0x78, 0x13, 0x16, 0x3c,
-	0x62, 0x87, 0x51, 0x30, 0x08, 0xa8, 0x17, 0xf8, 0x6b, 0x25, 0x3e, 0x57, 0xf7, 0xc8, 0xa1, 0x22,
-	0x19, 0x3f, 0xd2, 0x60, 0x5e, 0x58, 0x8b, 0xee, 0xc3, 0x4a, 0x77, 0x18, 0x45, 0x0c, 0x19, 0x2a,
-	0xff, 0xdc, 0x4b, 0x8d, 0xe3, 0x1b, 0x49, 0x9e, 0xb4, 0xaf, 0xc3, 0x66, 0xb4, 0x60, 0x99, 0x3a,
-	0x51, 0x0f, 0x8f, 0x4d, 0x28, 0xf0, 0x09, 0x4b, 0x82, 0x95, 0x96, 0x9f, 0x61, 0xab, 0xf9, 0x2f,
-	0x0d, 0x2a, 0x52, 0x76, 0x26, 0x30, 0xe2, 0x98, 0x15, 0x67, 0xc6, 0x6c, 0x13, 0x56, 0xf1, 0xa7,
-	0x21, 0xee, 0x52, 0xec, 0x66, 0x8d, 0x2b, 0x71, 0xe3, 0x96, 0x15, 0x33, 0x6d, 0xde, 0xb4, 0x00,
-	0x94, 0xa7, 0x06, 0xe0, 0x1e, 0xa0, 0x08, 0x87, 0x7d, 0xaf, 0xeb, 0xb0, 0x60, 0xda, 0x27, 0x4e,
-	0x97, 0x06, 0xd1, 0xda, 0xbc, 0xf0, 0x3f, 0xc5, 0x79, 0xc4, 0x19, 0xe6, 0x10, 0xf4, 0x94, 0xa9,
-	0x5f, 0xa2, 0x28, 0xdc, 0x05, 0x20, 0x6c, 0xd3, 0xdb, 0xde, 0xf4, 0xaa, 0x40, 0xe2, 0xaa, 0xf0,
-	0x27, 0x0d, 0xea, 0x19, 0x75, 0x68, 0x0d, 0x2a, 0x3e, 0xa6, 0x67, 0x41, 0x74, 0x2a, 0xf7, 0xbf,
-	0x1a, 0x32, 0x8e, 0xe3, 0xba, 0x11, 0x26, 0x44, 0x66, 0x48, 0x0d, 0xd1, 0xcb, 0x50, 0x77, 0xdc,
-	0x81, 0xe7, 0xdb, 0x8a, 0x5f, 0xe2, 0xfc, 0x05, 0x4e, 0xdc, 0x92, 0x42, 0x08, 0x4a, 0xd4, 0xe9,
-	0x91, 0xb5, 0xca, 0x7a, 0x71, 0xa3, 0x66, 0xf1, 0xdf, 0x68, 0x1d, 0x16, 0x5c, 0x8f, 0x9c, 0xf2,
-	0x58, 0xda, 0xbd, 0xe3, 0xb5, 0xaa, 0xa8, 0x97, 0x8c, 0xc6, 0x82, 0xf8, 0xde, 0x31, 0x7a, 0x0d,
-	0x96, 0x9c, 0x7e, 0x3f, 0xe8, 0x3a, 0x2c, 0x5b, 0x4a, 0xac, 0xc6, 0xc5, 0x9a, 0x31, 0x43, 0xc8,
-	0x9a, 0x3f, 0x29, 0xc0, 0xca, 0xd3, 0xa0, 0xeb, 0xf4, 0xb9, 0xab, 0x64, 0xcf, 0x57, 0xa0, 0x69,
-	0x40, 0xc1, 0x73, 0x25, 0x58, 0x0b, 0x9e, 0x8b, 0xda, 0x20, 0x42, 0x60, 0x0f, 0x1c, 0x56, 0xc4,
-	0x19, 0x58, 0x6e, 0xb3, 0x10, 0xe5, 0x4d, 0x16, 0x71, 0xdb, 0x77, 0xc2, 0x5d, 0x9f, 0x46, 0x23,
-	0xab, 0x4a, 0xe4, 0x90, 0xed, 0xa0, 0x0c, 0x14, 0x44, 0xad, 0xd7, 0xbb, 0xe7, 0x62, 0xa0, 0x34,
-	0x05, 0x03, 0xc6, 0x77, 0xa0, 0x9e, 0x59, 0x0c, 0x2d, 0x42, 0xf1, 0x14, 0x8f, 0xa4, 0xe1, 0xec,
-	0x27, 0x7a, 0x19, 0xca, 0x9f, 0x38, 0xfd, 0x21, 0xce, 0x4f, 0xac, 0xe0, 0x3d, 0x2c, 0x3c, 0xd0,
-	0xcc, 0xcf, 0x0b, 0xa9, 0xc3, 0x81, 0x25, 0x48, 0xed, 0x12, 0x51, 0xda, 0xc5, 0xd6, 0x59, 0x50,
-	0x44, 0x5e, 0xdc, 0xaf, 0x43, 0x8d, 0xe0, 0xe8, 0x13, 0x1c, 0xd9, 0x9e, 0x2b, 0x37, 0x6a, 0x55,
-	0x10, 0xf6, 0x5c, 0x74, 0x0d, 0xaa, 0x12, 0x56, 0xae, 0xf4, 0xb4, 0x22, 0x50, 0xe4, 0x4e, 0x04,
-	0xa2, 0x74, 0xd1, 0x40, 0x94, 0xa7, 0x04, 0x02, 0xdd, 0x85, 0x79, 0x42, 0x1d, 0x3a, 0x24, 0x7c,
-	0xbf, 0x34, 0x36, 0x57, 0x32, 0x6e, 0xb6, 0x3a, 0x9c, 0x67, 0x49, 0x19, 0x59, 0xca, 0xba, 0x8e,
-	0xef, 0x7a, 0xac, 0x74, 0xae, 0x55, 0x54, 0x29, 0x6b, 0x2b, 0x12, 0xab, 0x46, 0xac, 0xda, 0xe1,
-	0x68, 0xe0, 0xf8, 0x6c, 0x0f, 0xcb, 0x82, 0x59, 0xe5, 0x92, 0x4b, 0x1e, 0x39, 0x54, 0x1c, 0x51,
-	0x39, 0xcd, 0x87, 0x30, 0x2f, 0x16, 0x41, 0x35, 0x28, 0xef, 0xee, 0x1f, 0x1e, 0x7d, 0xb4, 0x38,
-	0x87, 0xea, 0x50, 0xdb, 0x3e, 0x38, 0x38, 0xea, 0x1c, 0x59, 0x5b, 0x87, 0x8b, 0x1a, 0xe3, 0x58,
-	0xbb, 0x5b, 0x3b, 0x1f, 0x2d, 0x16, 0x90, 0x0e, 0x95, 0x9d, 0xdd, 0xa7, 0xbb, 0x47, 0xbb, 0x3b,
-	0x8b, 0x45, 0xb3, 0x02, 0xe5, 0xdd, 0x41, 0x48, 0x47, 0xe6, 0x4f, 0x35, 0x58, 0x78, 0x82, 0x47,
-	0x47, 0xa3, 0x10, 0x7f, 0xc0, 0xf2, 0x92, 0x4e, 0xe7, 0x82, 0x48, 0xe7, 0x2d, 0x68, 0x84, 0x4e,
-	0x44, 0x3d, 0x1e, 0x95, 0xe7, 0x0e, 0x79, 0xce, 0xe3, 0x5e, 0xb2, 0xea, 0x31, 0xf5, 0xb1, 0x43,
-	0x9e, 0xa3, 0x16, 0xd4, 0x5c, 0x87, 0x3a, 0x36, 0x1d, 0x85, 0x02, 0x67, 0x0d, 0x51, 0x08, 0x0e,
-	0xc2, 0x2d, 0xdf, 0xdd, 0x71, 0xa8, 0xc3, 0xd6, 0xb0, 0xaa, 0xae, 0xfc, 0x85, 0x56, 0x14, 0x4a,
-	0x4a, 0x7c, 0x29, 0x31, 0x30, 0x0f, 0xa0, 0x2a, 0xfb, 0x18, 0x32, 0xb3, 0x8c, 0xbe, 0x02, 0xd5,
-	0x48, 0xca, 0xc9, 0xcd, 0xc1, 0x4f, 0x4b, 0x39, 0xd7, 0x8a, 0x99, 0xe6, 0x5b, 0x50, 0xb3, 0x30,
-	0x09, 0x03, 0x9f, 0x60, 0x82, 0x5e, 0x83, 0x5a, 0xa4, 0x06, 0xf2, 0xd0, 0x5a, 0x10, 0xd3, 0x04,
-	0xd1, 0x4a, 0xd8, 0xe6, 0xe7, 0x1a, 0x54, 0x54, 0x4b, 0x95, 0x06, 0x96, 0x96, 0x05, 0xd6, 0x3a,
-	0x14, 0xc3, 0x21, 0x95, 0x50, 0x6f, 0x30, 0x65, 0x87, 0x43, 0xaa, 0xcc, 0x60, 0x2c, 0x26, 0xd1,
-	0xc3, 0x54, 0x1e, 0xb6, 0x5c, 0xe2, 0x3d, 0x9c, 0x48, 0xf4, 0x30, 0x45, 0x0f, 0xa1, 0xce, 0x0e,
-	0xa1, 0xe3, 0x91, 0x1d, 0x46, 0xf8, 0xc4, 0xfb, 0x94, 0x87, 0x44, 0xdf, 0xbc, 0x22, 0x65, 0xb7,
-	0x47, 0x87, 0x9c, 0xac, 0xe6, 0xe8, 0xbd, 0x84, 0x86, 0x5e, 0x85, 0x79, 0x09, 0x94, 0x72, 0x52,
-	0x7c, 0x05, 0x42, 0x94, 0xbc, 0x14, 0x40, 0xb7, 0xa1, 0x3c, 0xc0, 0x51, 0x0f, 0x73, 0xc0, 0xea,
-	0x9b, 0x8b, 0x4c, 0x72, 0x9f, 0x11, 0x94, 0xa0, 0x60, 0x9b, 0xff, 0xd4, 0x00, 0x12, 0x27, 0xbe,
-	0x38, 0x22, 0x4c, 0xa8, 0x8b, 0x9e, 0xc3, 0xb5, 0x1d, 0x6a, 0xfb, 0xa2, 0x22, 0x97, 0x2c, 0x5d,
-	0x12, 0xb7, 0xe8, 0x33, 0x82, 0x5e, 0x04, 0xa0, 0xb4, 0x6f, 0x13, 0xdc, 0x0d, 0x7c, 0x57, 0xee,
-	0xca, 0x1a, 0xa5, 0xfd, 0x0e, 0x27, 0xa0, 0x87, 0xb0, 0x18, 0x84, 0xb6, 0xe3, 0xbb, 0x76, 0x82,
-	0xad, 0xf2, 0x34, 0x6c, 0xd5, 0x83, 0xf4, 0x30, 0x01, 0xd8, 0x7c, 0x1a, 0x60, 0xbf, 0xd7, 0x60,
-	0x21, 0xed, 0xf4, 0x57, 0xeb, 0x5e, 0x9e, 0xfd, 0xa5, 0xcb, 0xda, 0x5f, 0x4e, 0xdb, 0xff, 0x16,
-	0xd4, 0xbf, 0x1b, 0x79, 0x2c, 0xb9, 0x02, 0xa8, 0xec, 0xdc, 0x08, 0x4e, 0xb9, 0xf9, 0x55, 0xab,
-	0x10, 0x9c, 0xa2, 0x2b, 0x71, 0x5d, 0x12, 0x47, 0xa3, 0x1c, 0x99, 0x7d, 0xa8, 0x67, 0x60, 0xf1,
-	0x95, 0x3a, 0x6e, 0xee, 0x02, 0x24, 0x28, 0xff, 0xc2, 0x4b, 0x99, 0x2e, 0xe8, 0x5c, 0xcd, 0xe5,
-	0x7c, 0x45, 0xf7, 0xa0, 0x76, 0x8a, 0x47, 0xb6, 0x08, 0x5f, 0x31, 0x41, 0x7b, 0xba, 0xd2, 0xf1,
-	0x62, 0xc2, 0x7f, 0x99, 0x27, 0x80, 0x26, 0xb7, 0x19, 0x53, 0x2e, 0xb7, 0xa3, 0xb0, 0x5b, 0x8e,
-	0x58, 0x5e, 0xfa, 0xde, 0xc0, 0xa3, 0xf2, 0xf8, 0x11, 0x03, 0x16, 0x94, 0xbe, 0x43, 0xa8, 0x4d,
-	0x30, 0xf6, 0x6d, 0xe6, 0x6c, 0x91, 0x4f, 0xd2, 0x19, 0xb1, 0x83, 0xb1, 0xff, 0x04, 0x8f, 0x4c,
-	0x1f, 0x96, 0x33, 0xeb, 0x5c, 0xd2, 0xab, 0xd7, 0x01, 0x62, 0xaf, 0x54, 0xff, 0x38, 0xe9, 0x56,
-	0x4d, 0xb9, 0x45, 0xcc, 0x9f, 0x6b, 0xac, 0x9a, 0xca, 0x55, 0x5e, 0x81, 0xf2, 0x19, 0x03, 0x4e,
-	0xba, 0x49, 0xcb, 0x20, 0xc9, 0x12, 0x7c, 0x74, 0x53, 0xd4, 0x2b, 0x51, 0xd1, 0x9a, 0x71, 0xbd,
-	0x92, 0x42, 0xbc, 0x60, 0xbd, 0x3d, 0x5e, 0xb0, 0x44, 0x8c, 0xaf, 0x4e, 0x14, 0x2c, 0x39, 0x29,
-	0x5d, 0xb1, 0xcc, 0x6f, 0x80, 0x6e, 0x39, 0x67, 0x4f, 0xa4, 0x95, 0x39, 0xd8, 0x58, 0x49, 0xf7,
-	0x0f, 0x31, 0xf0, 0x7f, 0xa3, 0x41, 0xf5, 0x69, 0xd0, 0x13, 0x4d, 0xc7, 0x04, 0x04, 0xb5, 0xc9,
-	0xbd, 0x77, 0x7e, 0x65, 0x4e, 0x6a, 0x67, 0xf1, 0xc2, 0xb5, 0xb3, 0x34, 0xbb, 0x76, 0x76, 0xa0,
-	0xd1, 0x0e, 0xc2, 0xd1, 0x4e, 0xe0, 0xf3, 0x4b, 0x6c, 0x8f, 0x6f, 0x63, 0x7e, 0x56, 0x70, 0x13,
-	0xcb, 0x96, 0x18, 0xa0, 0x3b, 0x80, 0xba, 0x41, 0x38, 0xb2, 0x09, 0x75, 0x22, 0x6a, 0x53, 0x6f,
-	0x80, 0x99, 0x17, 0xcc, 0xd6, 0xa2, 0xd5, 0x64, 0x9c, 0x0e, 0x63, 0x1c, 0x79, 0x03, 0xfc, 0x8c,
-	0x98, 0xff, 0xd1, 0x60, 0x65, 0x3b, 0x08, 0x28, 0xa1, 0x91, 0x13, 0x32, 0xf5, 0x0a, 0xa2, 0xb3,
-	0x4e, 0xc8, 0xf4, 0x99, 0x55, 0x98, 0xdd, 0x0c, 0xe5, 0x74, 0x85, 0xb7, 0xa1, 0x29, 0xaf, 0x46,
-	0xb1, 0x12, 0x51, 0x9c, 0xeb, 0x82, 0xdc, 0x91, 0xaa, 0xa6, 0x5c, 0xa1, 0xca, 0xd3, 0xae, 0x50,
-	0x57, 0x60, 0x3e, 0x88, 0xbc, 0x9e, 0xe7, 0xf3, 0xaa, 0x5c, 0xb3, 0xe4, 0x28, 0xd9, 0x54, 0x15,
-	0x9e, 0x48, 0x31, 0x30, 0xff, 0xad, 0xc1, 0xea, 0x98, 0xe3, 0x12, 0xcd, 0xad, 0xcc, 0x5e, 0x48,
-	0xdd, 0x3f, 0x53, 0xd0, 0x4a, 0x6d, 0x05, 0xf4, 0x3d, 0x40, 0xc7, 0x9e, 0xdf, 0x0f, 0x7a, 0x47,
-	0x8e, 0xd7, 0x3f, 0x8c, 0x82, 0x1e, 0xbf, 0x02, 0x08, 0x6c, 0xdc, 0x65, 0xf3, 0x72, 0x97, 0x69,
-	0x6d, 0x4f, 0xcc, 0xb1, 0x72, 0xf4, 0x18, 0x8f, 0x00, 0x4d, 0x4a, 0xb2, 0xbb, 0x08, 0xc1, 0xbd,
-	0x01, 0xf6, 0x69, 0xdc, 0x34, 0x88, 0x21, 0x8f, 0xc2, 0xc9, 0x09, 0x91, 0xbb, 0xac, 0x64, 0xc9,
-	0x91, 0xf9, 0x59, 0x01, 0x96, 0x0e, 0x87, 0xfd, 0xbe, 0xbc, 0xb2, 0x7f, 0xb9, 0x2c, 0xa7, 0x96,
-	0x2f, 0x4e, 0x5b, 0xbe, 0x94, 0x5e, 0x3e, 0x49, 0x42, 0x39, 0x5d, 0xd9, 0x72, 0xa0, 0x30, 0x7f,
-	0x09, 0x28, 0x54, 0xce, 0x87, 0x42, 0x35, 0x0d, 0x05, 0xf3, 0x57, 0x1a, 0xa0, 0x74, 0x10, 0x64,
-	0xc6, 0x6f, 0xc2, 0x82, 0x8f, 0x3f, 0x65, 0x05, 0x36, 0x1d, 0x52, 0x9d, 0xd1, 0x3a, 0xd2, 0xaf,
-	0x1b, 0xc0, 0x87, 0x76, 0x26, 0xb6, 0xc0, 0x48, 0x07, 0xc2, 0xc1, 0xdb, 0x50, 0xc1, 0x3e, 0x8d,
-	0xbc, 0xb8, 0x7c, 0x2e, 0x88, 0x1b, 0x95, 0xa8, 0x2a, 0x96, 0x62, 0xa2, 0x97, 0x40, 0x0f, 0x86,
-	0x4c, 0x8f, 0x4d, 0x46, 0x7e, 0x57, 0xbe, 0x3b, 0xd4, 0x82, 0x21, 0x3d, 0x38, 0xe9, 0x8c, 0xfc,
-	0xae, 0xf9, 0x04, 0x50, 0xfb, 0x39, 0xee, 0x9e, 0x8a, 0xa4, 0x7f, 0xb9, 0x3c, 0x99, 0x9f, 0x69,
-	0xb0, 0x9c, 0xd1, 0x26, 0x1d, 0x9e, 0xd1, 0x74, 0xbe, 0x0a, 0x8b, 0xd8, 0x89, 0xfa, 0x1e, 0x26,
-	0x49, 0x3c, 0x84, 0xd6, 0xa6, 0xa2, 0xab, 0x98, 0xdc, 0x82, 0x46, 0xdf, 0xa1, 0x69, 0x41, 0x01,
-	0x86, 0xba, 0xa0, 0x4a, 0x31, 0xf3, 0x67, 0x45, 0x68, 0xee, 0x60, 0xd2, 0x8d, 0xbc, 0xe3, 0x18,
-	0x77, 0x07, 0xb0, 0xe4, 0x62, 0xd2, 0x15, 0xad, 0x4b, 0x17, 0xfb, 0x14, 0x47, 0x44, 0x9e, 0x1e,
-	0x2f, 0x8b, 0x4a, 0x99, 0x91, 0xe7, 0x63, 0xd6, 0xbd, 0xb4, 0x85, 0xa8, 0xd5, 0x74, 0xb3, 0x04,
-	0xf4, 0x18, 0x1a, 0x5c, 0xa1, 0x8a, 0x8a, 0xda, 0x80, 0x37, 0xa7, 0x69, 0x7b, 0xa2, 0x04, 0xad,
-	0xba, 0x9b, 0x1e, 0xa2, 0x6d, 0x58, 0xe0, 0x9a, 0xd4, 0x83, 0x99, 0xa8, 0xdf, 0x37, 0xa6, 0xe9,
-	0x51, 0x8f, 0x68, 0xba, 0x9b, 0x0c, 0x52, 0x3a, 0x3c, 0xec, 0x53, 0x22, 0x2b, 0xfb, 0x0c, 0x1d,
-	0x5c, 0x4c, 0xe9, 0xe0, 0x03, 0x63, 0x49, 0x44, 0x2d, 0xe5, 0xa4, 0xd1, 0x64, 0x7d, 0x56, 0xca,
-	0x56, 0xe3, 0x55, 0xd0, 0x53, 0x36, 0xcc, 0x42, 0x89, 0x51, 0x57, 0xa2, 0x5c, 0xbb, 0xf9, 0xcb,
-	0x79, 0x58, 0x4c, 0x4c, 0x91, 0xb0, 0xd8, 0x87, 0xc5, 0xf1, 0xac, 0xe4, 0x27, 0x45, 0x96, 0xb0,
-	0xac, 0x7d, 0x56, 0x23, 0x9b, 0x14, 0xb4, 0x37, 0x25, 0x27, 0xe6, 0x54, 0x65, 0x53, 0x93, 0xd2,
-	0xce, 0x4d, 0xca, 0xfa, 0x54, 0x45, 0xb9, 0x59, 0xe1, 0x67, 0x13, 0x7f, 0xde, 0x15, 0xaf, 0xd3,
-	0xf1, 0x45, 0x9d, 0xd1, 0xf8, 0xf3, 0xb4, 0xf1, 0x3b, 0x0d, 0x1a, 0x59, 0xaf, 0xd0, 0x01, 0xe8,
-	0x93, 0xf1, 0x68, 0x5d, 0x20, 0x1e, 0xad, 0x54, 0x68, 0xc0, 0x8d, 0x7f, 0x1b, 0x8f, 0x01, 0x52,
-	0xea, 0x1f, 0x42, 0x33, 0xfb, 0xd2, 0xa5, 0x2e, 0x9d, 0x39, 0x4f, 0x5d, 0x8d, 0xcc, 0x53, 0x17,
-	0x31, 0xfe, 0xa6, 0x8d, 0x01, 0x02, 0xed, 0xf1, 0xee, 0x54, 0x46, 0x5b, 0x1c, 0x5d, 0x77, 0xce,
-	0x8f, 0x76, 0x4b, 0xfd, 0xb2, 0x92, 0xd9, 0x46, 0x04, 0x55, 0x45, 0x3e, 0xef, 0xba, 0x2c, 0xb3,
-	0x92, 0xb9, 0x2e, 0xab, 0x0c, 0xc4, 0xcc, 0x89, 0xf0, 0x17, 0x27, 0xc3, 0xff, 0x63, 0x2d, 0x0b,
-	0xe8, 0x0b, 0xbe, 0x5b, 0xb7, 0x64, 0xfd, 0x56, 0xb2, 0x85, 0x49, 0x59, 0x5e, 0xbd, 0xa7, 0x01,
-	0x61, 0xd2, 0x12, 0xf3, 0x8f, 0x1a, 0xac, 0xb4, 0x23, 0xec, 0x50, 0xac, 0x34, 0xe4, 0x54, 0xe2,
-	0xc2, 0xe4, 0xa3, 0xf2, 0xff, 0xf6, 0x49, 0x8c, 0xf5, 0x72, 0x34, 0xa0, 0x4e, 0xdf, 0xce, 0x3c,
-	0x13, 0x8a, 0x33, 0xb4, 0xc9, 0x39, 0x3b, 0xc9, 0x5b, 0xa1, 0x7a, 0x61, 0x9c, 0x4f, 0x5e, 0x18,
-	0xcd, 0x23, 0x58, 0x1d, 0x73, 0x43, 0xee, 0xf5, 0x15, 0x28, 0xe3, 0x28, 0x0a, 0x22, 0x99, 0x4f,
-	0x31, 0x48, 0x07, 0xbc, 0x30, 0x3d, 0xe0, 0xe6, 0x26, 0xac, 0x88, 0x5e, 0xf6, 0xe2, 0xc1, 0x31,
-	0xef, 0xc1, 0xea, 0xd8, 0x9c, 0x59, 0x96, 0x98, 0x6f, 0xc0, 0x6a, 0x3b, 0x18, 0x84, 0x4e, 0x97,
-	0x5e, 0x62, 0x8d, 0x16, 0x5c, 0x19, 0x9f, 0x34, 0x73, 0x91, 0xef, 0x03, 0xb2, 0x70, 0xd8, 0x77,
-	0xba, 0x98, 0x3f, 0x90, 0x5f, 0x20, 0xc5, 0x57, 0xa1, 0xe2, 0x07, 0x2e, 0x4e, 0x9e, 0x01, 0xe7,
-	0xd9, 0x70, 0xcf, 0x15, 0x0d, 0xc2, 0xd9, 0xd8, 0x0b, 0x30, 0xf8, 0xf8, 0x4c, 0xbe, 0xff, 0x9a,
-	0x77, 0x60, 0x39, 0xb3, 0xd6, 0x4c, 0xc3, 0xfe, 0xa2, 0x01, 0x12, 0x79, 0xe3, 0x2d, 0xd0, 0x45,
-	0xda, 0x80, 0x99, 0xcf, 0x97, 0x5f, 0x09, 0x32, 0x45, 0x0b, 0x91, 0x87, 0x4c, 0xce, 0x49, 0x90,
-	0xc9, 0x7c, 0xcf, 0x78, 0x73, 0x5e, 0xe6, 0x05, 0x50, 0xe2, 0xaa, 0x74, 0xbe, 0xf7, 0x2c, 0xf3,
-	0xe3, 0x93, 0x66, 0x2e, 0xf2, 0x66, 0x8c, 0x94, 0xcb, 0xac, 0xf2, 0x3a, 0x5c, 0x9d, 0x98, 0x35,
-	0x73, 0x99, 0xdf, 0x6a, 0x70, 0xdd, 0x92, 0xb1, 0xe3, 0x79, 0x3f, 0x8c, 0x70, 0xe8, 0x44, 0xf8,
-	0xeb, 0x97, 0x50, 0xf3, 0x4d, 0x78, 0x21, 0xdf, 0xd2, 0x99, 0x0e, 0x3e, 0x00, 0x23, 0x33, 0xab,
-	0x1d, 0x0c, 0x06, 0x1e, 0xbd, 0x48, 0x2c, 0xdf, 0x18, 0x8b, 0x8c, 0x9a, 0x39, 0x73, 0xb9, 0x6f,
-	0x8d, 0x4f, 0xea, 0x63, 0xc7, 0x1f, 0x86, 0x17, 0x59, 0x6f, 0xdc, 0xbf, 0x78, 0xea, 0xcc, 0x05,
-	0xff, 0xae, 0xc1, 0x9a, 0xf8, 0x13, 0xf0, 0xeb, 0xbd, 0x1d, 0x2f, 0x79, 0x59, 0x36, 0xff, 0x1f,
-	0xae, 0xe5, 0xb8, 0x35, 0x33, 0x14, 0x0e, 0x2b, 0x60, 0x7c, 0xca, 0x45, 0x73, 0x7c, 0xd9, 0x7f,
-	0x41, 0xcd, 0xbb, 0xb0, 0x92, 0x5d, 0x62, 0xa6, 0x41, 0xc7, 0xb1, 0xf4, 0x85, 0x51, 0x70, 0x69,
-	0x8b, 0xee, 0xc1, 0xea, 0xd8, 0x1a, 0x33, 0x4d, 0xfa, 0x18, 0xea, 0xf2, 0x0f, 0xee, 0x0b, 0x9c,
-	0x25, 0x53, 0x6c, 0x29, 0x4e, 0xb3, 0xe5, 0x36, 0x34, 0x94, 0xf2, 0x59, 0x46, 0xbc, 0xb6, 0x07,
-	0xf5, 0xcc, 0xeb, 0x2f, 0xaa, 0x41, 0x79, 0xfb, 0xa3, 0xa3, 0xdd, 0xce, 0xe2, 0x1c, 0xd2, 0xa1,
-	0xf2, 0xe8, 0xe9, 0xc1, 0xd6, 0xd1, 0x37, 0xdf, 0x5c, 0xd4, 0x50, 0x13, 0xf4, 0xfd, 0xad, 0x0f,
-	0x6d, 0x45, 0x28, 0x70, 0xc2, 0xde, 0xb3, 0x98, 0x50, 0xdc, 0xfc, 0x43, 0x09, 0xf4, 0x0f, 0x1c,
-	0x42, 0x83, 0x7d, 0x87, 0x77, 0x4e, 0x6f, 0x33, 0xff, 0x7a, 0x1e, 0x37, 0x89, 0xf5, 0xa2, 0x08,
-	0xc5, 0x5d, 0x6a, 0xfc, 0xe1, 0x83, 0xb1, 0x18, 0xd3, 0xd4, 0xc7, 0x16, 0x73, 0x1b, 0xda, 0x7d,
-	0x0d, 0x7d, 0x9b, 0xd9, 0x2f, 0x26, 0x8b, 0x6b, 0x08, 0x5a, 0xce, 0xf9, 0x6e, 0xc2, 0x58, 0x9a,
-	0xf8, 0x68, 0x40, 0xce, 0x7f, 0x0b, 0xaa, 0xaa, 0x8f, 0x15, 0x33, 0xc7, 0xee, 0x52, 0xc6, 0x4a,
-	0x5e, 0xab, 0x6b, 0xce, 0xa1, 0x47, 0x50, 0xcf, 0x34, 0x41, 0x48, 0x7c, 0x97, 0x90, 0xd3, 0xde,
-	0x19, 0xd7, 0x72, 0x38, 0x69, 0x3d, 0x99, 0x16, 0x46, 0xe8, 0xc9, 0xeb, 0x84, 0x84, 0x9e, 0xdc,
-	0x7e, 0xc7, 0x9c, 0x63, 0x17, 0xa3, 0x6c, 0x9b, 0x82, 0xc4, 0xb2, 0x79, 0xfd, 0x8e, 0x61, 0xe4,
-	0xb1, 0x62, 0x55, 0x0f, 0x14, 0xe0, 0x94, 0xa6, 0x25, 0xf9, 0xa7, 0x53, 0x82, 0x41, 0x03, 0xa5,
-	0x49, 0xf1, 0xcc, 0x77, 0x41, 0x4f, 0xf5, 0x23, 0xe8, 0x8a, 0x10, 0x1a, 0x6f, 0x86, 0x8c, 0xab,
-	0x13, 0xf4, 0x58, 0xc3, 0x2d, 0xd6, 0xac, 0x1f, 0x0f, 0x7b, 0x12, 0x1b, 0x35, 0x26, 0xc9, 0xff,
-	0xfa, 0x33, 0x92, 0x9f, 0xe6, 0xdc, 0xe6, 0x9f, 0x2b, 0x00, 0x1c, 0x43, 0x02, 0x31, 0x8f, 0xa1,
-	0x9e, 0x79, 0x10, 0x13, 0x41, 0xcc, 0x7b, 0x83, 0x14, 0x41, 0xcc, 0x7d, 0x3d, 0x33, 0xe7, 0xee,
-	0x6b, 0xe8, 0x1d, 0x80, 0x23, 0xc7, 0xeb, 0x8b, 0xb7, 0x0d, 0xb4, 0x2a, 0x9e, 0x61, 0xc7, 0x5e,
-	0xb8, 0x8c, 0x2b, 0xe3, 0xe4, 0x94, 0x82, 0x77, 0x41, 0x4f, 0xbd, 0x8e, 0x88, 0x10, 0x4c, 0x3e,
-	0xbe, 0x88, 0x10, 0xe4, 0x3c, 0xa3, 0x88, 0x20, 0xa6, 0x0a, 0xa8, 0xd4, 0x30, 0x71, 0x50, 0x48,
-	0x0d, 0x93, 0x95, 0x56, 0x60, 0x21, 0xdb, 0xb8, 0xa0, 0x14, 0x74, 0xc6, 0x7a, 0x13, 0x81, 0x85,
-	0xfc, 0x3e, 0xc7, 0x9c, 0x43, 0x4f, 0xa1, 0x39, 0xd6, 0x9d, 0xa0, 0x34, 0x78, 0xc6, 0x95, 0x5d,
-	0xcf, 0xe5, 0xc5, 0xda, 0x3e, 0x66, 0xd5, 0x75, 0xb2, 0x1f, 0x40, 0x37, 0x14, 0x20, 0xa6, 0xf4,
-	0x34, 0xc6, 0xfa, 0x74, 0x81, 0x58, 0xf9, 0x87, 0xa2, 0x19, 0x1e, 0x3b, 0xfc, 0xd1, 0x4b, 0x13,
-	0x53, 0x33, 0x67, 0x8d, 0x71, 0x63, 0x2a, 0x7f, 0xaa, 0xd9, 0xb2, 0x6e, 0xe7, 0x98, 0x9d, 0x3d,
-	0x35, 0x72, 0xcc, 0x1e, 0x2b, 0xf9, 0xe6, 0x1c, 0x7a, 0xa6, 0x76, 0x9b, 0x0a, 0xc6, 0x0b, 0xc9,
-	0xd6, 0xca, 0x49, 0xfb, 0x8b, 0x53, 0xb8, 0xb1, 0xbe, 0x36, 0x2c, 0xa4, 0xcf, 0x3b, 0x74, 0x35,
-	0x35, 0x21, 0xe3, 0xf8, 0xda, 0x24, 0x23, 0x5d, 0x95, 0x32, 0x47, 0x14, 0x4a, 0x0b, 0x67, 0x7d,
-	0xbc, 0x96, 0xc3, 0x89, 0xf5, 0xfc, 0x1f, 0x00, 0xdf, 0xce, 0x62, 0x9b, 0x4e, 0xd9, 0xcd, 0xdb,
-	0x2f, 0x42, 0xd5, 0x0b, 0x5a, 0xfc, 0x53, 0xc1, 0x6d, 0xb1, 0xad, 0x0f, 0xa3, 0x80, 0x06, 0x87,
-	0xda, 0xaf, 0x0b, 0x85, 0x0f, 0x3a, 0xc7, 0xf3, 0xfc, 0xf3, 0xc1, 0x37, 0xfe, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xad, 0xac, 0x3e, 0xc9, 0x4d, 0x28, 0x00, 0x00,
+	// 4641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x8f, 0x24, 0x47,
+	0x56, 0x93, 0xf5, 0x5d, 0xaf, 0x3e, 0x3b, 0xba, 0x67, 0xa6, 0x26, 0xc7, 0xf6, 0xf4, 0xa4, 0x3d,
+	0xb3, 0x63, 0x7b, 0xa6, 0x6d, 0xda, 0xc6, 0x6b, 0xcf, 0x6a, 0xb1, 0xbb, 0xab, 0x7b, 0x3c, 0x8d,
+	0xfb, 0x8b, 0xac, 0xb6, 0x77, 0xed, 0x45, 0x4a, 0xb2, 0xab, 0xa2, 0xab, 0x93, 0xce, 0xca, 0xac,
+	0xcd, 0x88, 0x9a, 0x71, 0x73, 0x42, 0x7b, 0x80, 0x13, 0x42, 0x7c, 0x1c, 0x10, 0x20, 0x90, 0x10,
+	0x07, 0x56, 0x08, 0x09, 0x21, 0x0e, 0x9c, 0x39, 0x22, 0x81, 0x38, 0x22, 0x84, 0x10, 0xfb, 0x03,
+	0xb8, 0x72, 0x05, 0xc5, 0x57, 0x66, 0x64, 0x55, 0x56, 0x4d, 0xb5, 0xcd, 0x48, 0x7b, 0xaa, 0x8c,
+	0xf7, 0x5e, 0xbc, 0x88, 0x78, 0xf1, 0xbe, 0xe2, 0x45, 0x14, 0xd4, 0x9e, 0xb9, 0x84, 0x86, 0x1b,
+	0xe3, 0x28, 0xa4, 0x21, 0xca, 0x8d, 0x4f, 0x2d, 0x1b, 0x9a, 0xdb, 0xae, 0xef, 0x06, 0x7d, 0x6c,
+	0xe3, 0x1f, 0x4f, 0x30, 0xa1, 0xe8, 0x0e, 0xd4, 0x08, 0x0d, 0x23, 0xec, 0x0c, 0xa3, 0x70, 0x32,
+	0xee, 0xe4, 0xd6, 0x8d, 0x07, 0x55, 0x1b, 0x38, 0xe8, 0x53, 0x06, 0x49, 0x08, 0xfa, 0xe1, 0x24,
+	0xa0, 0x9d, 0xfc, 0xba, 0xf1, 0xa0, 0x21, 0x09, 0xba, 0x0c, 0x62, 0xfd, 0x7d, 0x0e, 0x9a, 0x3d,
+	0xd6, 0x7c, 0x8a, 0xdd, 0x88, 0x9e, 0x62, 0x97, 0xa2, 0x0f, 0xa1, 0x29, 0xfa, 0x44, 0x98, 0x84,
+	0x93, 0xa8, 0x8f, 0x3b, 0xc6, 0xba, 0xf1, 0xa0, 0xb6, 0xb9, 0xb2, 0x31, 0x3e, 0xdd, 0xe0, 0xb4,
+	0xb6, 0x44, 0xd8, 0x0d, 0xa2, 0x37, 0xd1, 0xdb, 0x50, 0xed, 0x9d, 0xbb, 0xd1, 0x60, 0x2f, 0x38,
+	0x0b, 0xf9, 0x64, 0x6a, 0x9b, 0x0d, 0xde, 0x49, 0x01, 0xed, 0x04, 0x8f, 0xbe, 0x0f, 0xed, 0x08,
+	0x8f, 0x7d, 0xaf, 0xef, 0x52, 0x2f, 0x0c, 0x1c, 0xdf, 0x1d, 0x92, 0x4e, 0x7e, 0x3d, 0xff, 0xa0,
+	0xb6, 0x89, 0x58, 0x1f, 0x3b, 0xc1, 0xed, 0xbb, 0x43, 0xbb, 0x15, 0xa5, 0xda, 0x04, 0x3d, 0x86,
+	0xd6, 0x05, 0xbe, 0x24, 0x63, 0xb7, 0x8f, 0x9d, 0x1f, 0x4f, 0x42, 0xea, 0x92, 0x4e, 0x61, 0x3d,
+	0xaf, 0xa6, 0xf9, 0x99, 0x44, 0xfd, 0x0a, 0xc3, 0xd8, 0xcd, 0x0b, 0xbd, 0x49, 0xd0, 0x27, 0xb0,
+	0x12, 0xf7, 0x65, 0x2b, 0x70, 0x87, 0x98, 0x74, 0x8a, 0xbc, 0xf7, 0xaa, 0xde, 0xbb, 0x27, 0x70,
+	0x76, 0xfb, 0x22, 0x0d, 0x20, 0xd6, 0xff, 0x1a, 0xd0, 0x4c, 0xcf, 0x10, 0x99, 0x50, 0x51, 0x64,
+	0x5c, 0x60, 0x55, 0x3b, 0x6e, 0xa3, 0xdb, 0x50, 0x25, 0x38, 0x7a, 0x86, 0x23, 0xc7, 0x1b, 0x70,
+	0xc1, 0x34, 0xec, 0x8a, 0x00, 0xec, 0x0d, 0xd0, 0x2d, 0xa8, 0x10, 0x26, 0x15, 0x86, 0x13, 0x1b,
+	0x54, 0xe6, 0xed, 0xbd, 0x01, 0x7a, 0x00, 0x6d, 0x21, 0x5a, 0x27, 0xe9, 0x5e, 0xe0, 0x24, 0x4d,
+	0x01, 0xef, 0x29, 0x26, 0xf7, 0xa1, 0xa5, 0x28, 0x15, 0xaf, 0x22, 0x27, 0x6c, 0x48, 0x42, 0xc9,
+	0xb1, 0x03, 0x65, 0x1c, 0xd0, 0xc8, 0xc3, 0xa4, 0x53, 0x5a, 0x37, 0x1e, 0x14, 0x6c, 0xd5, 0x44,
+	0x6b, 0x50, 0x3c, 0xbd, 0xa4, 0x98, 0x74, 0xca, 0x1c, 0x2e, 0x1a, 0xe8, 0x26, 0x94, 0xa9, 0x37,
+	0xc2, 0x4e, 0x40, 0x3a, 0x15, 0x0e, 0x2f, 0xb1, 0xe6, 0x21, 0xb1, 0x7e, 0xc7, 0x80, 0x3a, 0x57,
+	0x86, 0x03, 0x4c, 0x88, 0x3b, 0xc4, 0x68, 0x17, 0x56, 0xdd, 0x7e, 0x1f, 0x13, 0xe2, 0xf4, 0xc3,
+	0x80, 0x46, 0xa1, 0xef, 0xf8, 0x1e, 0xa1, 0x52, 0x77, 0xae, 0x33, 0xb1, 0x6e, 0x71, 0x74, 0x57,
+	0x60, 0xf7, 0x3d, 0x42, 0xed, 0x15, 0x77, 0x1a, 0x84, 0x3e, 0x80, 0x9b, 0x53, 0x6c, 0x06, 0x1e,
+	0x71, 0x4f, 0x7d, 0x2c, 0x04, 0x57, 0xb1, 0xaf, 0xa7, 0xfa, 0xec, 0x48, 0xa4, 0xf5, 0x6f, 0x06,
+	0xb4, 0xba, 0xbe, 0x87, 0x03, 0x9a, 0x68, 0xf2, 0x1d, 0xa8, 0xf5, 0x39, 0xc8, 0x09, 0xdc, 0x11,
+	0x56, 0xe6, 0x21, 0x40, 0x87, 0xee, 0x08, 0xa3, 0x23, 0x68, 0xf6, 0xfd, 0x09, 0xa1, 0x38, 0x72,
+	0xce, 0x42, 0xdf, 0x0f, 0x9f, 0xf3, 0x0d, 0xa8, 0x6d, 0x3e, 0x60, 0xd3, 0x9d, 0xe2, 0xb6, 0xd1,
+	0x15, 0x94, 0x4f, 0x38, 0xa1, 0x5c, 0xb5, 0xdd, 0xe8, 0xeb, 0x50, 0xb3, 0x07, 0x6b, 0x59, 0x64,
+	0x0b, 0x95, 0xe3, 0x0e, 0xd4, 0x3c, 0xe2, 0x4c, 0x02, 0x39, 0x03, 0xb1, 0x4a, 0xf0, 0xc8, 0xe7,
+	0x12, 0x62, 0xfd, 0x73, 0x1e, 0x1a, 0x62, 0x32, 0x8a, 0xdd, 0x3d, 0x28, 0xcb, 0x71, 0xa5, 0x7c,
+	0x6b, 0x62, 0xc2, 0x1c, 0x64, 0x2b, 0x1c, 0xfa, 0x18, 0xca, 0x93, 0xf1, 0xc0, 0x65, 0x9b, 0x2a,
+	0xac, 0xf1, 0x5e, 0xb2, 0x2e, 0xc9, 0x2a, 0x6d, 0xd0, 0x9f, 0x73, 0x6a, 0x5b, 0xf5, 0x42, 0xef,
+	0x42, 0x29, 0xc2, 0xc4, 0xfb, 0x0d, 0x2c, 0xe5, 0xd2, 0x99, 0xed, 0x6f, 0x73, 0xbc, 0x2d, 0xe9,
+	0xcc, 0x3f, 0x32, 0x60, 0x35, 0x83, 0x25, 0xba, 0x07, 0xc5, 0x20, 0x1c, 0x60, 0xd2, 0x31, 0xb8,
+	0x99, 0xb5, 0xb4, 0xf9, 0x1e, 0x86, 0x03, 0x6c, 0x0b, 0x2c, 0x33, 0x14, 0x8f, 0x38, 0x03, 0xec,
+	0x63, 0x8a, 0xa5, 0x24, 0x2a, 0x1e, 0xd9, 0xe1, 0xed, 0x94, 0x10, 0xf3, 0x53, 0x42, 0xbc, 0x0b,
+	0x75, 0x8f, 0x38, 0xe3, 0x28, 0x1c, 0x85, 0xcc, 0x20, 0xb9, 0x95, 0x54, 0xec, 0x9a, 0x47, 0x8e,
+	0x15, 0xc8, 0xfc, 0x2d, 0x03, 0x4a, 0x62, 0xb6, 0xe8, 0x5d, 0x58, 0xeb, 0x4f, 0xa2, 0x88, 0x69,
+	0x86, 0xda, 0x7f, 0xbe, 0x4a, 0x83, 0x9b, 0x0c, 0x92, 0x38, 0x39, 0xbf, 0x1e, 0xeb, 0xb1, 0x01,
+	0xab, 0xd4, 0x8d, 0x86, 0x78, 0xaa, 0x83, 0xb0, 0xe5, 0x15, 0x81, 0xd2, 0xe9, 0x17, 0xcc, 0xd5,
+	0xfa, 0x99, 0x01, 0x65, 0x49, 0xbb, 0x50, 0x31, 0x62, 0x99, 0xe5, 0x17, 0xca, 0x6c, 0x13, 0xae,
+	0xe3, 0xaf, 0xc7, 0xb8, 0x4f, 0xf1, 0x20, 0x3d, 0x39, 0xe1, 0x29, 0x56, 0x15, 0x52, 0x9f, 0xde,
+	0x3c, 0x01, 0x14, 0xe7, 0x0a, 0xe0, 0x11, 0x20, 0xdd, 0x5d, 0x9f, 0xb9, 0x7d, 0x1a, 0x46, 0xdc,
+	0x87, 0x34, 0xec, 0x15, 0x0d, 0xf3, 0x84, 0x23, 0xac, 0x09, 0xd4, 0xb4, 0xa9, 0x7e, 0x8b, 0x98,
+	0xf2, 0x10, 0x40, 0x7a, 0xb4, 0xf9, 0x41, 0x85, 0xa8, 0x4f, 0xeb, 0x9f, 0x0c, 0x68, 0xa4, 0xd8,
+	0x31, 0x87, 0x17, 0x60, 0xfa, 0x3c, 0x8c, 0x2e, 0xa4, 0xfd, 0xab, 0x26, 0xc3, 0xb8, 0x83, 0x41,
+	0x84, 0x09, 0x91, 0x3b, 0xa4, 0x9a, 0xe8, 0x75, 0x68, 0xb8, 0x83, 0x91, 0x17, 0x38, 0x0a, 0x5f,
+	0xe0, 0xf8, 0x3a, 0x07, 0x6e, 0x49, 0x22, 0x04, 0x05, 0xca, 0x62, 0x56, 0x79, 0x3d, 0xff, 0xa0,
+	0x6a, 0xf3, 0x6f, 0xb4, 0x0e, 0xf5, 0x81, 0x47, 0x2e, 0xb8, 0x2c, 0x9d, 0xe1, 0x29, 0x77, 0x99,
+	0x0d, 0x1b, 0x18, 0x8c, 0x09, 0xf1, 0xd3, 0x53, 0xf4, 0x16, 0xac, 0xb8, 0xbe, 0x1f, 0xf6, 0x5d,
+	0xb6, 0x5b, 0x8a, 0xac, 0xca, 0xc9, 0x5a, 0x31, 0x42, 0xd0, 0x5a, 0x3f, 0xcb, 0xc1, 0xda, 0x7e,
+	0xd8, 0x77, 0x7d, 0xbe, 0x54, 0xb2, 0x17, 0x28, 0xa5, 0x69, 0x42, 0xce, 0x1b, 0x48, 0x65, 0xcd,
+	0x79, 0x03, 0xd4, 0x05, 0x21, 0x02, 0x67, 0xe4, 0xb2, 0x24, 0x80, 0x29, 0xcb, 0x7d, 0x26, 0xa2,
+	0xac, 0xce, 0x42, 0x6e, 0x07, 0xee, 0x78, 0x37, 0xa0, 0xd1, 0xa5, 0x2d, 0x42, 0xcf, 0x81, 0x3b,
+	0x66, 0x16, 0x94, 0x52, 0x05, 0x11, 0x8a, 0x6a, 0xfd, 0x17, 0xea, 0x40, 0x61, 0x8e, 0x0e, 0xa0,
+	0xef, 0x40, 0x91, 0x47, 0xe6, 0x4e, 0x31, 0xd9, 0xeb, 0x74, 0x60, 0x16, 0x78, 0xf4, 0x08, 0xca,
+	0x32, 0x0c, 0x73, 0x85, 0x9a, 0x13, 0x85, 0x15, 0x8d, 0xf9, 0xcb, 0xd0, 0x48, 0x2d, 0x02, 0xb5,
+	0x21, 0x7f, 0x81, 0x2f, 0xa5, 0x40, 0xd8, 0x27, 0x7a, 0x1d, 0x8a, 0xcf, 0x5c, 0x7f, 0x82, 0xb3,
+	0x15, 0x46, 0xe0, 0x1e, 0xe7, 0x3e, 0x34, 0xac, 0x0f, 0x60, 0x65, 0x26, 0x2c, 0xa1, 0xbb, 0x50,
+	0x9c, 0x10, 0x1c, 0x29, 0x67, 0x55, 0x13, 0xc1, 0xcb, 0xff, 0x9c, 0xe0, 0xc8, 0x16, 0x18, 0xeb,
+	0x4b, 0x28, 0x4b, 0x08, 0x53, 0x04, 0x1e, 0x5e, 0x84, 0xf9, 0xf2, 0x6f, 0x16, 0x4c, 0x69, 0x78,
+	0x81, 0x03, 0xa9, 0x73, 0xa2, 0x81, 0xde, 0x80, 0xd2, 0x30, 0x72, 0x03, 0xaa, 0x2c, 0xba, 0x2e,
+	0x19, 0x7f, 0xca, 0x80, 0xb6, 0xc4, 0x59, 0x67, 0x50, 0x51, 0xb0, 0x85, 0xee, 0x01, 0x41, 0x21,
+	0xc2, 0xae, 0x0a, 0x8b, 0xfc, 0x9b, 0x8d, 0xfb, 0x3c, 0xf2, 0xa8, 0xd8, 0xbd, 0x8a, 0x2d, 0x1a,
+	0x0c, 0xca, 0x55, 0x57, 0x7a, 0x45, 0xd1, 0xb0, 0xfe, 0xca, 0x80, 0x46, 0x6a, 0x3b, 0x16, 0x8e,
+	0xf6, 0x06, 0x34, 0xc3, 0x31, 0x71, 0xc6, 0x4c, 0x3d, 0x70, 0x3f, 0x0c, 0xc4, 0xb8, 0x05, 0xbb,
+	0x1e, 0x8e, 0xc9, 0x31, 0x8e, 0x7a, 0x1c, 0xc6, 0x12, 0x16, 0x9e, 0x37, 0xe8, 0x74, 0x79, 0x4e,
+	0xd7, 0xe4, 0xf0, 0x84, 0xf2, 0x6d, 0x40, 0x34, 0xa4, 0x2e, 0x0f, 0xef, 0x89, 0xc1, 0x08, 0x5d,
+	0x6a, 0x71, 0xcc, 0x4e, 0x6c, 0x35, 0x16, 0x81, 0xd6, 0x94, 0x36, 0x2c, 0x9c, 0xeb, 0x0d, 0x28,
+	0xe1, 0x60, 0xe8, 0x05, 0x2a, 0xe4, 0xcb, 0x16, 0x7a, 0x08, 0xe5, 0x71, 0x14, 0x9e, 0x79, 0xbe,
+	0x8a, 0x67, 0x48, 0xb9, 0x1f, 0x77, 0x88, 0x8f, 0x05, 0xc6, 0x56, 0x24, 0xd6, 0xdf, 0xe6, 0xa1,
+	0x99, 0xc6, 0x31, 0xc6, 0xe3, 0x08, 0x13, 0x4c, 0xe5, 0x90, 0xb2, 0x85, 0x1e, 0xc1, 0xea, 0xa9,
+	0x1f, 0xf6, 0x2f, 0x9c, 0xbe, 0xdb, 0x3f, 0xc7, 0x62, 0x35, 0xa3, 0x53, 0x19, 0x1d, 0xda, 0x1c,
+	0xd5, 0x65, 0x18, 0xb6, 0x9c, 0x03, 0xee, 0x04, 0x4e, 0xfd, 0x30, 0x1c, 0x39, 0x67, 0x9e, 0xcf,
+	0xec, 0xed, 0xd4, 0xa3, 0x44, 0xda, 0x5b, 0x8b, 0x23, 0x9e, 0x70, 0xf8, 0xb6, 0x47, 0x09, 0xb2,
+	0xe1, 0x7a, 0x3f, 0x1c, 0xb1, 0x71, 0x08, 0xb3, 0x39, 0x26, 0x57, 0x1f, 0x3f, 0xc3, 0x3e, 0xcf,
+	0x76, 0x9b, 0x9b, 0xaf, 0xcd, 0xae, 0x60, 0xa3, 0x9b, 0xd0, 0xdb, 0xab, 0x5a, 0xe7, 0x63, 0x1c,
+	0xed, 0xb3, 0xae, 0xe8, 0x1d, 0x58, 0xe3, 0x8a, 0xe1, 0x9c, 0x4e, 0xce, 0xce, 0x70, 0x14, 0xcf,
+	0x57, 0x78, 0xff, 0x15, 0x8e, 0xdb, 0xe6, 0x28, 0x39, 0x61, 0x0b, 0x1a, 0x13, 0x82, 0x9d, 0x81,
+	0x17, 0xe1, 0x3e, 0x75, 0xbc, 0x90, 0x9b, 0x69, 0xc5, 0xae, 0x4d, 0x08, 0xde, 0xe1, 0xb0, 0xbd,
+	0x90, 0xa5, 0x31, 0x32, 0x53, 0x73, 0x9e, 0xbb, 0x3e, 0xcf, 0x22, 0x2b, 0x36, 0x48, 0xd0, 0x0f,
+	0x5c, 0xdf, 0x72, 0xa1, 0xa6, 0xcd, 0x0c, 0xd5, 0xa0, 0xbc, 0xb3, 0xfb, 0x64, 0xeb, 0xf3, 0xfd,
+	0x93, 0xf6, 0x35, 0x54, 0x81, 0xc2, 0xe1, 0xd1, 0xe1, 0x6e, 0xdb, 0x40, 0x00, 0xa5, 0xde, 0xe1,
+	0xd6, 0xf1, 0xf1, 0x97, 0xed, 0x1c, 0x83, 0x7e, 0xb5, 0xbf, 0xb7, 0xdd, 0xce, 0xa3, 0x32, 0xe4,
+	0xb7, 0xbf, 0xda, 0x6c, 0x17, 0xd8, 0xc7, 0xfe, 0x57, 0xef, 0xb7, 0x8b, 0xa8, 0x0a, 0xc5, 0xfd,
+	0xaf, 0xde, 0x7f, 0xda, 0x6d, 0x97, 0x38, 0x59, 0xef, 0x64, 0xa7, 0x5d, 0xb6, 0x7e, 0x3f, 0xaf,
+	0x9d, 0x40, 0x98, 0x1b, 0x8f, 0xd3, 0x7c, 0xcd, 0x42, 0xeb, 0x0a, 0xc8, 0x53, 0xc0, 0x6f, 0x9a,
+	0x9a, 0x4f, 0xbb, 0xcb, 0xc2, 0xb2, 0xee, 0xb2, 0x38, 0xcf, 0x5d, 0x3e, 0x84, 0x12, 0xa1, 0x2e,
+	0x9d, 0x88, 0xcc, 0xbc, 0xb9, 0xb9, 0x96, 0x72, 0x5a, 0x1b, 0x3d, 0x8e, 0xb3, 0x25, 0x8d, 0x4c,
+	0x78, 0xfa, 0x6e, 0x30, 0xf0, 0x58, 0x82, 0x25, 0xe5, 0x5d, 0xf3, 0x48, 0x57, 0x81, 0x58, 0xce,
+	0xe2, 0x71, 0x4b, 0x1c, 0xb9, 0x01, 0x8b, 0xf4, 0x32, 0xad, 0xaa, 0x70, 0xca, 0x15, 0x8f, 0x1c,
+	0x2b, 0x8c, 0xcc, 0xaf, 0x6e, 0x41, 0xc5, 0x23, 0x0e, 0xa1, 0xae, 0x8f, 0x79, 0x48, 0xaa, 0xd8,
+	0x65, 0x8f, 0xf4, 0x58, 0xd3, 0x7a, 0x0c, 0x25, 0x31, 0x3e, 0x93, 0xfb, 0xee, 0xc1, 0xf1, 0xc9,
+	0x97, 0xed, 0x6b, 0xa8, 0x01, 0xd5, 0xed, 0xa3, 0xa3, 0x93, 0xde, 0x89, 0xbd, 0x75, 0xdc, 0x36,
+	0x18, 0xc6, 0xde, 0xdd, 0xda, 0x61, 0x1b, 0xc7, 0xf7, 0x76, 0x7f, 0xf7, 0x64, 0x77, 0xa7, 0x9d,
+	0xb7, 0xca, 0x50, 0xdc, 0x1d, 0x8d, 0xe9, 0x25, 0x3f, 0x32, 0x7c, 0x86, 0x2f, 0x4f, 0x2e, 0xc7,
+	0xf8, 0x0b, 0xe6, 0x80, 0x75, 0xbf, 0x5d, 0x17, 0x7e, 0xfb, 0x1e, 0x34, 0xc7, 0x6e, 0x44, 0x3d,
+	0x2e, 0xb0, 0x73, 0x97, 0x9c, 0x4b, 0x2f, 0xd3, 0x88, 0xa1, 0x4f, 0x5d, 0x72, 0x8e, 0x36, 0xa0,
+	0x3a, 0x70, 0xa9, 0xeb, 0xd0, 0xcb, 0xb1, 0x30, 0xe5, 0xa6, 0x88, 0x2e, 0x47, 0xe3, 0xad, 0x60,
+	0xb0, 0xe3, 0x52, 0x97, 0x8d, 0x61, 0x57, 0x06, 0xf2, 0x8b, 0x39, 0x40, 0x11, 0x0e, 0x0a, 0x7c,
+	0x28, 0xd1, 0xb0, 0x7e, 0x9a, 0x83, 0x8a, 0x3c, 0x49, 0x93, 0x85, 0xfe, 0xe4, 0x3b, 0x50, 0x89,
+	0x24, 0x5d, 0x27, 0x97, 0x84, 0x04, 0xd9, 0xd7, 0x8e, 0x91, 0xe8, 0x55, 0x00, 0xf9, 0xad, 0x34,
+	0xa6, 0x60, 0x57, 0x25, 0x64, 0x6f, 0xc0, 0xd0, 0xec, 0xf4, 0x14, 0x4e, 0x28, 0x3b, 0x4f, 0x15,
+	0x04, 0x5a, 0x42, 0x0e, 0x09, 0xea, 0x42, 0x83, 0x46, 0x4c, 0x59, 0xd9, 0xc9, 0x07, 0x7f, 0x4d,
+	0xe5, 0x91, 0xf4, 0x35, 0x6d, 0x2c, 0xb2, 0x71, 0xc2, 0x28, 0xba, 0x82, 0x40, 0x84, 0xf0, 0x3a,
+	0xd5, 0x40, 0x49, 0xe4, 0x29, 0x69, 0x91, 0xc7, 0xfc, 0x18, 0x56, 0x66, 0x3a, 0xea, 0xe2, 0xaf,
+	0x0a, 0xf1, 0xaf, 0xe9, 0x61, 0xb3, 0xaa, 0xc7, 0xc9, 0x2f, 0xa0, 0x6a, 0x63, 0x32, 0x0e, 0x03,
+	0x82, 0x09, 0x7a, 0x0b, 0xaa, 0x91, 0x6a, 0x74, 0x8c, 0x24, 0x94, 0x29, 0x0a, 0x3b, 0x41, 0x4f,
+	0x89, 0x24, 0x37, 0x25, 0x12, 0xeb, 0xef, 0x72, 0x50, 0x96, 0x6b, 0x4b, 0x59, 0x9b, 0x91, 0xb6,
+	0xb6, 0x75, 0xc8, 0x8f, 0x27, 0x54, 0x46, 0xf3, 0x26, 0x1b, 0xeb, 0x78, 0x42, 0x95, 0xfc, 0x19,
+	0x8a, 0x51, 0x0c, 0x31, 0xed, 0xe4, 0x13, 0x8a, 0x4f, 0x71, 0x42, 0x31, 0xc4, 0x14, 0x3d, 0x86,
+	0x06, 0xcb, 0xdf, 0x4f, 0x2f, 0x9d, 0x71, 0x84, 0xcf, 0xbc, 0xaf, 0xf9, 0x06, 0xd4, 0x36, 0x6f,
+	0x48, 0xda, 0xed, 0xcb, 0x63, 0x0e, 0x56, 0x7d, 0x6a, 0xc3, 0x04, 0x86, 0xde, 0x84, 0x92, 0xb4,
+	0x1e, 0x2d, 0x97, 0x11, 0x66, 0xa3, 0xe8, 0x25, 0x01, 0xba, 0x0f, 0xc5, 0x11, 0x8e, 0xe2, 0x54,
+	0xa6, 0xcd, 0x28, 0x0f, 0x18, 0x40, 0x11, 0x0a, 0x34, 0xfa, 0x08, 0xea, 0xa2, 0x87, 0x13, 0xb9,
+	0xc1, 0x50, 0x18, 0xb0, 0x9c, 0x8d, 0x64, 0xec, 0x06, 0x49, 0xa7, 0xda, 0x20, 0x81, 0x59, 0xff,
+	0x6e, 0x00, 0x24, 0xeb, 0xff, 0xe6, 0x66, 0xc4, 0xdc, 0x3a, 0x3f, 0x9e, 0x0d, 0x1c, 0x97, 0xab,
+	0xa4, 0xd0, 0xd8, 0x9a, 0x04, 0x6e, 0x31, 0xa5, 0x64, 0x3a, 0x4b, 0x7d, 0x15, 0xcb, 0x85, 0x97,
+	0xab, 0x52, 0xea, 0xcb, 0x30, 0xfe, 0x18, 0xda, 0xe1, 0xd8, 0x71, 0x83, 0x81, 0x93, 0x18, 0x64,
+	0x71, 0x9e, 0x41, 0x36, 0x42, 0xbd, 0x99, 0x68, 0x5b, 0x49, 0xb7, 0xca, 0xff, 0x32, 0xa0, 0xae,
+	0xcb, 0xeb, 0xe5, 0x2e, 0x2f, 0x6b, 0xfe, 0x85, 0xab, 0xce, 0xbf, 0xa8, 0xcd, 0x9f, 0x39, 0xe6,
+	0x08, 0xd3, 0x49, 0x14, 0x38, 0xc9, 0xe2, 0x2a, 0x76, 0x4d, 0xc0, 0xb8, 0xdf, 0xb3, 0xc6, 0xd0,
+	0xf8, 0x01, 0x8b, 0xb1, 0xca, 0x5e, 0x58, 0x42, 0x1f, 0x5e, 0xf0, 0x15, 0x56, 0xec, 0x5c, 0x78,
+	0xc1, 0xf2, 0x0c, 0x19, 0x0a, 0x64, 0x02, 0x93, 0x72, 0xfa, 0xf4, 0x3c, 0x0a, 0x29, 0x65, 0x15,
+	0x91, 0xbc, 0x72, 0xfa, 0x27, 0x0a, 0x34, 0xc7, 0xd5, 0xf9, 0xd0, 0x48, 0x69, 0xeb, 0x4b, 0x15,
+	0xaa, 0xf5, 0x6b, 0x80, 0x66, 0x55, 0x98, 0xcd, 0x8c, 0x50, 0x37, 0xa2, 0x72, 0x50, 0xd1, 0x60,
+	0x13, 0xc1, 0x32, 0x99, 0xac, 0xdb, 0xec, 0x73, 0xa9, 0x11, 0x76, 0x01, 0x12, 0xf3, 0xfe, 0xc6,
+	0x8b, 0xb1, 0x7e, 0xdb, 0x80, 0x1a, 0xe7, 0x73, 0xc5, 0x7d, 0x78, 0x04, 0xd5, 0x0b, 0x7c, 0x29,
+	0x37, 0x38, 0x9f, 0xd8, 0xb9, 0x1e, 0xdd, 0x78, 0xfc, 0xf8, 0x42, 0xa9, 0x44, 0x6a, 0xdb, 0x0a,
+	0x33, 0xdb, 0x66, 0x9d, 0x01, 0x9a, 0xf5, 0x41, 0x32, 0xdf, 0x64, 0xbe, 0x4a, 0xac, 0x4d, 0xb6,
+	0x98, 0x28, 0x7d, 0x6f, 0xe4, 0x51, 0x99, 0xb0, 0x88, 0x06, 0x13, 0x9c, 0xef, 0x12, 0xea, 0x10,
+	0x8c, 0x03, 0x87, 0x09, 0x24, 0xcf, 0x3b, 0xd5, 0x18, 0xb0, 0x87, 0x71, 0xf0, 0x19, 0xbe, 0xb4,
+	0x7e, 0xcf, 0x80, 0xd5, 0xd4, 0x40, 0x57, 0x5c, 0xf9, 0x3b, 0x00, 0xf1, 0xca, 0xd5, 0x31, 0x66,
+	0x76, 0xe9, 0x55, 0xb5, 0x74, 0xb2, 0xcc, 0xda, 0xff, 0xc0, 0x80, 0x4a, 0x3c, 0x91, 0xef, 0xa8,
+	0x13, 0x8c, 0x56, 0x20, 0x48, 0x19, 0x8b, 0x3a, 0xd4, 0xdc, 0x15, 0x0e, 0x5f, 0x84, 0x84, 0x56,
+	0xec, 0xf0, 0x25, 0x11, 0xc3, 0xa1, 0xef, 0x4d, 0x7b, 0x7c, 0xb1, 0x55, 0x37, 0x67, 0x3c, 0xbe,
+	0xec, 0xa4, 0xbb, 0x7c, 0xeb, 0x17, 0xa1, 0x66, 0xbb, 0xcf, 0x3f, 0x53, 0x7b, 0x38, 0xab, 0x63,
+	0xa9, 0x60, 0x19, 0x5b, 0xda, 0x7f, 0x1a, 0x50, 0xd9, 0x0f, 0x87, 0x22, 0xc2, 0xce, 0xa8, 0xb2,
+	0x31, 0xeb, 0x81, 0x5e, 0x1c, 0xda, 0x92, 0xe0, 0x93, 0x5f, 0x3a, 0xf8, 0x14, 0xae, 0x16, 0x7c,
+	0x8a, 0xcb, 0x07, 0x9f, 0x1e, 0x34, 0xbb, 0xe1, 0xf8, 0x72, 0x27, 0x0c, 0x78, 0xe9, 0x77, 0xc8,
+	0xfd, 0x20, 0x8f, 0xd3, 0x7c, 0x75, 0x45, 0x5b, 0x34, 0xd8, 0x01, 0xaf, 0x1f, 0x8e, 0x2f, 0x1d,
+	0x6e, 0xe6, 0x8e, 0x2a, 0x22, 0xb3, 0x65, 0xe6, 0xed, 0x16, 0xc3, 0xf4, 0x18, 0xe2, 0x44, 0x54,
+	0x93, 0xff, 0xc7, 0x80, 0xb5, 0xed, 0x30, 0xa4, 0x84, 0x46, 0xee, 0x98, 0xb1, 0x57, 0x16, 0xb0,
+	0x28, 0x2d, 0xd3, 0xf3, 0x85, 0xdc, 0xe2, 0xec, 0x3c, 0xa3, 0x98, 0x71, 0x1f, 0x5a, 0xb2, 0xa2,
+	0x17, 0x33, 0x11, 0xd1, 0xad, 0x21, 0xc0, 0xaa, 0x62, 0x3e, 0xa7, 0xf2, 0x57, 0x9c, 0x57, 0xf9,
+	0xbb, 0x01, 0xa5, 0x30, 0xf2, 0x86, 0x9e, 0xca, 0xc0, 0x64, 0x2b, 0xb1, 0x59, 0x59, 0x5f, 0xe7,
+	0x0d, 0xeb, 0xbf, 0x0d, 0xb8, 0x3e, 0xb5, 0x70, 0x69, 0x08, 0x1b, 0x29, 0x4b, 0xd3, 0xca, 0xa6,
+	0x9a, 0x56, 0xea, 0x86, 0xf6, 0xab, 0x80, 0x4e, 0xbd, 0xc0, 0x0f, 0x87, 0x27, 0xae, 0xe7, 0x1f,
+	0x47, 0xe1, 0x90, 0x57, 0xae, 0x84, 0x5a, 0x3d, 0x64, 0xfd, 0x32, 0x87, 0xd9, 0xd8, 0x9e, 0xe9,
+	0x63, 0x67, 0xf0, 0x31, 0x9f, 0x00, 0x9a, 0xa5, 0x64, 0x25, 0x34, 0x82, 0x87, 0x23, 0x1c, 0xd0,
+	0x38, 0x61, 0x13, 0x4d, 0x2e, 0x85, 0xb3, 0x33, 0x22, 0x0d, 0xb4, 0x60, 0xcb, 0x96, 0xf5, 0x93,
+	0x1c, 0xac, 0x1c, 0x4f, 0x7c, 0x5f, 0x56, 0x9a, 0xbf, 0xdd, 0x2e, 0x6b, 0xc3, 0xe7, 0xe7, 0x0d,
+	0x5f, 0xd0, 0x87, 0x4f, 0x36, 0xa1, 0xa8, 0x3b, 0xce, 0x0c, 0x55, 0x28, 0x5d, 0x41, 0x15, 0xca,
+	0x2f, 0x56, 0x85, 0x8a, 0xae, 0x0a, 0xd6, 0xef, 0xe6, 0x00, 0xe9, 0x42, 0x90, 0x3b, 0x7e, 0x17,
+	0xea, 0x01, 0xfe, 0x9a, 0x3a, 0x72, 0x11, 0x52, 0xa4, 0x35, 0x06, 0xeb, 0xc9, 0x75, 0xdd, 0x01,
+	0xde, 0x74, 0x52, 0xb2, 0x05, 0x06, 0x3a, 0x12, 0x0b, 0xbc, 0x9f, 0xdc, 0xef, 0x68, 0x35, 0x26,
+	0xe5, 0x90, 0x92, 0xdb, 0x9e, 0xd7, 0xa0, 0xc6, 0x8e, 0x21, 0xe1, 0x99, 0x43, 0x2e, 0x83, 0xbe,
+	0xf4, 0xca, 0xd5, 0x70, 0x42, 0x8f, 0xce, 0x7a, 0x97, 0x41, 0x9f, 0x0d, 0xe4, 0xbb, 0x43, 0x47,
+	0xf1, 0x2a, 0x8a, 0x81, 0x7c, 0x77, 0xb8, 0x2b, 0x19, 0xdc, 0x86, 0x2a, 0x23, 0x10, 0x57, 0x46,
+	0xe2, 0x2a, 0xa9, 0xe2, 0xbb, 0xc3, 0x6d, 0xd6, 0x66, 0x05, 0x06, 0xdf, 0xa5, 0x98, 0x50, 0x27,
+	0xed, 0xfe, 0x84, 0xea, 0xaf, 0x08, 0xdc, 0xe7, 0x5a, 0x3c, 0xff, 0x0c, 0x50, 0xf7, 0x1c, 0xf7,
+	0x2f, 0x84, 0x8e, 0x7d, 0x3b, 0xb5, 0xb0, 0x7e, 0x62, 0xc0, 0x6a, 0x8a, 0x9b, 0x94, 0xef, 0x82,
+	0xf3, 0xc5, 0x9b, 0xd0, 0xc6, 0x6e, 0xe4, 0x7b, 0x98, 0x24, 0xe2, 0x17, 0x5c, 0x5b, 0x0a, 0xae,
+	0xb6, 0xe0, 0x1e, 0x34, 0xe5, 0xda, 0xd2, 0xba, 0xd7, 0x10, 0x50, 0x49, 0x66, 0xfd, 0x69, 0x01,
+	0x5a, 0x3b, 0x98, 0xf4, 0x23, 0xef, 0x34, 0x56, 0xf3, 0x23, 0x58, 0x19, 0x60, 0xd2, 0x17, 0xa9,
+	0x66, 0x1f, 0x07, 0x54, 0xd4, 0x18, 0x99, 0x85, 0xbe, 0x2e, 0x5c, 0x6f, 0x8a, 0x9e, 0xb7, 0x59,
+	0xb6, 0xd9, 0x15, 0xa4, 0x76, 0x6b, 0x90, 0x06, 0xa0, 0xa7, 0xd0, 0xe4, 0x0c, 0x95, 0x54, 0x94,
+	0xbd, 0xdf, 0x9d, 0xc7, 0x4d, 0x55, 0xd1, 0x88, 0xdd, 0x18, 0xe8, 0x4d, 0xb4, 0xcd, 0x02, 0x02,
+	0xe9, 0x2b, 0xc5, 0x96, 0x91, 0xe6, 0xce, 0x3c, 0x3e, 0xea, 0xaa, 0xa9, 0x36, 0x48, 0x1a, 0x1a,
+	0x0f, 0x0f, 0xb3, 0x22, 0x67, 0xe1, 0x45, 0x3c, 0x38, 0x99, 0xe2, 0xc1, 0x1b, 0xe8, 0x89, 0x5c,
+	0x91, 0x3b, 0x19, 0x78, 0xd4, 0xf1, 0xc3, 0xa1, 0x0c, 0x4d, 0xeb, 0xf3, 0xb8, 0x6c, 0x31, 0xc2,
+	0xfd, 0x70, 0x68, 0xd7, 0x07, 0x5a, 0xcb, 0x5c, 0x11, 0xd2, 0xd7, 0x84, 0x65, 0xb6, 0x58, 0x0e,
+	0xac, 0xad, 0xd9, 0x7c, 0x13, 0x6a, 0xda, 0x5a, 0x16, 0x69, 0x9b, 0xd9, 0x50, 0xa4, 0x7c, 0x96,
+	0xe6, 0x27, 0x50, 0xd7, 0xc7, 0x5e, 0xa8, 0xa8, 0x99, 0xb9, 0x9a, 0xf5, 0x0f, 0x15, 0x68, 0x27,
+	0xcb, 0x91, 0x0a, 0x7a, 0x00, 0xed, 0x69, 0xfd, 0xc8, 0x56, 0x0f, 0xe9, 0xbb, 0xd3, 0x2b, 0xb4,
+	0x9b, 0x69, 0xf5, 0x40, 0x7b, 0x73, 0xb4, 0xc3, 0x9a, 0xcb, 0x6c, 0xae, 0x7a, 0x74, 0x33, 0xd5,
+	0x63, 0x7d, 0x2e, 0xa3, 0x4c, 0xfd, 0xe0, 0x41, 0x99, 0x5f, 0xc7, 0x8a, 0xd7, 0x08, 0x71, 0xc9,
+	0x8c, 0xc1, 0xf8, 0x73, 0x04, 0xf4, 0xe9, 0x9c, 0xed, 0xbf, 0x3b, 0x77, 0xa4, 0x39, 0xfb, 0xff,
+	0xd7, 0x06, 0x34, 0xd3, 0xe2, 0x41, 0x47, 0x50, 0x9b, 0x15, 0xec, 0xc6, 0x12, 0x82, 0xdd, 0x48,
+	0x3e, 0x6d, 0x18, 0xc4, 0xdf, 0xe6, 0x53, 0x00, 0x8d, 0xfd, 0x63, 0x68, 0xa5, 0xaf, 0xb8, 0x54,
+	0xad, 0x28, 0xe3, 0x8e, 0xab, 0x99, 0xba, 0xe3, 0x22, 0xe6, 0xbf, 0x18, 0x53, 0xba, 0x89, 0xf6,
+	0xf8, 0x09, 0x43, 0x6e, 0x9b, 0x08, 0xfe, 0x6f, 0xbf, 0x78, 0xdb, 0xe2, 0x3b, 0x13, 0x3b, 0xe9,
+	0x6d, 0x46, 0x50, 0x51, 0xe0, 0x17, 0x55, 0xb9, 0xe4, 0xf6, 0xa6, 0xaa, 0x5c, 0x6a, 0x2b, 0x63,
+	0xe4, 0xcc, 0x3e, 0xe6, 0x67, 0xf6, 0xd1, 0xfc, 0xb3, 0x5c, 0xda, 0xb6, 0x96, 0xbc, 0xb0, 0xde,
+	0x90, 0x11, 0x50, 0xd1, 0xe6, 0x66, 0x69, 0x79, 0xfc, 0x9b, 0xa7, 0x51, 0xb3, 0x33, 0xc9, 0x7c,
+	0x66, 0x52, 0x58, 0xfe, 0x99, 0xc9, 0xcb, 0xba, 0xc3, 0xfa, 0x68, 0xca, 0x83, 0xbc, 0x09, 0xe5,
+	0x08, 0xf7, 0xc3, 0x68, 0x90, 0x4a, 0xf5, 0x38, 0xda, 0xe6, 0x70, 0x5b, 0xe1, 0xad, 0x3f, 0xce,
+	0xc1, 0x5a, 0x37, 0xc2, 0x2e, 0xc5, 0x4a, 0x26, 0x19, 0xe1, 0x32, 0x37, 0x7b, 0x3f, 0xfe, 0xff,
+	0x7c, 0xbb, 0x97, 0x7d, 0x81, 0x53, 0xcc, 0xbc, 0xc0, 0x89, 0x2f, 0x4b, 0x4b, 0xda, 0x65, 0x69,
+	0x72, 0x4b, 0x53, 0x4e, 0xdd, 0xd2, 0x7c, 0x4f, 0x18, 0x92, 0x3b, 0xc4, 0x8e, 0xba, 0xad, 0xa9,
+	0xcc, 0xbd, 0xad, 0x69, 0x92, 0x54, 0xdb, 0x3a, 0x81, 0xeb, 0x53, 0xb2, 0x91, 0xbe, 0x75, 0x0d,
+	0x8a, 0x38, 0x8a, 0xc2, 0x48, 0xaa, 0xbd, 0x68, 0xe8, 0x7a, 0x99, 0x9b, 0xaf, 0x97, 0xd6, 0x26,
+	0xac, 0x89, 0x63, 0xd1, 0xf2, 0x12, 0xb7, 0x1e, 0xc1, 0xf5, 0xa9, 0x3e, 0x8b, 0x66, 0x62, 0xbd,
+	0x07, 0xd7, 0xd9, 0xed, 0x88, 0xdb, 0xa7, 0x57, 0x18, 0x63, 0x03, 0x6e, 0x4c, 0x77, 0x5a, 0x38,
+	0xc8, 0xaf, 0x03, 0x62, 0x0a, 0xcf, 0xee, 0x3e, 0xc2, 0x01, 0x5e, 0x46, 0x6f, 0x6e, 0x42, 0x99,
+	0xbd, 0x32, 0x48, 0x2e, 0x40, 0x4a, 0xac, 0xb9, 0x37, 0x10, 0x99, 0xe8, 0xf3, 0xa9, 0x1b, 0x72,
+	0x08, 0xf0, 0x73, 0x79, 0x3f, 0x6e, 0xbd, 0x0d, 0xab, 0xa9, 0xb1, 0x16, 0x4e, 0xac, 0x09, 0xf5,
+	0xfd, 0x70, 0xe8, 0x05, 0x72, 0x4a, 0x96, 0x03, 0x0d, 0xd9, 0x5e, 0xb8, 0x7d, 0x08, 0x0a, 0x13,
+	0x22, 0xf7, 0xae, 0x6a, 0xf3, 0xef, 0x25, 0x2f, 0x59, 0xff, 0x3c, 0x07, 0x35, 0xcd, 0xba, 0x98,
+	0x32, 0x8e, 0x30, 0x3d, 0x0f, 0x07, 0xea, 0x66, 0x4f, 0xb4, 0x16, 0xca, 0xe6, 0x06, 0x94, 0xfa,
+	0xae, 0xef, 0xcb, 0x70, 0x58, 0xb5, 0x65, 0x8b, 0x65, 0x88, 0xe2, 0x6b, 0x4a, 0x3a, 0x0d, 0x01,
+	0x95, 0x02, 0x42, 0xaf, 0x40, 0xd5, 0x8d, 0x86, 0x93, 0x11, 0xcf, 0x95, 0x8a, 0x9c, 0x22, 0x01,
+	0xb0, 0xd2, 0x41, 0xfa, 0xe4, 0x5c, 0xe2, 0x27, 0xe7, 0x1a, 0x49, 0x4e, 0xcd, 0x2c, 0x89, 0xc7,
+	0xc1, 0x20, 0xa6, 0x28, 0x73, 0x8a, 0x2a, 0x0e, 0x06, 0x12, 0x1f, 0x0b, 0xad, 0x32, 0x47, 0xe7,
+	0xab, 0x0b, 0x74, 0x7e, 0x1b, 0x6e, 0xf6, 0x30, 0x4d, 0xfb, 0x3a, 0xa9, 0x30, 0xb1, 0x53, 0x34,
+	0x16, 0x3b, 0x45, 0xeb, 0x5d, 0xe8, 0xcc, 0xf2, 0x58, 0xa8, 0x08, 0x7f, 0x99, 0x03, 0x24, 0x0c,
+	0x98, 0x1f, 0xba, 0x96, 0x39, 0x09, 0x2c, 0xbc, 0xc1, 0x7b, 0x29, 0x7e, 0x4f, 0x9c, 0x22, 0xb2,
+	0xfc, 0x1e, 0xc7, 0x68, 0x7e, 0x2f, 0x96, 0x54, 0x69, 0xf9, 0xf0, 0x51, 0x7e, 0x71, 0xf8, 0x60,
+	0xc6, 0x95, 0x92, 0xd2, 0x8b, 0x5c, 0x8b, 0xf0, 0x44, 0x8a, 0xdd, 0x12, 0x52, 0x65, 0xae, 0x65,
+	0xba, 0xd3, 0xc2, 0x41, 0xde, 0x8f, 0x5d, 0xd1, 0x55, 0x46, 0x79, 0x07, 0x6e, 0xce, 0xf4, 0x5a,
+	0x38, 0x4c, 0x17, 0x5a, 0x4f, 0x43, 0x4a, 0xc6, 0x21, 0x25, 0xcb, 0xe8, 0x46, 0x76, 0xf2, 0xfd,
+	0x1f, 0x06, 0xb4, 0x13, 0x2e, 0x72, 0xbc, 0x8f, 0xa0, 0xc4, 0x77, 0x4f, 0x05, 0x60, 0x9e, 0x72,
+	0x4e, 0x53, 0x89, 0xfb, 0xd8, 0xa7, 0x21, 0x9f, 0xae, 0x2d, 0x3b, 0xa0, 0x7b, 0x50, 0x21, 0x7e,
+	0xf8, 0xdc, 0x09, 0xc7, 0x2a, 0x73, 0x02, 0x1e, 0xaa, 0xfc, 0xf0, 0xf9, 0xd1, 0xd8, 0x2e, 0x13,
+	0xfe, 0xab, 0x99, 0x63, 0x5e, 0x5b, 0x91, 0x79, 0x0c, 0x75, 0x9d, 0xe9, 0xa2, 0x53, 0xea, 0x3d,
+	0xa8, 0x9c, 0x87, 0x94, 0xe7, 0xf3, 0xfa, 0x38, 0xa2, 0xa7, 0x5d, 0x3e, 0x17, 0x1c, 0xac, 0x77,
+	0x60, 0x85, 0x73, 0x64, 0x37, 0xb6, 0xcb, 0x48, 0xc9, 0x3a, 0x02, 0xa4, 0x77, 0x90, 0x02, 0x79,
+	0x7d, 0x4a, 0x20, 0xdc, 0x4d, 0xec, 0x9c, 0x0a, 0x22, 0xb5, 0xf4, 0x78, 0x4d, 0x39, 0x7d, 0x97,
+	0xfe, 0x24, 0x0f, 0x65, 0x49, 0xb9, 0x78, 0x3d, 0x4d, 0x4c, 0xa8, 0x37, 0xe2, 0x15, 0x02, 0xb9,
+	0x2a, 0x5e, 0xa5, 0x8f, 0xa1, 0x5c, 0x22, 0xac, 0xae, 0xed, 0x3d, 0xc3, 0x0e, 0x21, 0x34, 0x31,
+	0xe2, 0x82, 0x5d, 0x63, 0xc0, 0x1e, 0xa1, 0xdc, 0x88, 0xdf, 0x80, 0xa6, 0xc8, 0x46, 0x62, 0x22,
+	0x51, 0xf8, 0xa9, 0x73, 0xa8, 0xa2, 0x7a, 0x1d, 0x1a, 0x23, 0x3c, 0xa2, 0xfc, 0x91, 0x42, 0x5c,
+	0xc5, 0x2b, 0xd8, 0x75, 0x05, 0xe4, 0x44, 0x1f, 0x42, 0x67, 0x8c, 0x83, 0x81, 0x17, 0x0c, 0x9d,
+	0xbe, 0xd0, 0x4d, 0xe6, 0x16, 0xf4, 0x42, 0xc7, 0x0d, 0x89, 0xef, 0xc6, 0x68, 0x51, 0xf6, 0x78,
+	0x0b, 0x56, 0xf8, 0xdb, 0x0c, 0xf6, 0xae, 0x43, 0x3e, 0xb9, 0x16, 0xef, 0xc3, 0x0a, 0x76, 0x8b,
+	0x23, 0x9e, 0x78, 0xbe, 0x78, 0x77, 0x4d, 0xf8, 0x4b, 0x19, 0xed, 0xc9, 0xc8, 0xb9, 0x47, 0xd5,
+	0x0b, 0xdb, 0x66, 0xf2, 0x5e, 0xe4, 0xa9, 0x47, 0x09, 0x7a, 0x08, 0x48, 0xa7, 0x1c, 0x79, 0x84,
+	0x60, 0xc2, 0x5d, 0x77, 0x41, 0x7f, 0x5b, 0x72, 0xc0, 0xe1, 0xcc, 0xe1, 0x11, 0xea, 0xfa, 0xbe,
+	0x33, 0xf2, 0xfa, 0x51, 0x48, 0x3a, 0x20, 0x64, 0xc5, 0x61, 0x07, 0x1c, 0x64, 0x3d, 0x81, 0x92,
+	0x50, 0x99, 0xec, 0xa2, 0xb6, 0x48, 0xa5, 0xc5, 0x4e, 0x88, 0x46, 0x5a, 0x73, 0x0b, 0x6a, 0x97,
+	0xff, 0xd5, 0x80, 0x92, 0xd0, 0xf1, 0x6f, 0x5a, 0xc0, 0x63, 0xb7, 0x0e, 0x63, 0x69, 0x0e, 0xb9,
+	0x70, 0x8c, 0x5e, 0x15, 0x35, 0x4f, 0xed, 0x7e, 0xb6, 0xce, 0x4f, 0x32, 0xf2, 0x16, 0xf6, 0x55,
+	0x00, 0xdf, 0xa5, 0x38, 0xe8, 0x5f, 0xb2, 0x70, 0x27, 0xf6, 0xae, 0x2a, 0x21, 0xe2, 0xaa, 0x92,
+	0x57, 0x4b, 0xc5, 0xd6, 0x8a, 0xad, 0xaa, 0x72, 0x08, 0xdf, 0x57, 0x15, 0x51, 0xa7, 0xaa, 0x51,
+	0x35, 0x09, 0xe4, 0x75, 0xa8, 0xdf, 0xcc, 0xc1, 0x6d, 0x75, 0x24, 0xe0, 0x79, 0xcb, 0x71, 0x84,
+	0xc7, 0x6e, 0x84, 0x7f, 0x0e, 0xe3, 0xd0, 0x4b, 0x3a, 0x99, 0x58, 0xef, 0xc3, 0x2b, 0xd9, 0x12,
+	0x58, 0xe8, 0x97, 0x3f, 0x04, 0x33, 0xd5, 0xab, 0x1b, 0x8e, 0x46, 0x1e, 0x5d, 0xc6, 0xf9, 0xbc,
+	0x07, 0xb7, 0x33, 0x7b, 0x2e, 0x1c, 0xee, 0xa3, 0xe9, 0x4e, 0x3e, 0x76, 0x83, 0xc9, 0x78, 0x99,
+	0xf1, 0xa6, 0xd7, 0x17, 0x77, 0x5d, 0x38, 0xe0, 0xdf, 0xe4, 0xa0, 0x23, 0x1e, 0x0f, 0xff, 0x7c,
+	0x67, 0x27, 0x57, 0xbd, 0xad, 0x78, 0x59, 0x09, 0xca, 0x2f, 0xc0, 0xad, 0x0c, 0x71, 0x2d, 0x14,
+	0xb1, 0x0b, 0xab, 0xb2, 0xcb, 0xb2, 0xba, 0x73, 0xd5, 0x57, 0xd9, 0xd6, 0x43, 0x58, 0x4b, 0x0f,
+	0xb1, 0x70, 0x42, 0xa7, 0x31, 0xf5, 0xd2, 0xda, 0x75, 0xe5, 0x19, 0x3d, 0x82, 0xeb, 0x53, 0x63,
+	0x2c, 0x9c, 0xd2, 0x8f, 0xa0, 0x21, 0xc8, 0x97, 0x39, 0xbb, 0xcd, 0x99, 0x4b, 0x7e, 0xde, 0x5c,
+	0xee, 0xb3, 0xff, 0xb4, 0x08, 0xe6, 0x8b, 0x26, 0xf1, 0xd6, 0x4f, 0x0d, 0x68, 0xa4, 0x1e, 0x46,
+	0xb0, 0xa7, 0x5f, 0xdb, 0x5f, 0x9e, 0xec, 0xf6, 0xda, 0xd7, 0xd8, 0xd3, 0xaf, 0x27, 0xfb, 0x47,
+	0x5b, 0x27, 0x1f, 0xbc, 0xdf, 0x36, 0x50, 0x0b, 0x6a, 0x07, 0x5b, 0x3f, 0x74, 0x14, 0x20, 0xc7,
+	0x01, 0x7b, 0x87, 0x31, 0x20, 0xcf, 0x7a, 0xee, 0x1d, 0xb2, 0xcf, 0x02, 0x7b, 0x4e, 0xc6, 0x88,
+	0x45, 0xb3, 0xc8, 0x9b, 0x7b, 0x87, 0xb2, 0x59, 0x62, 0x0f, 0xff, 0x7a, 0xbb, 0x27, 0xed, 0x32,
+	0x6a, 0x02, 0xf4, 0x8e, 0xec, 0x93, 0xdd, 0x1d, 0x87, 0xb5, 0x2b, 0x0c, 0xf1, 0x74, 0x7f, 0xbf,
+	0x5d, 0xe5, 0x93, 0xd8, 0x3f, 0x3a, 0x3a, 0x68, 0x03, 0x7b, 0x11, 0xb8, 0xbf, 0xd7, 0x3b, 0x69,
+	0xd7, 0x36, 0xff, 0xb1, 0x08, 0xb5, 0x2f, 0x5c, 0x42, 0xc3, 0x03, 0x97, 0xad, 0x93, 0x5d, 0x09,
+	0xdb, 0x78, 0xe8, 0xf1, 0x35, 0xd3, 0x30, 0xc2, 0x28, 0x2e, 0x2a, 0x24, 0xff, 0x80, 0x32, 0xdb,
+	0x31, 0x4c, 0xfe, 0xcb, 0xc1, 0xba, 0xf6, 0xc0, 0x78, 0xd7, 0x40, 0xbf, 0x04, 0x4d, 0xd5, 0x59,
+	0x54, 0x82, 0xd1, 0x6a, 0xc6, 0x1f, 0x45, 0xcc, 0x95, 0x99, 0x7f, 0x49, 0xc8, 0xfe, 0xdf, 0x85,
+	0x8a, 0xaa, 0xdf, 0x89, 0x9e, 0x53, 0x05, 0x6d, 0x73, 0x2d, 0xab, 0xc4, 0x67, 0x5d, 0x43, 0x4f,
+	0xa0, 0x91, 0xaa, 0x6a, 0x20, 0xf1, 0x47, 0x8c, 0x8c, 0x22, 0x90, 0x79, 0x2b, 0x03, 0xa3, 0xf3,
+	0x49, 0xd5, 0x24, 0x04, 0x9f, 0xac, 0xd2, 0x86, 0x79, 0x2b, 0x03, 0x13, 0xf3, 0xd9, 0x83, 0xa6,
+	0xcc, 0x7d, 0x14, 0x23, 0x31, 0x6c, 0x56, 0x01, 0xc3, 0x34, 0xb3, 0x50, 0x31, 0xab, 0x0f, 0x95,
+	0x46, 0x2b, 0x4e, 0x2b, 0xf2, 0x25, 0x59, 0xa2, 0xe4, 0x26, 0xd2, 0x41, 0x71, 0xcf, 0x4f, 0xa0,
+	0xa6, 0x15, 0x18, 0xd0, 0x0d, 0x55, 0xce, 0x4b, 0x57, 0x37, 0xcc, 0x9b, 0x33, 0xf0, 0x98, 0xc3,
+	0x11, 0xb4, 0xa7, 0x8f, 0xa7, 0xe8, 0x36, 0xdf, 0xfb, 0xec, 0x83, 0xaf, 0xf9, 0x4a, 0x36, 0x32,
+	0x66, 0xb8, 0x01, 0x45, 0x5e, 0xb6, 0x40, 0x6d, 0x79, 0xeb, 0x16, 0x57, 0x34, 0xcc, 0x15, 0x0d,
+	0x12, 0xd3, 0xdf, 0x63, 0x55, 0xd2, 0xd3, 0xc9, 0x50, 0x2a, 0x67, 0x95, 0xd1, 0xf0, 0xb7, 0x92,
+	0x66, 0xf2, 0x69, 0x5d, 0xdb, 0xfc, 0xc3, 0x2a, 0x00, 0x57, 0x62, 0xa1, 0xb2, 0x4f, 0xa1, 0x91,
+	0xba, 0xcb, 0x15, 0xbb, 0x98, 0x75, 0x7d, 0x6e, 0xde, 0xca, 0xc0, 0xa8, 0xd1, 0xdf, 0x35, 0xd0,
+	0xc7, 0x00, 0xec, 0x3e, 0x57, 0xdc, 0x93, 0xa1, 0xeb, 0xe2, 0xf1, 0xc1, 0xd4, 0xe5, 0xac, 0x79,
+	0x63, 0x1a, 0xac, 0x31, 0xf8, 0x04, 0x6a, 0xda, 0x4d, 0x9b, 0xd8, 0x83, 0xd9, 0x8b, 0x3c, 0xf3,
+	0xe6, 0x0c, 0x5c, 0xdf, 0x45, 0x2d, 0x44, 0x48, 0x0e, 0x33, 0x21, 0xd6, 0xbc, 0x39, 0x03, 0xd7,
+	0x95, 0x31, 0x7d, 0x52, 0x45, 0x9a, 0xee, 0x4e, 0x1d, 0x46, 0x4d, 0x33, 0x0b, 0x15, 0xb3, 0xda,
+	0x87, 0xd6, 0xd4, 0x71, 0x14, 0xe9, 0xda, 0x3b, 0xcd, 0xec, 0x76, 0x26, 0x2e, 0xe6, 0xf6, 0x23,
+	0x16, 0x3f, 0x66, 0x33, 0x29, 0x74, 0x47, 0x2f, 0x3c, 0x67, 0x64, 0x99, 0xe6, 0xfa, 0x7c, 0x82,
+	0x98, 0xf9, 0x0f, 0x61, 0x35, 0x45, 0x21, 0x22, 0x1a, 0x7a, 0x6d, 0xa6, 0x6b, 0x2a, 0x9a, 0x9a,
+	0x77, 0xe6, 0xe2, 0xe7, 0x4e, 0x5b, 0x46, 0xa6, 0x8c, 0x69, 0xa7, 0xe3, 0xa2, 0xb9, 0x3e, 0x9f,
+	0x20, 0x66, 0x7e, 0xa8, 0xcc, 0x5d, 0x09, 0xe3, 0x95, 0xc4, 0xb6, 0x33, 0xb6, 0xfd, 0xd5, 0x39,
+	0xd8, 0x98, 0x5f, 0x17, 0xea, 0x7a, 0x44, 0x47, 0x37, 0xb5, 0x0e, 0xa9, 0x85, 0x77, 0x66, 0x11,
+	0xba, 0x5b, 0x4c, 0x05, 0x61, 0xa4, 0x13, 0xa7, 0xd7, 0x78, 0x2b, 0x03, 0x13, 0xf3, 0xf9, 0x2e,
+	0x54, 0x54, 0xc1, 0x40, 0xf8, 0xf7, 0xa9, 0x52, 0x85, 0xb9, 0x96, 0x06, 0xc6, 0x1d, 0xbf, 0x0f,
+	0x90, 0x1c, 0xc0, 0x85, 0x1d, 0xce, 0x9c, 0xe0, 0xcd, 0x1b, 0xd3, 0xe0, 0x97, 0xe7, 0xc7, 0xde,
+	0x00, 0xe0, 0x7e, 0x49, 0xf8, 0x9b, 0x39, 0x6e, 0x69, 0xfb, 0x55, 0xa8, 0x78, 0xe1, 0x06, 0xff,
+	0x97, 0xf2, 0xb6, 0xf0, 0x4f, 0xc7, 0x51, 0x48, 0xc3, 0x63, 0xe3, 0x2f, 0x72, 0xb9, 0x2f, 0x7a,
+	0xa7, 0x25, 0xfe, 0xcf, 0xe5, 0xf7, 0xfe, 0x6f, 0x00, 0x72, 0xbd, 0x54, 0x84, 0xc8, 0x3c, 0x00,
+	0x00,
 }
@@ -0,0 +1,253 @@
+// Package security loads the TLS certificates for the grpc and tcp traffic between vasto processes.
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// reloadCheckInterval is how often the certificate files are checked for changes
+const reloadCheckInterval = time.Second
+
+// handshakeTimeout limits how long an accepted connection can take to finish the TLS handshake
+var handshakeTimeout = 10 * time.Second
+
+// TlsOption has the certificate files. TLS is disabled if both CertFile and CaFile are empty.
+type TlsOption struct {
+	CertFile     *string
+	KeyFile      *string
+	CaFile       *string
+	VerifyClient *bool
+}
+
+// Tls has the current certificates, reloaded when the files change.
+// A nil Tls means plaintext.
+type Tls struct {
+	certFile     string
+	keyFile      string
+	caFile       string
+	verifyClient bool
+
+	sync.Mutex
+	cert      *tls.Certificate
+	caPool    *x509.CertPool
+	modTimes  map[string]time.Time
+	checkedAt time.Time
+}
+
+// NewTls loads the certificates. It returns nil if TLS is not configured.
+func NewTls(option *TlsOption) (*Tls, error) {
+	if option == nil || (*option.CertFile == "" && *option.CaFile == "") {
+		return nil, nil
+	}
+	if (*option.CertFile == "") != (*option.KeyFile == "") {
+		return nil, errors.New("tls cert file and key file should be set together")
+	}
+	t := &Tls{
+		certFile:     *option.CertFile,
+		keyFile:      *option.KeyFile,
+		caFile:       *option.CaFile,
+		verifyClient: *option.VerifyClient,
+	}
+	if t.verifyClient && (t.certFile == "" || t.caFile == "") {
+		return nil, errors.New("verifying tls clients needs both the cert file and the ca file")
+	}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// MustNewTls is NewTls, but exits if the certificates can not be loaded.
+func MustNewTls(option *TlsOption) *Tls {
+	t, err := NewTls(option)
+	if err != nil {
+		glog.Fatalf("load tls certificates: %v", err)
+	}
+	return t
+}
+
+// load reads all the files before replacing the certificates, so a failed reload keeps the previous ones.
+func (t *Tls) load() error {
+	var cert *tls.Certificate
+	if t.certFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(t.certFile, t.keyFile)
+		if err != nil {
+			return fmt.Errorf("load tls key pair %s %s: %v", t.certFile, t.keyFile, err)
+		}
+		cert = &keyPair
+	}
+	var caPool *x509.CertPool
+	if t.caFile != "" {
+		pem, err := ioutil.ReadFile(t.caFile)
+		if err != nil {
+			return fmt.Errorf("read tls ca file %s: %v", t.caFile, err)
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in tls ca file %s", t.caFile)
+		}
+	}
+	modTimes := make(map[string]time.Time)
+	for _, f := range []string{t.certFile, t.keyFile, t.caFile} {
+		if f == "" {
+			continue
+		}
+		if info, err := os.Stat(f); err == nil {
+			modTimes[f] = info.ModTime()
+		}
+	}
+	t.cert, t.caPool, t.modTimes = cert, caPool, modTimes
+	return nil
+}
+
+// current returns the certificates, reloading them if the files have changed.
+func (t *Tls) current() (*tls.Certificate, *x509.CertPool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if now := time.Now(); now.Sub(t.checkedAt) >= reloadCheckInterval {
+		t.checkedAt = now
+		if t.hasChanged() {
+			if err := t.load(); err != nil {
+				glog.Errorf("reload tls certificates, keep using the previous ones: %v", err)
+			} else {
+				glog.V(0).Infof("reloaded tls certificates %s %s", t.certFile, t.caFile)
+			}
+		}
+	}
+
+	return t.cert, t.caPool
+}
+
+func (t *Tls) hasChanged() bool {
+	for _, f := range []string{t.certFile, t.keyFile, t.caFile} {
+		if f == "" {
+			continue
+		}
+		if info, err := os.Stat(f); err == nil && !info.ModTime().Equal(t.modTimes[f]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServerConfig creates the tls config for the servers, reading the current certificates for each handshake.
+func (t *Tls) ServerConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool := t.current()
+			if cert == nil {
+				return nil, errors.New("no tls certificate to serve")
+			}
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*cert},
+			}
+			if t.verifyClient {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.ClientCAs = caPool
+			}
+			return config, nil
+		},
+	}
+}
+
+// ClientConfig creates the tls config for the clients. The server certificate is verified
+// against the current ca, or the system roots if there is no ca file.
+func (t *Tls) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the verification is done in VerifyConnection, to use the reloaded ca
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, caPool := t.current()
+			opts := x509.VerifyOptions{
+				Roots:         caPool,
+				DNSName:       state.ServerName,
+				Intermediates: x509.NewCertPool(),
+			}
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("no server certificate")
+			}
+			for _, cert := range state.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err := state.PeerCertificates[0].Verify(opts)
+			return err
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert, _ := t.current(); cert != nil {
+				return cert, nil
+			}
+			return &tls.Certificate{}, nil
+		},
+	}
+}
+
+// GrpcServerOptions returns the options for grpc servers, empty if TLS is disabled.
+func (t *Tls) GrpcServerOptions() []grpc.ServerOption {
+	if t == nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(t.ServerConfig("h2")))}
+}
+
+// GrpcDialOption returns the transport credentials to dial grpc servers, or grpc.WithInsecure() if TLS is disabled.
+func (t *Tls) GrpcDialOption() grpc.DialOption {
+	if t == nil {
+		return grpc.WithInsecure()
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(t.ClientConfig()))
+}
+
+// ServerConn wraps an accepted tcp connection with TLS, and finishes the handshake within handshakeTimeout.
+// Unix socket connections are kept as is. The caller closes the connection if the handshake fails.
+func (t *Tls) ServerConn(conn net.Conn) (net.Conn, error) {
+	if t == nil {
+		return conn, nil
+	}
+	if _, isTcp := conn.(*net.TCPConn); !isTcp {
+		return conn, nil
+	}
+	tlsConn := tls.Server(conn, t.ServerConfig())
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return nil, err
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// ClientConn wraps a dialed tcp connection with TLS. Unix socket connections are kept as is.
+func (t *Tls) ClientConn(conn net.Conn, address string) net.Conn {
+	if t == nil {
+		return conn
+	}
+	if _, isTcp := conn.(*net.TCPConn); !isTcp {
+		return conn
+	}
+	config := t.ClientConfig()
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		config.ServerName = host
+	}
+	return tls.Client(conn, config)
+}
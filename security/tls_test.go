@@ -0,0 +1,224 @@
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCa struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCa(t *testing.T, name string) *testCa {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create ca: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCa{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (ca *testCa) issue(t *testing.T, serial int64) (certPem, keyPem []byte) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "vasto"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create cert: %v", err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeFiles(t *testing.T, dir string, ca *testCa, serial int64, modTime time.Time) *TlsOption {
+	certPem, keyPem := ca.issue(t, serial)
+	files := map[string][]byte{"cert.pem": certPem, "key.pem": keyPem, "ca.pem": ca.pem}
+	for name, data := range files {
+		f := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(f, data, 0600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		os.Chtimes(f, modTime, modTime)
+	}
+	certFile, keyFile, caFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), filepath.Join(dir, "ca.pem")
+	verifyClient := true
+	return &TlsOption{CertFile: &certFile, KeyFile: &keyFile, CaFile: &caFile, VerifyClient: &verifyClient}
+}
+
+func handshake(server, client *Tls) (*x509.Certificate, error) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- tls.Server(serverConn, server.ServerConfig()).Handshake()
+	}()
+
+	config := client.ClientConfig()
+	config.ServerName = "127.0.0.1"
+	c := tls.Client(clientConn, config)
+	if err := c.Handshake(); err != nil {
+		return nil, err
+	}
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+	return c.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestDisabled(t *testing.T) {
+	empty, no := "", false
+	tlsConfig, err := NewTls(&TlsOption{CertFile: &empty, KeyFile: &empty, CaFile: &empty, VerifyClient: &no})
+	if err != nil || tlsConfig != nil {
+		t.Fatalf("expected disabled tls, got %v %v", tlsConfig, err)
+	}
+	if len(tlsConfig.GrpcServerOptions()) != 0 {
+		t.Errorf("expected no grpc server options")
+	}
+	conn, _ := net.Pipe()
+	if c, err := tlsConfig.ServerConn(conn); err != nil || c != conn {
+		t.Errorf("expected plain connection")
+	}
+}
+
+func TestMutualTls(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "vasto_tls")
+	defer os.RemoveAll(dir)
+
+	ca := newTestCa(t, "ca")
+	tlsConfig, err := NewTls(writeFiles(t, dir, ca, 2, time.Now()))
+	if err != nil {
+		t.Fatalf("load tls: %v", err)
+	}
+
+	if _, err := handshake(tlsConfig, tlsConfig); err != nil {
+		t.Fatalf("handshake: %v", err)
+	}
+
+	otherDir, _ := ioutil.TempDir("", "vasto_tls_other")
+	defer os.RemoveAll(otherDir)
+	other, err := NewTls(writeFiles(t, otherDir, newTestCa(t, "other"), 3, time.Now()))
+	if err != nil {
+		t.Fatalf("load other tls: %v", err)
+	}
+	if _, err := handshake(tlsConfig, other); err == nil {
+		t.Errorf("expected handshake failure with a client from another ca")
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "vasto_tls")
+	defer os.RemoveAll(dir)
+
+	ca := newTestCa(t, "ca")
+	tlsConfig, err := NewTls(writeFiles(t, dir, ca, 2, time.Now().Add(-time.Minute)))
+	if err != nil {
+		t.Fatalf("load tls: %v", err)
+	}
+
+	writeFiles(t, dir, ca, 5, time.Now())
+	tlsConfig.checkedAt = time.Time{}
+
+	cert, err := handshake(tlsConfig, tlsConfig)
+	if err != nil {
+		t.Fatalf("handshake: %v", err)
+	}
+	if cert.SerialNumber.Int64() != 5 {
+		t.Errorf("expected reloaded certificate 5, got %v", cert.SerialNumber)
+	}
+
+	// a broken file keeps the previous certificates
+	ioutil.WriteFile(filepath.Join(dir, "cert.pem"), []byte("broken"), 0600)
+	tlsConfig.checkedAt = time.Time{}
+	if _, err := handshake(tlsConfig, tlsConfig); err != nil {
+		t.Fatalf("handshake after broken reload: %v", err)
+	}
+
+	// a new certificate with a broken ca is not loaded halfway
+	writeFiles(t, dir, newTestCa(t, "other"), 6, time.Now().Add(time.Minute))
+	ioutil.WriteFile(filepath.Join(dir, "ca.pem"), []byte("broken"), 0600)
+	tlsConfig.checkedAt = time.Time{}
+	if cert, err := handshake(tlsConfig, tlsConfig); err != nil || cert.SerialNumber.Int64() != 5 {
+		t.Fatalf("handshake after broken ca reload: %v %v", cert, err)
+	}
+}
+
+func TestHandshakeTimeout(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "vasto_tls")
+	defer os.RemoveAll(dir)
+
+	tlsConfig, err := NewTls(writeFiles(t, dir, newTestCa(t, "ca"), 2, time.Now()))
+	if err != nil {
+		t.Fatalf("load tls: %v", err)
+	}
+
+	defer func(timeout time.Duration) { handshakeTimeout = timeout }(handshakeTimeout)
+	handshakeTimeout = 50 * time.Millisecond
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+
+	// a client connects but never starts the handshake
+	client, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	defer conn.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := tlsConfig.ServerConn(conn)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Errorf("handshake without a client hello succeeded")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("handshake does not time out")
+	}
+}
@@ -0,0 +1,73 @@
+package stats
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/chrislusf/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// HttpServer serves /metrics from its own registry, the /health liveness check, and the registered handlers.
+// Each vasto server has one, so more than one server can run in a process.
+type HttpServer struct {
+	name     string
+	mux      *http.ServeMux
+	registry *prometheus.Registry
+}
+
+// NewHttpServer creates the http server, with the go runtime and process metrics registered.
+func NewHttpServer(name string) *HttpServer {
+	s := &HttpServer{
+		name:     name,
+		mux:      http.NewServeMux(),
+		registry: prometheus.NewRegistry(),
+	}
+	s.registry.MustRegister(prometheus.NewGoCollector())
+	s.registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+	s.mux.Handle("/metrics", promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{}))
+	s.mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok\n"))
+	})
+	return s
+}
+
+// MustRegister adds the collectors to the metrics of this server.
+func (s *HttpServer) MustRegister(collectors ...prometheus.Collector) {
+	s.registry.MustRegister(collectors...)
+}
+
+// HandleFunc registers one more handler to the http server, besides /metrics and the /health liveness check.
+func (s *HttpServer) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// WriteReadiness writes the status as json, with http status 200 if ready, or 503 if not.
+func WriteReadiness(w http.ResponseWriter, ready bool, status interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if ready {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(status); err != nil {
+		glog.Errorf("write readiness status: %v", err)
+	}
+}
+
+// Start serves /metrics and other registered handlers on the address.
+// It does nothing if the address is empty.
+func (s *HttpServer) Start(address string) {
+	if address == "" {
+		return
+	}
+	glog.V(0).Infof("%s http metrics on %s", s.name, address)
+	go func() {
+		if err := http.ListenAndServe(address, s.mux); err != nil {
+			glog.Errorf("%s http server on %s: %v", s.name, address, err)
+		}
+	}()
+}
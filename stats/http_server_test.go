@@ -0,0 +1,50 @@
+package stats
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestWriteReadiness(t *testing.T) {
+
+	w := httptest.NewRecorder()
+	WriteReadiness(w, true, map[string]bool{"ready": true})
+	if w.Code != http.StatusOK {
+		t.Errorf("ready status code %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"ready": true`) {
+		t.Errorf("unexpected body %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	WriteReadiness(w, false, map[string]bool{"ready": false})
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("not ready status code %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	NewHttpServer("test").mux.ServeHTTP(w, httptest.NewRequest("GET", "/health", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("health status code %d", w.Code)
+	}
+}
+
+func TestHttpServersInOneProcess(t *testing.T) {
+
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_total", Help: "test"})
+	for _, name := range []string{"first", "second"} {
+		s := NewHttpServer(name)
+		s.MustRegister(counter)
+		s.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {})
+
+		w := httptest.NewRecorder()
+		s.mux.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+		if !strings.Contains(w.Body.String(), "test_total") {
+			t.Errorf("%s metrics: %s", name, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"sort"
+	"sync"
+)
+
+// TopKItem is one tracked key. The real count is between Count-Error and Count.
+type TopKItem struct {
+	Key   []byte
+	Count uint64
+	Error uint64
+}
+
+// TopK approximates the most frequent keys in bounded memory, with the space-saving algorithm.
+// When full, a new key replaces the least counted key, and inherits its count as the error.
+type TopK struct {
+	sync.Mutex
+	capacity int
+	counters map[string]*TopKItem
+}
+
+// NewTopK creates a TopK tracking at most capacity keys.
+func NewTopK(capacity int) *TopK {
+	return &TopK{
+		capacity: capacity,
+		counters: make(map[string]*TopKItem, capacity),
+	}
+}
+
+// Add counts one more occurrence of the key.
+func (t *TopK) Add(key []byte) {
+	t.Lock()
+	defer t.Unlock()
+
+	if item, found := t.counters[string(key)]; found {
+		item.Count++
+		return
+	}
+
+	if len(t.counters) < t.capacity {
+		t.counters[string(key)] = &TopKItem{Key: append([]byte(nil), key...), Count: 1}
+		return
+	}
+
+	var minKey string
+	var minItem *TopKItem
+	for k, item := range t.counters {
+		if minItem == nil || item.Count < minItem.Count {
+			minKey, minItem = k, item
+		}
+	}
+	delete(t.counters, minKey)
+	t.counters[string(key)] = &TopKItem{
+		Key:   append([]byte(nil), key...),
+		Count: minItem.Count + 1,
+		Error: minItem.Count,
+	}
+}
+
+// Top returns at most n keys, most frequent first. It returns all tracked keys if n is 0.
+func (t *TopK) Top(n int) (items []TopKItem) {
+	t.Lock()
+	for _, item := range t.counters {
+		items = append(items, *item)
+	}
+	t.Unlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return string(items[i].Key) < string(items[j].Key)
+	})
+	if n > 0 && len(items) > n {
+		items = items[:n]
+	}
+	return
+}
+
+// Decay halves all the counts, so that recent accesses weigh more. Keys with nothing left are dropped.
+func (t *TopK) Decay() {
+	t.Lock()
+	defer t.Unlock()
+
+	for k, item := range t.counters {
+		item.Count /= 2
+		item.Error /= 2
+		if item.Count == 0 {
+			delete(t.counters, k)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package stats
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+
+	topK := NewTopK(8)
+
+	for i := 0; i < 100; i++ {
+		topK.Add([]byte("hot"))
+		if i%2 == 0 {
+			topK.Add([]byte("warm"))
+		}
+		topK.Add([]byte(fmt.Sprintf("cold%d", i)))
+	}
+
+	items := topK.Top(2)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items: %+v", items)
+	}
+	if string(items[0].Key) != "hot" || items[0].Count-items[0].Error > 100 || items[0].Count < 100 {
+		t.Errorf("unexpected hottest key: %s count:%d error:%d", items[0].Key, items[0].Count, items[0].Error)
+	}
+	if string(items[1].Key) != "warm" {
+		t.Errorf("unexpected second hottest key: %s count:%d error:%d", items[1].Key, items[1].Count, items[1].Error)
+	}
+
+	if len(topK.Top(0)) != 8 {
+		t.Errorf("expected to track 8 keys: %+v", topK.Top(0))
+	}
+
+	topK.Decay()
+	if items := topK.Top(1); items[0].Count != 50 {
+		t.Errorf("decayed count %d, expected 50", items[0].Count)
+	}
+
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/crypt"
+	"github.com/golang/protobuf/proto"
 	"io/ioutil"
 	"math"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // LogManager manages the local binlogs
@@ -17,6 +20,7 @@ type LogManager struct {
 	dir               string
 	logFileMaxSize    int64
 	logFileCountLimit int
+	cipher            *crypt.Cipher
 
 	filesLock sync.RWMutex
 	files     map[uint32]*logSegmentFile
@@ -27,6 +31,11 @@ type LogManager struct {
 	offset       int64
 	followerCond *sync.Cond
 	hasShutdown  bool
+
+	// counters since start, to estimate the lag of the followers
+	appendedEntries   uint64
+	appendedBytes     uint64
+	latestUpdatedAtNs uint64
 }
 
 const (
@@ -48,6 +57,12 @@ func NewLogManager(dir string, id int, logFileMaxSize int64, logFileCountLimit i
 	return m
 }
 
+// SetCipher encrypts the new log entries if the keyspace is encrypted, and decrypts the encrypted ones when reading.
+// This should be called before Initialze.
+func (m *LogManager) SetCipher(cipher *crypt.Cipher) {
+	m.cipher = cipher
+}
+
 // Initialze locates existing logs from disk, and creates files to write if needed.
 func (m *LogManager) Initialze() error {
 
@@ -89,7 +104,15 @@ func (m *LogManager) AppendEntry(entry *pb.LogEntry) error {
 		m.followerCond.L.Unlock()
 	}
 
-	return m.lastLogFile.appendEntry(entry)
+	if err := m.lastLogFile.appendEntry(entry); err != nil {
+		return err
+	}
+
+	atomic.AddUint64(&m.appendedEntries, 1)
+	atomic.AddUint64(&m.appendedBytes, uint64(proto.Size(entry)+4))
+	atomic.StoreUint64(&m.latestUpdatedAtNs, entry.UpdatedAtNs)
+
+	return nil
 
 }
 
@@ -137,7 +160,7 @@ func (m *LogManager) maybeRemoveOldFiles() {
 
 func (m *LogManager) maybePrepareCurrentFileForWrite() (err error) {
 	if m.lastLogFile == nil {
-		m.lastLogFile = newLogSegmentFile(m.getFileName(m.segment), m.segment, m.logFileMaxSize)
+		m.lastLogFile = newLogSegmentFile(m.getFileName(m.segment), m.segment, m.logFileMaxSize, m.cipher)
 		m.filesLock.Lock()
 		defer m.filesLock.Unlock()
 		m.files[m.segment] = m.lastLogFile
@@ -205,7 +228,7 @@ func (m *LogManager) loadFilesFromDisk() error {
 				glog.Errorf("parse file name %s under %s", name, m.dir)
 				return err
 			}
-			oneLogFile := newLogSegmentFile(m.getFileName(segmentNumber), segmentNumber, m.logFileMaxSize)
+			oneLogFile := newLogSegmentFile(m.getFileName(segmentNumber), segmentNumber, m.logFileMaxSize, m.cipher)
 			// glog.V(2).Infof("add segment %d file %s", segmentNumber, oneLogFile.fullName)
 			m.files[segmentNumber] = oneLogFile
 			if maxSegmentNumber <= segmentNumber {
@@ -232,3 +255,37 @@ func (m *LogManager) GetSegmentOffset() (uint32, int64) {
 	}
 	return m.segment, m.lastLogFile.offset
 }
+
+// Lag returns how far the position of segment and offset is behind the latest entry.
+// The bytes are exact. The entries are estimated by the average entry size since start.
+// The latestUpdatedAtNs is the time of the latest appended entry, 0 if nothing appended since start.
+func (m *LogManager) Lag(segment uint32, offset int64) (entries uint64, bytes uint64, latestUpdatedAtNs uint64) {
+
+	latestSegment, latestOffset := m.GetSegmentOffset()
+	if segment > latestSegment || segment == latestSegment && offset >= latestOffset {
+		return 0, 0, atomic.LoadUint64(&m.latestUpdatedAtNs)
+	}
+
+	m.filesLock.RLock()
+	behind := int64(0)
+	for s := segment; s < latestSegment; s++ {
+		if oneLogFile, ok := m.files[s]; ok {
+			behind += oneLogFile.size()
+		}
+	}
+	m.filesLock.RUnlock()
+	behind += latestOffset - offset
+	if behind < 0 {
+		behind = 0
+	}
+
+	bytes = uint64(behind)
+	if appendedEntries := atomic.LoadUint64(&m.appendedEntries); appendedEntries > 0 {
+		averageEntrySize := atomic.LoadUint64(&m.appendedBytes) / appendedEntries
+		if averageEntrySize > 0 {
+			entries = (bytes + averageEntrySize - 1) / averageEntrySize
+		}
+	}
+
+	return entries, bytes, atomic.LoadUint64(&m.latestUpdatedAtNs)
+}
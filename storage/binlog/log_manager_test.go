@@ -1,9 +1,12 @@
 package binlog
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/crypt"
 	"github.com/magiconair/properties/assert"
+	"io/ioutil"
 	"os"
 	"path"
 	"testing"
@@ -110,3 +113,80 @@ func TestLargeLogManager(t *testing.T) {
 	// os.RemoveAll(dir)
 
 }
+
+func TestLogManagerLag(t *testing.T) {
+
+	dir := path.Join(os.TempDir(), "vasto_test_lag")
+	os.RemoveAll(dir)
+	os.MkdirAll(dir, 0755)
+	defer os.RemoveAll(dir)
+
+	m := NewLogManager(dir, 2, 100, 3)
+	m.Initialze()
+
+	for i := 0; i < 10; i++ {
+		m.AppendEntry(&pb.LogEntry{
+			UpdatedAtNs: uint64(i + 1),
+			Put: &pb.PutRequest{
+				Key:   []byte(fmt.Sprintf("key %4d", i)),
+				Value: []byte(fmt.Sprintf("value %4d", i)),
+			},
+		})
+	}
+
+	segment, offset := m.GetSegmentOffset()
+
+	entries, bytes, latestUpdatedAtNs := m.Lag(segment, offset)
+	assert.Equal(t, entries, uint64(0), "caught up entries")
+	assert.Equal(t, bytes, uint64(0), "caught up bytes")
+	assert.Equal(t, latestUpdatedAtNs, uint64(10), "latest updated at")
+
+	entries, bytes, _ = m.Lag(segment-1, 0)
+	if bytes <= uint64(offset) {
+		t.Errorf("lag bytes %d should include the previous segment", bytes)
+	}
+	if entries == 0 || entries > 10 {
+		t.Errorf("unexpected lag entries %d", entries)
+	}
+
+	m.Shutdown()
+
+}
+
+func TestEncryptedLogManager(t *testing.T) {
+
+	dir := path.Join(os.TempDir(), "vasto_test_encrypted")
+	os.RemoveAll(dir)
+	os.MkdirAll(dir, 0755)
+	defer os.RemoveAll(dir)
+
+	keyFile := path.Join(dir, "keys")
+	ioutil.WriteFile(keyFile, []byte("ks1 1 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f\n"), 0600)
+	provider, err := crypt.NewKeyFileProvider(keyFile)
+	assert.Equal(t, err, nil, "load keys")
+
+	m := NewLogManager(dir, 2, 1024*1024, 3)
+	m.SetCipher(crypt.NewCipher(provider, "ks1"))
+	m.Initialze()
+
+	for i := 0; i < 10; i++ {
+		m.AppendEntry(&pb.LogEntry{
+			UpdatedAtNs: 2342342,
+			Put: &pb.PutRequest{
+				Key:   []byte(fmt.Sprintf("key %4d", i)),
+				Value: []byte(fmt.Sprintf("secret %4d", i)),
+			},
+		})
+	}
+
+	entries, _, err := m.ReadEntries(0, 0, 10)
+	assert.Equal(t, err, nil, "read encrypted entries")
+	assert.Equal(t, len(entries), 10, "read encrypted entries count")
+	assert.Equal(t, string(entries[3].GetPut().Value), "secret    3", "decrypted value")
+
+	m.Shutdown()
+
+	data, _ := ioutil.ReadFile(m.getFileName(0))
+	assert.Equal(t, bytes.Contains(data, []byte("secret")), false, "plain text in binlog")
+
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/crypt"
 	"github.com/golang/protobuf/proto"
 	"io"
 	"os"
 	"sync"
 )
 
+// encryptedFlag is set in the size of encrypted log entries
+const encryptedFlag = uint32(1) << 31
+
 type logSegmentFile struct {
 	fullName        string
 	segment         uint32
@@ -22,9 +26,10 @@ type logSegmentFile struct {
 	logFileMaxSize  int64
 	hasShutdown     bool
 	accessLock      sync.Mutex
+	cipher          *crypt.Cipher
 }
 
-func newLogSegmentFile(fillName string, segment uint32, logFileMaxSize int64) *logSegmentFile {
+func newLogSegmentFile(fillName string, segment uint32, logFileMaxSize int64, cipher *crypt.Cipher) *logSegmentFile {
 	return &logSegmentFile{
 		fullName:        fillName,
 		segment:         segment,
@@ -32,6 +37,7 @@ func newLogSegmentFile(fillName string, segment uint32, logFileMaxSize int64) *l
 		sizeBufForRead:  make([]byte, 4),
 		followerCond:    &sync.Cond{L: &sync.Mutex{}},
 		logFileMaxSize:  logFileMaxSize,
+		cipher:          cipher,
 	}
 }
 
@@ -42,6 +48,13 @@ func (f *logSegmentFile) appendEntry(entry *pb.LogEntry) (err error) {
 	if err != nil {
 		return fmt.Errorf("appendEntry marshal log entry: %v", err)
 	}
+	sizeFlag := uint32(0)
+	if f.cipher.Enabled() {
+		if encodedData, err = f.cipher.Encrypt(encodedData); err != nil {
+			return fmt.Errorf("appendEntry encrypt log entry: %v", err)
+		}
+		sizeFlag = encryptedFlag
+	}
 
 	// lock writeBuffer, sizeBufForWrite, and file writes
 	f.accessLock.Lock()
@@ -51,7 +64,7 @@ func (f *logSegmentFile) appendEntry(entry *pb.LogEntry) (err error) {
 	dataLen := len(encodedData)
 	// glog.V(0).Infof("entry size %d: %v", dataLen, entry)
 
-	binary.LittleEndian.PutUint32(f.sizeBufForWrite, uint32(dataLen))
+	binary.LittleEndian.PutUint32(f.sizeBufForWrite, uint32(dataLen)|sizeFlag)
 	if _, err := f.file.WriteAt(f.sizeBufForWrite, f.offset); err != nil {
 		return fmt.Errorf("appendEntry write log entry size: %v", err)
 	}
@@ -127,6 +140,8 @@ func (f *logSegmentFile) readOneEntry(offset int64) (entry *pb.LogEntry, nextOff
 		return nil, 0, fmt.Errorf("read %d bytes for size info", sizeLen)
 	}
 	dataLen := binary.LittleEndian.Uint32(f.sizeBufForRead)
+	isEncrypted := dataLen&encryptedFlag != 0
+	dataLen &^= encryptedFlag
 	data := make([]byte, dataLen)
 	n, err := f.file.ReadAt(data, offset+4)
 	if err != nil && err != io.EOF {
@@ -137,13 +152,19 @@ func (f *logSegmentFile) readOneEntry(offset int64) (entry *pb.LogEntry, nextOff
 		return nil, 0, fmt.Errorf("read wrong data size: %d, expecting %d", n, dataLen)
 	}
 
+	if isEncrypted {
+		if data, err = f.cipher.Decrypt(data); err != nil {
+			return nil, 0, fmt.Errorf("readOneEntry decrypt: %v", err)
+		}
+	}
+
 	// unmarshal log entry
 	entry = &pb.LogEntry{}
 	if err := proto.Unmarshal(data, entry); err != nil {
 		glog.Warningf("unmarshal pb.LogEntry size %d %v: %v", dataLen, entry, err)
 		return nil, 0, fmt.Errorf("readOneEntry unmarshal: %v", err)
 	}
-	return entry, offset + int64(dataLen+4), nil
+	return entry, offset + int64(dataLen) + 4, nil
 
 }
 
@@ -170,6 +191,20 @@ func (f *logSegmentFile) open() error {
 	return nil
 }
 
+// size returns the written size of the file, which may be closed already.
+func (f *logSegmentFile) size() int64 {
+	f.followerCond.L.Lock()
+	isOpen, offset := f.file != nil, f.offset
+	f.followerCond.L.Unlock()
+	if isOpen {
+		return offset
+	}
+	if stat, err := os.Stat(f.fullName); err == nil {
+		return stat.Size()
+	}
+	return 0
+}
+
 func (f *logSegmentFile) close() {
 
 	f.followerCond.L.Lock()
@@ -54,11 +54,19 @@ func GetPartitionHashFromBytes(b []byte) uint64 {
 	return binary.LittleEndian.Uint64(b[0:8])
 }
 
+// GetUpdatedAtNsFromBytes reads the update time directly from bytes
+func GetUpdatedAtNsFromBytes(b []byte) uint64 {
+	if len(b) < 16 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b[8:16])
+}
+
 // IsExpired checks whether the entry updated_at time plus ttl time is less than current time.
 // If ttlSecond is 0, the entry will not expire.
 func (e *Entry) IsExpired() bool {
 
 	return e.TtlSecond > 0 &&
-		e.UpdatedAtNs+uint64(e.TtlSecond*1e9) < uint64(time.Now().UnixNano())
+		e.UpdatedAtNs+uint64(e.TtlSecond)*1e9 < uint64(time.Now().UnixNano())
 
 }
@@ -0,0 +1,108 @@
+package codec
+
+import (
+	"github.com/chrislusf/vasto/storage/crypt"
+)
+
+// EncryptedFlag marks the entry value as encrypted, in the high bit of OpAndDataType.
+// The rest of the entry header is kept in plain text for sharding, ttl, and timestamp checks.
+const EncryptedFlag OpAndDataType = 0x80
+
+const headerSize = 21
+
+// IsEncrypted checks whether the entry value is encrypted.
+func (e *Entry) IsEncrypted() bool {
+	return e.OpAndDataType&EncryptedFlag != 0
+}
+
+// Encrypt encrypts the entry value with the active key, if the keyspace is encrypted.
+func (e *Entry) Encrypt(c *crypt.Cipher) error {
+	if e.IsEncrypted() || !c.Enabled() {
+		return nil
+	}
+	value, err := c.Encrypt(e.Value)
+	if err != nil {
+		return err
+	}
+	e.Value = value
+	e.OpAndDataType |= EncryptedFlag
+	return nil
+}
+
+// Decrypt decrypts the entry value if it is encrypted.
+func (e *Entry) Decrypt(c *crypt.Cipher) error {
+	if !e.IsEncrypted() {
+		return nil
+	}
+	value, err := c.Decrypt(e.Value)
+	if err != nil {
+		return err
+	}
+	e.Value = value
+	e.OpAndDataType &^= EncryptedFlag
+	return nil
+}
+
+// EncryptBytes encrypts the serialized entry, if the keyspace is encrypted.
+func EncryptBytes(c *crypt.Cipher, b []byte) ([]byte, error) {
+	if len(b) < headerSize || isEncryptedBytes(b) || !c.Enabled() {
+		return b, nil
+	}
+	value, err := c.Encrypt(b[headerSize:])
+	if err != nil {
+		return nil, err
+	}
+	return joinHeader(b, value, true), nil
+}
+
+// DecryptBytes returns the serialized entry with the value in plain text.
+func DecryptBytes(c *crypt.Cipher, b []byte) ([]byte, error) {
+	if len(b) < headerSize || !isEncryptedBytes(b) {
+		return b, nil
+	}
+	value, err := c.Decrypt(b[headerSize:])
+	if err != nil {
+		return nil, err
+	}
+	return joinHeader(b, value, false), nil
+}
+
+// RotateBytes re-encrypts the serialized entry with the active key, encrypts it if the keyspace
+// becomes encrypted, or decrypts it if the keyspace is no longer encrypted.
+// It returns nil if the entry does not need to change.
+func RotateBytes(c *crypt.Cipher, b []byte) ([]byte, error) {
+	if len(b) < headerSize {
+		return nil, nil
+	}
+	enabled := c.Enabled()
+	if !isEncryptedBytes(b) {
+		if !enabled {
+			return nil, nil
+		}
+		return EncryptBytes(c, b)
+	}
+	if enabled && c.IsActive(b[headerSize:]) {
+		return nil, nil
+	}
+	plain, err := DecryptBytes(c, b)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptBytes(c, plain)
+}
+
+func isEncryptedBytes(b []byte) bool {
+	return OpAndDataType(b[headerSize-1])&EncryptedFlag != 0
+}
+
+func joinHeader(b []byte, value []byte, isEncrypted bool) []byte {
+	t := make([]byte, headerSize+len(value))
+	copy(t, b[:headerSize])
+	if isEncrypted {
+		t[headerSize-1] |= byte(EncryptedFlag)
+	} else {
+		t[headerSize-1] &^= byte(EncryptedFlag)
+	}
+	copy(t[headerSize:], value)
+	return t
+}
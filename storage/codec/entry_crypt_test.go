@@ -0,0 +1,97 @@
+package codec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/crypt"
+	"github.com/chrislusf/vasto/util"
+)
+
+type testKeyProvider struct {
+	keys        map[uint32][]byte
+	activeKeyId uint32
+}
+
+func (p *testKeyProvider) ActiveKey(keyspace string) (uint32, []byte, bool) {
+	key, found := p.keys[p.activeKeyId]
+	return p.activeKeyId, key, found
+}
+
+func (p *testKeyProvider) Key(keyspace string, keyId uint32) ([]byte, bool) {
+	key, found := p.keys[keyId]
+	return key, found
+}
+
+func TestEntryEncryption(t *testing.T) {
+
+	provider := &testKeyProvider{
+		keys: map[uint32][]byte{
+			1: bytes.Repeat([]byte{1}, 32),
+			2: bytes.Repeat([]byte{2}, 32),
+		},
+		activeKeyId: 1,
+	}
+	c := crypt.NewCipher(provider, "ks1")
+
+	entry := &Entry{
+		PartitionHash: 123,
+		UpdatedAtNs:   456,
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_FLOAT64),
+		Value:         util.Float64ToBytes(3.5),
+	}
+	if err := entry.Encrypt(c); err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	b := entry.ToBytes()
+
+	if GetPartitionHashFromBytes(b) != 123 || !FromBytes(b).IsEncrypted() {
+		t.Errorf("expected plain header and encrypted value")
+	}
+
+	// merge needs the plain values
+	plain, err := DecryptBytes(c, b)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	merged, _ := Merge(plain, plain)
+	if x := util.BytesToFloat64(FromBytes(merged).Value); x != 7 {
+		t.Errorf("merged value %v", x)
+	}
+
+	// nothing to rotate with the active key
+	if rotated, err := RotateBytes(c, b); err != nil || rotated != nil {
+		t.Errorf("unexpected rotation %x: %v", rotated, err)
+	}
+
+	provider.activeKeyId = 2
+	rotated, err := RotateBytes(c, b)
+	if err != nil || rotated == nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	if !c.IsActive(rotated[headerSize:]) {
+		t.Errorf("expected rotated to the active key")
+	}
+
+	e := FromBytes(rotated)
+	if err := e.Decrypt(c); err != nil {
+		t.Fatalf("decrypt rotated: %v", err)
+	}
+	if e.IsEncrypted() || e.OpAndDataType != OpAndDataType(pb.OpAndDataType_FLOAT64) || util.BytesToFloat64(e.Value) != 3.5 {
+		t.Errorf("unexpected decrypted entry %+v", e)
+	}
+
+	// not encrypted any more
+	provider.activeKeyId = 3
+	decrypted, err := RotateBytes(c, rotated)
+	if err != nil || !bytes.Equal(decrypted, e.ToBytes()) {
+		t.Errorf("expected decrypted entry: %v", err)
+	}
+
+	// no cipher for plain keyspaces
+	if b, _ := EncryptBytes(nil, plain); !bytes.Equal(b, plain) {
+		t.Errorf("expected plain entry without cipher")
+	}
+
+}
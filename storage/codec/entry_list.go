@@ -0,0 +1,351 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/crypt"
+)
+
+// The LIST value is a kind byte, the max length and the max age in seconds, 0 for no limit,
+// and the elements, newest first. Each element is its creation time in seconds, a uvarint length, and the bytes.
+//
+// A merge operand is a list of pushes and trims, applied in order. Merging two operands concatenates the ops,
+// since trims are relative to the whole list. Merging an operand into an existing list applies the ops,
+// and then drops the elements over the max length or older than the max age at the operand update time,
+// so all replicas merge to the same value.
+const (
+	listValue byte = 0
+	listOps   byte = 1
+)
+
+const (
+	listPush byte = 0
+	listTrim byte = 1
+)
+
+var (
+	// ErrorBadList error if the value can not be decoded as a list
+	ErrorBadList = errors.New("bad list value")
+)
+
+type listElement struct {
+	createdAtSecond uint64
+	value           []byte
+}
+
+type list struct {
+	maxLength    uint64
+	maxAgeSecond uint64
+	elements     []listElement
+}
+
+type listOp struct {
+	op           byte
+	maxLength    uint64
+	maxAgeSecond uint64
+	elements     []listElement
+	start, stop  int64
+}
+
+type listReader struct {
+	b   []byte
+	err error
+}
+
+func (r *listReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = ErrorBadList
+		return 0
+	}
+	r.b = r.b[n:]
+	return x
+}
+
+func (r *listReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Varint(r.b)
+	if n <= 0 {
+		r.err = ErrorBadList
+		return 0
+	}
+	r.b = r.b[n:]
+	return x
+}
+
+func (r *listReader) elements() (elements []listElement) {
+	count := r.uvarint()
+	for i := uint64(0); i < count && r.err == nil; i++ {
+		createdAtSecond := r.uvarint()
+		length := r.uvarint()
+		if r.err != nil || uint64(len(r.b)) < length {
+			r.err = ErrorBadList
+			return nil
+		}
+		elements = append(elements, listElement{createdAtSecond: createdAtSecond, value: r.b[:length]})
+		r.b = r.b[length:]
+	}
+	return elements
+}
+
+// decodeList returns either the list value, or the ops of a merge operand
+func decodeList(b []byte) (l *list, ops []listOp, err error) {
+	if len(b) == 0 {
+		return nil, nil, ErrorBadList
+	}
+	r := &listReader{b: b[1:]}
+	switch b[0] {
+	case listValue:
+		l = &list{}
+		l.maxLength = r.uvarint()
+		l.maxAgeSecond = r.uvarint()
+		l.elements = r.elements()
+	case listOps:
+		for len(r.b) > 0 && r.err == nil {
+			op := listOp{op: r.b[0]}
+			r.b = r.b[1:]
+			switch op.op {
+			case listPush:
+				op.maxLength = r.uvarint()
+				op.maxAgeSecond = r.uvarint()
+				op.elements = r.elements()
+			case listTrim:
+				op.start = r.varint()
+				op.stop = r.varint()
+			default:
+				r.err = ErrorBadList
+			}
+			ops = append(ops, op)
+		}
+	default:
+		return nil, nil, ErrorBadList
+	}
+	if r.err != nil || len(r.b) > 0 {
+		return nil, nil, ErrorBadList
+	}
+	return l, ops, nil
+}
+
+func appendUvarint(b []byte, x uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(b, buf[:binary.PutUvarint(buf, x)]...)
+}
+
+func appendVarint(b []byte, x int64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(b, buf[:binary.PutVarint(buf, x)]...)
+}
+
+func appendListElements(b []byte, elements []listElement) []byte {
+	b = appendUvarint(b, uint64(len(elements)))
+	for _, element := range elements {
+		b = appendUvarint(b, element.createdAtSecond)
+		b = appendUvarint(b, uint64(len(element.value)))
+		b = append(b, element.value...)
+	}
+	return b
+}
+
+func (l *list) encode() []byte {
+	b := []byte{listValue}
+	b = appendUvarint(b, l.maxLength)
+	b = appendUvarint(b, l.maxAgeSecond)
+	return appendListElements(b, l.elements)
+}
+
+func encodeListOps(ops []listOp) []byte {
+	b := []byte{listOps}
+	for _, op := range ops {
+		b = append(b, op.op)
+		switch op.op {
+		case listPush:
+			b = appendUvarint(b, op.maxLength)
+			b = appendUvarint(b, op.maxAgeSecond)
+			b = appendListElements(b, op.elements)
+		case listTrim:
+			b = appendVarint(b, op.start)
+			b = appendVarint(b, op.stop)
+		}
+	}
+	return b
+}
+
+// listRange converts the start and stop indexes, negative ones counted from the end, into a slice range
+func listRange(length int, start, stop int64) (from, to int) {
+	n := int64(length)
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	if start > stop {
+		return 0, 0
+	}
+	return int(start), int(stop) + 1
+}
+
+func (l *list) apply(ops []listOp) {
+	for _, op := range ops {
+		switch op.op {
+		case listPush:
+			if op.maxLength > 0 {
+				l.maxLength = op.maxLength
+			}
+			if op.maxAgeSecond > 0 {
+				l.maxAgeSecond = op.maxAgeSecond
+			}
+			l.elements = append(append([]listElement(nil), op.elements...), l.elements...)
+		case listTrim:
+			from, to := listRange(len(l.elements), op.start, op.stop)
+			l.elements = l.elements[from:to]
+		}
+	}
+}
+
+// enforce drops the elements over the max length, or older than the max age. It returns whether any is dropped.
+func (l *list) enforce(nowSecond uint64) (changed bool) {
+	if l.maxAgeSecond > 0 && nowSecond > l.maxAgeSecond {
+		cutoff := nowSecond - l.maxAgeSecond
+		kept := l.elements[:0]
+		for _, element := range l.elements {
+			if element.createdAtSecond >= cutoff {
+				kept = append(kept, element)
+			}
+		}
+		changed = len(kept) < len(l.elements)
+		l.elements = kept
+	}
+	if l.maxLength > 0 && uint64(len(l.elements)) > l.maxLength {
+		l.elements = l.elements[:l.maxLength]
+		changed = true
+	}
+	return changed
+}
+
+// mergeList merges the operand y into the entry, which starts over if it is not a list.
+func (e *Entry) mergeList(y *Entry) {
+	operand, ops, err := decodeList(y.Value)
+	if err != nil {
+		return
+	}
+	if operand != nil {
+		// a whole list replaces the existing one
+		e.OpAndDataType, e.Value = y.OpAndDataType, y.Value
+		return
+	}
+	var existing *list
+	if e.OpAndDataType == y.OpAndDataType {
+		var existingOps []listOp
+		if existing, existingOps, err = decodeList(e.Value); err == nil && existing == nil {
+			e.Value = encodeListOps(append(existingOps, ops...))
+			return
+		}
+	}
+	if existing == nil {
+		existing = &list{}
+	}
+	existing.apply(ops)
+	existing.enforce(y.UpdatedAtNs / uint64(time.Second))
+	e.OpAndDataType, e.Value = y.OpAndDataType, existing.encode()
+}
+
+// startList applies the ops of a LIST operand to an empty list, when there is no existing value.
+func (e *Entry) startList() {
+	if _, ops, err := decodeList(e.Value); err == nil && ops != nil {
+		l := &list{}
+		l.apply(ops)
+		l.enforce(e.UpdatedAtNs / uint64(time.Second))
+		e.Value = l.encode()
+	}
+}
+
+// TrimList drops the LIST elements over the max length or older than the max age, e.g., during compaction.
+// It returns whether the entry value is changed. Encrypted values are left as is, see TrimEncryptedList.
+func (e *Entry) TrimList(now time.Time) bool {
+	if e.OpAndDataType != OpAndDataType(pb.OpAndDataType_LIST) {
+		return false
+	}
+	l, _, err := decodeList(e.Value)
+	if err != nil || l == nil || !l.enforce(uint64(now.Unix())) {
+		return false
+	}
+	e.Value = l.encode()
+	return true
+}
+
+// TrimEncryptedList is TrimList for the serialized entry with an encrypted LIST value,
+// which is decrypted, trimmed and encrypted again. It returns nil if the entry does not need to change.
+func TrimEncryptedList(c *crypt.Cipher, b []byte, now time.Time) ([]byte, error) {
+	entry := FromBytes(b)
+	if entry == nil || !entry.IsEncrypted() || entry.OpAndDataType&^EncryptedFlag != OpAndDataType(pb.OpAndDataType_LIST) {
+		return nil, nil
+	}
+	if err := entry.Decrypt(c); err != nil {
+		return nil, err
+	}
+	if !entry.TrimList(now) {
+		return nil, nil
+	}
+	if err := entry.Encrypt(c); err != nil {
+		return nil, err
+	}
+	return entry.ToBytes(), nil
+}
+
+// EncodeListPush creates a LIST merge operand to push the elements to the head of the list, the last one first.
+// The maxLength and maxAge, if not 0, change the limits of the list.
+func EncodeListPush(elements [][]byte, maxLength int, maxAge time.Duration, now time.Time) []byte {
+	op := listOp{op: listPush}
+	if maxLength > 0 {
+		op.maxLength = uint64(maxLength)
+	}
+	if maxAge > 0 {
+		op.maxAgeSecond = uint64((maxAge + time.Second - 1) / time.Second)
+	}
+	for i := len(elements) - 1; i >= 0; i-- {
+		op.elements = append(op.elements, listElement{createdAtSecond: uint64(now.Unix()), value: elements[i]})
+	}
+	return encodeListOps([]listOp{op})
+}
+
+// EncodeListTrim creates a LIST merge operand to keep only the elements from start to stop, both inclusive.
+// Negative indexes count from the end, -1 being the last element.
+func EncodeListTrim(start, stop int64) []byte {
+	return encodeListOps([]listOp{{op: listTrim, start: start, stop: stop}})
+}
+
+// DecodeListRange returns the LIST elements from start to stop, both inclusive, newest first.
+// Negative indexes count from the end, -1 being the last element. Elements older than the max age are skipped.
+func DecodeListRange(b []byte, start, stop int64, now time.Time) ([][]byte, error) {
+	l, ops, err := decodeList(b)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		l = &list{}
+		l.apply(ops)
+	}
+	l.enforce(uint64(now.Unix()))
+
+	from, to := listRange(len(l.elements), start, stop)
+	var elements [][]byte
+	for _, element := range l.elements[from:to] {
+		elements = append(elements, element.value)
+	}
+	return elements, nil
+}
@@ -0,0 +1,140 @@
+package codec
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/crypt"
+)
+
+func listElements(values ...string) (elements [][]byte) {
+	for _, value := range values {
+		elements = append(elements, []byte(value))
+	}
+	return elements
+}
+
+func TestMergeList(t *testing.T) {
+
+	now := time.Now()
+
+	existing, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_LIST,
+		EncodeListPush(listElements("a", "b"), 3, 0, now)))
+	// the max length is enforced after all the ops
+	existing.MergeWith(setEntryBytes(pb.OpAndDataType_LIST, EncodeListPush(nil, 5, 0, now)))
+
+	// two operands merged together, the trim applies to the whole list
+	operands, _ := Merge(
+		setEntryBytes(pb.OpAndDataType_LIST, EncodeListPush(listElements("c", "d", "e"), 0, 0, now)),
+		setEntryBytes(pb.OpAndDataType_LIST, EncodeListTrim(0, -2)),
+	)
+
+	existing.MergeWith(operands)
+
+	// e d c b a is trimmed to 4, without the last element
+	elements, err := DecodeListRange(existing.Value, 0, -1, now)
+	if err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	if expected := listElements("e", "d", "c", "b"); !reflect.DeepEqual(elements, expected) {
+		t.Errorf("unexpected elements %q, expected %q", elements, expected)
+	}
+
+	if elements, _ := DecodeListRange(existing.Value, -2, 10, now); !reflect.DeepEqual(elements, listElements("c", "b")) {
+		t.Errorf("unexpected range %q", elements)
+	}
+	if elements, _ := DecodeListRange(existing.Value, 2, 1, now); len(elements) != 0 {
+		t.Errorf("unexpected empty range %q", elements)
+	}
+}
+
+func TestTrimList(t *testing.T) {
+
+	now := time.Now()
+
+	existing, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_LIST,
+		EncodeListPush(listElements("old"), 0, time.Minute, now.Add(-30*time.Second))))
+	existing.MergeWith(setEntryBytes(pb.OpAndDataType_LIST,
+		EncodeListPush(listElements("new"), 0, 0, now)))
+
+	if elements, _ := DecodeListRange(existing.Value, 0, -1, now); !reflect.DeepEqual(elements, listElements("new", "old")) {
+		t.Errorf("unexpected elements %q", elements)
+	}
+
+	if existing.TrimList(now) {
+		t.Errorf("list is trimmed before max age")
+	}
+
+	later := now.Add(45 * time.Second)
+	if elements, _ := DecodeListRange(existing.Value, 0, -1, later); !reflect.DeepEqual(elements, listElements("new")) {
+		t.Errorf("expired elements are read: %q", elements)
+	}
+	if !existing.TrimList(later) {
+		t.Errorf("list is not trimmed after max age")
+	}
+	if elements, _ := DecodeListRange(existing.Value, 0, -1, now); !reflect.DeepEqual(elements, listElements("new")) {
+		t.Errorf("unexpected elements after trim %q", elements)
+	}
+}
+
+func TestMergeListAtOperandTime(t *testing.T) {
+
+	now := time.Now()
+
+	mergeAt := func(updatedAt time.Time) [][]byte {
+		existing, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_LIST,
+			EncodeListPush(listElements("old"), 0, time.Minute, now.Add(-90*time.Second))))
+		operand := FromBytes(setEntryBytes(pb.OpAndDataType_LIST, EncodeListPush(listElements("new"), 0, 0, now)))
+		operand.UpdatedAtNs = uint64(updatedAt.UnixNano())
+		existing.MergeWith(operand.ToBytes())
+		elements, _ := DecodeListRange(existing.Value, 0, -1, now.Add(-90*time.Second))
+		return elements
+	}
+
+	// an operand replayed later trims at its own update time, not the replay time
+	if elements := mergeAt(now.Add(-time.Minute)); !reflect.DeepEqual(elements, listElements("new", "old")) {
+		t.Errorf("elements trimmed after the operand time: %q", elements)
+	}
+	if elements := mergeAt(now); !reflect.DeepEqual(elements, listElements("new")) {
+		t.Errorf("elements not trimmed at the operand time: %q", elements)
+	}
+}
+
+func TestTrimEncryptedList(t *testing.T) {
+
+	c := crypt.NewCipher(&testKeyProvider{
+		keys:        map[uint32][]byte{1: bytes.Repeat([]byte{1}, 32)},
+		activeKeyId: 1,
+	}, "ks1")
+
+	now := time.Now()
+	entry, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_LIST,
+		EncodeListPush(listElements("old"), 0, time.Minute, now.Add(-30*time.Second))))
+	entry.MergeWith(setEntryBytes(pb.OpAndDataType_LIST, EncodeListPush(listElements("new"), 0, 0, now)))
+	if err := entry.Encrypt(c); err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	b := entry.ToBytes()
+
+	if trimmed, err := TrimEncryptedList(c, b, now); err != nil || trimmed != nil {
+		t.Errorf("list is trimmed before max age: %v", err)
+	}
+
+	trimmed, err := TrimEncryptedList(c, b, now.Add(45*time.Second))
+	if err != nil || trimmed == nil {
+		t.Fatalf("list is not trimmed after max age: %v", err)
+	}
+	if !FromBytes(trimmed).IsEncrypted() {
+		t.Errorf("trimmed list is not encrypted")
+	}
+	plain, err := DecryptBytes(c, trimmed)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if elements, _ := DecodeListRange(FromBytes(plain).Value, 0, -1, now); !reflect.DeepEqual(elements, listElements("new")) {
+		t.Errorf("unexpected elements after trim %q", elements)
+	}
+}
@@ -16,7 +16,9 @@ func Merge(a, b []byte) (mergedBytes []byte, merged bool) {
 // MergeEntry merges two []byte into one Entry object.
 func MergeEntry(a, b []byte) (mergedEntry *Entry, merged bool) {
 	if a == nil {
-		return FromBytes(b), true
+		x := FromBytes(b)
+		x.asFirstValue()
+		return x, true
 	}
 
 	x := FromBytes(a)
@@ -51,8 +53,54 @@ func (e *Entry) MergeWith(b []byte) (merged bool) {
 		if left > right {
 			e.Value = y.Value
 		}
+	case OpAndDataType(pb.OpAndDataType_INT64):
+		if !e.isInt64() {
+			e.OpAndDataType, e.Value = y.OpAndDataType, y.Value
+			break
+		}
+		e.Value = util.Int64ToBytes(util.BytesToInt64(e.Value) + util.BytesToInt64(y.Value))
+	case OpAndDataType(pb.OpAndDataType_MAX_INT64):
+		if !e.isInt64() || util.BytesToInt64(e.Value) < util.BytesToInt64(y.Value) {
+			e.OpAndDataType, e.Value = y.OpAndDataType, y.Value
+		}
+	case OpAndDataType(pb.OpAndDataType_MIN_INT64):
+		if !e.isInt64() || util.BytesToInt64(e.Value) > util.BytesToInt64(y.Value) {
+			e.OpAndDataType, e.Value = y.OpAndDataType, y.Value
+		}
+	case OpAndDataType(pb.OpAndDataType_SET):
+		e.mergeCollection(y, false)
+	case OpAndDataType(pb.OpAndDataType_SORTED_SET):
+		e.mergeCollection(y, true)
+	case OpAndDataType(pb.OpAndDataType_HLL):
+		e.mergeHll(y)
+	case OpAndDataType(pb.OpAndDataType_BLOOM):
+		e.mergeBloom(y)
+	case OpAndDataType(pb.OpAndDataType_LIST):
+		e.mergeList(y)
 	}
 
 	return true
 
 }
+
+// isInt64 checks whether the value is an int64, so int64 merges on other values start over.
+func (e *Entry) isInt64() bool {
+	switch e.OpAndDataType {
+	case OpAndDataType(pb.OpAndDataType_INT64), OpAndDataType(pb.OpAndDataType_MAX_INT64), OpAndDataType(pb.OpAndDataType_MIN_INT64):
+		return len(e.Value) == 8
+	}
+	return false
+}
+
+// asFirstValue turns a merge operand into a value, when there is no existing value to merge into.
+func (e *Entry) asFirstValue() {
+	if e == nil {
+		return
+	}
+	switch e.OpAndDataType {
+	case OpAndDataType(pb.OpAndDataType_SET), OpAndDataType(pb.OpAndDataType_SORTED_SET):
+		e.dropCollectionRemoves()
+	case OpAndDataType(pb.OpAndDataType_LIST):
+		e.startList()
+	}
+}
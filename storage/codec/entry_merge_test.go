@@ -90,6 +90,41 @@ func TestMergeMin(t *testing.T) {
 	}
 }
 
+func TestMergeInt64(t *testing.T) {
+
+	int64Entry := func(dataType pb.OpAndDataType, value int64) []byte {
+		return (&Entry{
+			OpAndDataType: OpAndDataType(dataType),
+			Value:         util.Int64ToBytes(value),
+		}).ToBytes()
+	}
+
+	tests := []struct {
+		name     string
+		existing []byte
+		operand  []byte
+		expected int64
+	}{
+		{"add", int64Entry(pb.OpAndDataType_INT64, 1<<60), int64Entry(pb.OpAndDataType_INT64, 3), 1<<60 + 3},
+		{"subtract", int64Entry(pb.OpAndDataType_INT64, 5), int64Entry(pb.OpAndDataType_INT64, -8), -3},
+		{"max", int64Entry(pb.OpAndDataType_MAX_INT64, 234), int64Entry(pb.OpAndDataType_MAX_INT64, 345), 345},
+		{"max kept", int64Entry(pb.OpAndDataType_MAX_INT64, 345), int64Entry(pb.OpAndDataType_MAX_INT64, 234), 345},
+		{"min", int64Entry(pb.OpAndDataType_MIN_INT64, 345), int64Entry(pb.OpAndDataType_MIN_INT64, 234), 234},
+		{"add to bytes", (&Entry{Value: []byte("abc")}).ToBytes(), int64Entry(pb.OpAndDataType_INT64, 7), 7},
+	}
+
+	for _, tt := range tests {
+		mergedBytes, merged := Merge(tt.existing, tt.operand)
+		if !merged {
+			t.Errorf("%s: merge error", tt.name)
+		}
+		mergedEntry := FromBytes(mergedBytes)
+		if len(mergedEntry.Value) != 8 || util.BytesToInt64(mergedEntry.Value) != tt.expected {
+			t.Errorf("%s: merged %x, expecting %d", tt.name, mergedEntry.Value, tt.expected)
+		}
+	}
+}
+
 func TestMergeBytes(t *testing.T) {
 
 	mergedBytes, merged := Merge((&Entry{
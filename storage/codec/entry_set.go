@@ -0,0 +1,222 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// The SET and SORTED_SET values are a kind byte, followed by the members sorted by the member bytes,
+// each as an op byte, a uvarint length, the member, and for sorted sets a float64 score.
+//
+// A merge operand is a list of adds and removes. Merging two operands keeps the last op of each member,
+// so the removes still apply to the existing value later. Merging an operand into an existing set applies the ops,
+// and the result only has the members.
+const (
+	collectionMembers byte = 0
+	collectionOps     byte = 1
+)
+
+const (
+	collectionAdd    byte = 0
+	collectionRemove byte = 1
+)
+
+var (
+	// ErrorBadCollection error if the value can not be decoded as a set or sorted set
+	ErrorBadCollection = errors.New("bad set value")
+	// ErrorNaNScore error if a sorted set score is NaN, which can not be ordered
+	ErrorNaNScore = errors.New("sorted set score is NaN")
+)
+
+// ScoredMember is one member of a sorted set
+type ScoredMember struct {
+	Member []byte
+	Score  float64
+}
+
+type collectionOp struct {
+	op    byte
+	score float64
+}
+
+type collection struct {
+	kind      byte
+	withScore bool
+	members   map[string]collectionOp
+}
+
+func decodeCollection(b []byte, withScore bool) (*collection, error) {
+	if len(b) == 0 {
+		return nil, ErrorBadCollection
+	}
+	c := &collection{
+		kind:      b[0],
+		withScore: withScore,
+		members:   make(map[string]collectionOp),
+	}
+	for b = b[1:]; len(b) > 0; {
+		op := b[0]
+		length, n := binary.Uvarint(b[1:])
+		if n <= 0 || uint64(len(b)-1-n) < length {
+			return nil, ErrorBadCollection
+		}
+		member := string(b[1+n : 1+n+int(length)])
+		b = b[1+n+int(length):]
+		var score float64
+		if withScore && op == collectionAdd {
+			if len(b) < 8 {
+				return nil, ErrorBadCollection
+			}
+			score = math.Float64frombits(binary.LittleEndian.Uint64(b))
+			if math.IsNaN(score) {
+				return nil, ErrorNaNScore
+			}
+			b = b[8:]
+		}
+		c.members[member] = collectionOp{op: op, score: score}
+	}
+	return c, nil
+}
+
+func (c *collection) encode() []byte {
+	keys := make([]string, 0, len(c.members))
+	for member := range c.members {
+		keys = append(keys, member)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.WriteByte(c.kind)
+	lengthBuf := make([]byte, binary.MaxVarintLen64)
+	scoreBuf := make([]byte, 8)
+	for _, member := range keys {
+		op := c.members[member]
+		buf.WriteByte(op.op)
+		buf.Write(lengthBuf[:binary.PutUvarint(lengthBuf, uint64(len(member)))])
+		buf.WriteString(member)
+		if c.withScore && op.op == collectionAdd {
+			binary.LittleEndian.PutUint64(scoreBuf, math.Float64bits(op.score))
+			buf.Write(scoreBuf)
+		}
+	}
+	return buf.Bytes()
+}
+
+// apply merges the ops in y. The removed members are dropped if c has the members,
+// or kept as removes if c is also an operand.
+func (c *collection) apply(y *collection) {
+	for member, op := range y.members {
+		if op.op == collectionRemove && c.kind == collectionMembers {
+			delete(c.members, member)
+		} else {
+			c.members[member] = op
+		}
+	}
+}
+
+// toMembers turns an operand into a value, when there is no existing value.
+func (c *collection) toMembers() {
+	for member, op := range c.members {
+		if op.op == collectionRemove {
+			delete(c.members, member)
+		}
+	}
+	c.kind = collectionMembers
+}
+
+// mergeCollection merges the operand y into the entry, which starts over if it is not of the same type.
+func (e *Entry) mergeCollection(y *Entry, withScore bool) {
+	operand, err := decodeCollection(y.Value, withScore)
+	if err != nil {
+		return
+	}
+	var existing *collection
+	if e.OpAndDataType == y.OpAndDataType {
+		existing, _ = decodeCollection(e.Value, withScore)
+	}
+	if existing == nil {
+		operand.toMembers()
+		existing = operand
+	} else {
+		existing.apply(operand)
+	}
+	e.OpAndDataType, e.Value = y.OpAndDataType, existing.encode()
+}
+
+// dropCollectionRemoves turns a SET or SORTED_SET operand into a value, when there is no existing value.
+func (e *Entry) dropCollectionRemoves() {
+	withScore := e.OpAndDataType == OpAndDataType(pb.OpAndDataType_SORTED_SET)
+	if c, err := decodeCollection(e.Value, withScore); err == nil && c.kind == collectionOps {
+		c.toMembers()
+		e.Value = c.encode()
+	}
+}
+
+// EncodeSetOps creates a SET merge operand to add and remove the members
+func EncodeSetOps(adds, removes [][]byte) []byte {
+	c := &collection{kind: collectionOps, members: make(map[string]collectionOp)}
+	for _, member := range removes {
+		c.members[string(member)] = collectionOp{op: collectionRemove}
+	}
+	for _, member := range adds {
+		c.members[string(member)] = collectionOp{op: collectionAdd}
+	}
+	return c.encode()
+}
+
+// DecodeSetMembers returns the sorted members of a SET value
+func DecodeSetMembers(b []byte) (members [][]byte, err error) {
+	c, err := decodeCollection(b, false)
+	if err != nil {
+		return nil, err
+	}
+	for member, op := range c.members {
+		if op.op == collectionAdd {
+			members = append(members, []byte(member))
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return bytes.Compare(members[i], members[j]) < 0
+	})
+	return members, nil
+}
+
+// EncodeSortedSetOps creates a SORTED_SET merge operand to set the scores of the members, and remove members
+func EncodeSortedSetOps(adds []ScoredMember, removes [][]byte) ([]byte, error) {
+	c := &collection{kind: collectionOps, withScore: true, members: make(map[string]collectionOp)}
+	for _, member := range removes {
+		c.members[string(member)] = collectionOp{op: collectionRemove}
+	}
+	for _, m := range adds {
+		if math.IsNaN(m.Score) {
+			return nil, ErrorNaNScore
+		}
+		c.members[string(m.Member)] = collectionOp{op: collectionAdd, score: m.Score}
+	}
+	return c.encode(), nil
+}
+
+// DecodeSortedSetMembers returns the members of a SORTED_SET value, with scores in [min, max], sorted by score
+func DecodeSortedSetMembers(b []byte, min, max float64) (members []ScoredMember, err error) {
+	c, err := decodeCollection(b, true)
+	if err != nil {
+		return nil, err
+	}
+	for member, op := range c.members {
+		if op.op == collectionAdd && min <= op.score && op.score <= max {
+			members = append(members, ScoredMember{Member: []byte(member), Score: op.score})
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return bytes.Compare(members[i].Member, members[j].Member) < 0
+	})
+	return members, nil
+}
@@ -0,0 +1,111 @@
+package codec
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func setEntryBytes(dataType pb.OpAndDataType, value []byte) []byte {
+	return (&Entry{
+		OpAndDataType: OpAndDataType(dataType),
+		Value:         value,
+	}).ToBytes()
+}
+
+func TestMergeSet(t *testing.T) {
+
+	existing, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_SET, EncodeSetOps(
+		[][]byte{[]byte("a"), []byte("b"), []byte("c")}, [][]byte{[]byte("x")})))
+
+	// two operands merged together still carry the remove of "b"
+	operands, _ := Merge(
+		setEntryBytes(pb.OpAndDataType_SET, EncodeSetOps(nil, [][]byte{[]byte("b")})),
+		setEntryBytes(pb.OpAndDataType_SET, EncodeSetOps([][]byte{[]byte("d")}, nil)),
+	)
+
+	existing.MergeWith(operands)
+
+	members, err := DecodeSetMembers(existing.Value)
+	if err != nil {
+		t.Fatalf("decode set: %v", err)
+	}
+	expected := [][]byte{[]byte("a"), []byte("c"), []byte("d")}
+	if !reflect.DeepEqual(members, expected) {
+		t.Errorf("unexpected members %q, expected %q", members, expected)
+	}
+
+	if c, _ := decodeCollection(existing.Value, false); c.kind != collectionMembers || len(c.members) != 3 {
+		t.Errorf("removes are kept in the merged value: %+v", c)
+	}
+}
+
+func TestMergeSetOnOtherType(t *testing.T) {
+
+	existing := &Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("not a set"),
+	}
+
+	existing.MergeWith(setEntryBytes(pb.OpAndDataType_SET, EncodeSetOps([][]byte{[]byte("a")}, nil)))
+
+	members, err := DecodeSetMembers(existing.Value)
+	if err != nil || existing.OpAndDataType != OpAndDataType(pb.OpAndDataType_SET) {
+		t.Fatalf("set did not start over: %v %v", existing.OpAndDataType, err)
+	}
+	if !reflect.DeepEqual(members, [][]byte{[]byte("a")}) {
+		t.Errorf("unexpected members %q", members)
+	}
+}
+
+func sortedSetOps(t *testing.T, adds []ScoredMember, removes [][]byte) []byte {
+	ops, err := EncodeSortedSetOps(adds, removes)
+	if err != nil {
+		t.Fatalf("encode sorted set ops: %v", err)
+	}
+	return ops
+}
+
+func TestMergeSortedSet(t *testing.T) {
+
+	existing, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_SORTED_SET, sortedSetOps(t, []ScoredMember{
+		{Member: []byte("a"), Score: 3},
+		{Member: []byte("b"), Score: 1},
+		{Member: []byte("c"), Score: 2},
+	}, nil)))
+
+	existing.MergeWith(setEntryBytes(pb.OpAndDataType_SORTED_SET, sortedSetOps(t, []ScoredMember{
+		{Member: []byte("a"), Score: 0.5},
+		{Member: []byte("d"), Score: 2},
+	}, [][]byte{[]byte("b")})))
+
+	members, err := DecodeSortedSetMembers(existing.Value, 0, 2)
+	if err != nil {
+		t.Fatalf("decode sorted set: %v", err)
+	}
+	expected := []ScoredMember{
+		{Member: []byte("a"), Score: 0.5},
+		{Member: []byte("c"), Score: 2},
+		{Member: []byte("d"), Score: 2},
+	}
+	if !reflect.DeepEqual(members, expected) {
+		t.Errorf("unexpected members %+v, expected %+v", members, expected)
+	}
+}
+
+func TestSortedSetNaNScore(t *testing.T) {
+
+	if _, err := EncodeSortedSetOps([]ScoredMember{{Member: []byte("a"), Score: math.NaN()}}, nil); err != ErrorNaNScore {
+		t.Errorf("encode NaN score: %v", err)
+	}
+
+	// an operand with a NaN score from another client is not merged
+	c := &collection{kind: collectionOps, withScore: true, members: map[string]collectionOp{
+		"a": {op: collectionAdd, score: math.NaN()},
+	}}
+	if _, err := DecodeSortedSetMembers(c.encode(), math.Inf(-1), math.Inf(1)); err != ErrorNaNScore {
+		t.Errorf("decode NaN score: %v", err)
+	}
+}
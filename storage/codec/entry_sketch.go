@@ -0,0 +1,310 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/chrislusf/vasto/util"
+)
+
+// HLL and BLOOM values are fixed size sketches. Small sketches are kept sparse,
+// as a list of the set registers or bits, and turn dense once the list is larger than the sketch itself.
+// Merging takes the max of each HLL register, and ORs the BLOOM bits, so merge operands are just smaller sketches.
+const (
+	sketchSparse byte = 0
+	sketchDense  byte = 1
+)
+
+const (
+	// HllPrecision is the number of hash bits to pick a register, for a standard error of about 0.81%
+	HllPrecision = 14
+	hllRegisters = 1 << HllPrecision
+
+	// DefaultBloomBits is the bloom filter size, for about 100 thousand items at 1% false positives
+	DefaultBloomBits = 1 << 20
+	// DefaultBloomHashes is the number of bits set for each item
+	DefaultBloomHashes = 7
+	// MaxBloomBits caps the bloom filter size to 16MB, since the store allocates the size read from the value
+	MaxBloomBits = 1 << 27
+)
+
+var (
+	// ErrorBadSketch error if the value can not be decoded as a HLL or bloom filter
+	ErrorBadSketch = errors.New("bad sketch value")
+	// ErrorBloomTooLarge error if the bloom filter has more than MaxBloomBits bits
+	ErrorBloomTooLarge = errors.New("bloom filter too large")
+)
+
+// decodeHll returns the dense registers
+func decodeHll(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, ErrorBadSketch
+	}
+	registers := make([]byte, hllRegisters)
+	switch b[0] {
+	case sketchDense:
+		if len(b) != 1+hllRegisters {
+			return nil, ErrorBadSketch
+		}
+		copy(registers, b[1:])
+	case sketchSparse:
+		if (len(b)-1)%3 != 0 {
+			return nil, ErrorBadSketch
+		}
+		for x := b[1:]; len(x) > 0; x = x[3:] {
+			index := binary.BigEndian.Uint16(x)
+			if index >= hllRegisters {
+				return nil, ErrorBadSketch
+			}
+			if x[2] > registers[index] {
+				registers[index] = x[2]
+			}
+		}
+	default:
+		return nil, ErrorBadSketch
+	}
+	return registers, nil
+}
+
+func encodeHll(registers []byte) []byte {
+	count := 0
+	for _, r := range registers {
+		if r != 0 {
+			count++
+		}
+	}
+	if count*3 >= hllRegisters {
+		return append([]byte{sketchDense}, registers...)
+	}
+	b := make([]byte, 1, 1+count*3)
+	b[0] = sketchSparse
+	for index, r := range registers {
+		if r != 0 {
+			b = append(b, byte(index>>8), byte(index), r)
+		}
+	}
+	return b
+}
+
+// hllRegister picks the register by the first bits of the hash, and counts the leading zeros of the rest
+func hllRegister(item []byte) (index int, rank byte) {
+	h := util.Hash(item)
+	index = int(h >> (64 - HllPrecision))
+	rank = byte(bits.LeadingZeros64(h<<HllPrecision|1<<(HllPrecision-1))) + 1
+	return
+}
+
+// mergeHll merges the operand y into the entry, which starts over if it is not of the same type.
+func (e *Entry) mergeHll(y *Entry) {
+	operand, err := decodeHll(y.Value)
+	if err != nil {
+		return
+	}
+	var registers []byte
+	if e.OpAndDataType == y.OpAndDataType {
+		registers, _ = decodeHll(e.Value)
+	}
+	if registers == nil {
+		registers = operand
+	} else {
+		for i, r := range operand {
+			if r > registers[i] {
+				registers[i] = r
+			}
+		}
+	}
+	e.OpAndDataType, e.Value = y.OpAndDataType, encodeHll(registers)
+}
+
+// EncodeHllOps creates a HLL merge operand to add the items
+func EncodeHllOps(items [][]byte) []byte {
+	registers := make([]byte, hllRegisters)
+	for _, item := range items {
+		index, rank := hllRegister(item)
+		if rank > registers[index] {
+			registers[index] = rank
+		}
+	}
+	return encodeHll(registers)
+}
+
+// HllCount estimates the number of distinct items added to any of the HLL values
+func HllCount(values ...[]byte) (uint64, error) {
+	registers := make([]byte, hllRegisters)
+	for _, value := range values {
+		x, err := decodeHll(value)
+		if err != nil {
+			return 0, err
+		}
+		for i, r := range x {
+			if r > registers[i] {
+				registers[i] = r
+			}
+		}
+	}
+
+	m := float64(hllRegisters)
+	sum, zeros := 0.0, 0
+	for _, r := range registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// linear counting is more accurate for small cardinalities
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5), nil
+}
+
+type bloomFilter struct {
+	bits   uint32
+	hashes byte
+	bitmap []byte
+}
+
+func newBloomFilter(numBits uint32, hashes int) *bloomFilter {
+	numBits = uint32((uint64(numBits) + 7) / 8 * 8)
+	if numBits == 0 {
+		numBits = DefaultBloomBits
+	}
+	if hashes <= 0 || hashes > math.MaxUint8 {
+		hashes = DefaultBloomHashes
+	}
+	return &bloomFilter{
+		bits:   numBits,
+		hashes: byte(hashes),
+		bitmap: make([]byte, numBits/8),
+	}
+}
+
+func decodeBloom(b []byte) (*bloomFilter, error) {
+	if len(b) < 6 {
+		return nil, ErrorBadSketch
+	}
+	numBits := binary.BigEndian.Uint32(b[1:5])
+	if numBits == 0 || numBits%8 != 0 || numBits > MaxBloomBits || b[5] == 0 {
+		return nil, ErrorBadSketch
+	}
+	f := newBloomFilter(numBits, int(b[5]))
+	switch x := b[6:]; b[0] {
+	case sketchDense:
+		if len(x) != len(f.bitmap) {
+			return nil, ErrorBadSketch
+		}
+		copy(f.bitmap, x)
+	case sketchSparse:
+		if len(x)%4 != 0 {
+			return nil, ErrorBadSketch
+		}
+		for ; len(x) > 0; x = x[4:] {
+			position := binary.BigEndian.Uint32(x)
+			if position >= numBits {
+				return nil, ErrorBadSketch
+			}
+			f.set(position)
+		}
+	default:
+		return nil, ErrorBadSketch
+	}
+	return f, nil
+}
+
+func (f *bloomFilter) encode() []byte {
+	count := 0
+	for _, x := range f.bitmap {
+		count += bits.OnesCount8(x)
+	}
+	kind := sketchSparse
+	if count*4 >= len(f.bitmap) {
+		kind = sketchDense
+	}
+	b := make([]byte, 6)
+	b[0] = kind
+	binary.BigEndian.PutUint32(b[1:5], f.bits)
+	b[5] = f.hashes
+	if kind == sketchDense {
+		return append(b, f.bitmap...)
+	}
+	position := make([]byte, 4)
+	for i, x := range f.bitmap {
+		for ; x != 0; x &= x - 1 {
+			binary.BigEndian.PutUint32(position, uint32(i*8+bits.TrailingZeros8(x)))
+			b = append(b, position...)
+		}
+	}
+	return b
+}
+
+func (f *bloomFilter) set(position uint32) {
+	f.bitmap[position/8] |= 1 << (position % 8)
+}
+
+func (f *bloomFilter) isSet(position uint32) bool {
+	return f.bitmap[position/8]&(1<<(position%8)) != 0
+}
+
+// positions of the item, by double hashing with the two halves of the hash
+func (f *bloomFilter) positions(item []byte) []uint32 {
+	h := util.Hash(item)
+	h1, h2 := h&math.MaxUint32, h>>32
+	positions := make([]uint32, f.hashes)
+	for i := range positions {
+		positions[i] = uint32((h1 + uint64(i)*h2) % uint64(f.bits))
+	}
+	return positions
+}
+
+// mergeBloom merges the operand y into the entry, which starts over if it is not a bloom filter of the same size.
+func (e *Entry) mergeBloom(y *Entry) {
+	operand, err := decodeBloom(y.Value)
+	if err != nil {
+		return
+	}
+	var existing *bloomFilter
+	if e.OpAndDataType == y.OpAndDataType {
+		existing, _ = decodeBloom(e.Value)
+	}
+	if existing == nil || existing.bits != operand.bits || existing.hashes != operand.hashes {
+		existing = operand
+	} else {
+		for i, x := range operand.bitmap {
+			existing.bitmap[i] |= x
+		}
+	}
+	e.OpAndDataType, e.Value = y.OpAndDataType, existing.encode()
+}
+
+// EncodeBloomOps creates a BLOOM merge operand to add the items.
+// The numBits is rounded up to bytes, at most MaxBloomBits, and all operands of one key should use the same bits and hashes.
+func EncodeBloomOps(numBits uint32, hashes int, items [][]byte) ([]byte, error) {
+	if numBits > MaxBloomBits {
+		return nil, ErrorBloomTooLarge
+	}
+	f := newBloomFilter(numBits, hashes)
+	for _, item := range items {
+		for _, position := range f.positions(item) {
+			f.set(position)
+		}
+	}
+	return f.encode(), nil
+}
+
+// BloomMightContain checks whether the item might have been added to the BLOOM value.
+// False positives are possible, but false negatives are not.
+func BloomMightContain(b []byte, item []byte) (bool, error) {
+	f, err := decodeBloom(b)
+	if err != nil {
+		return false, err
+	}
+	for _, position := range f.positions(item) {
+		if !f.isSet(position) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
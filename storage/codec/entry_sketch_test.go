@@ -0,0 +1,111 @@
+package codec
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestMergeHll(t *testing.T) {
+
+	var existing *Entry
+	total := 0
+	for batch := 0; batch < 20; batch++ {
+		var items [][]byte
+		for i := 0; i < 500; i++ {
+			items = append(items, []byte(fmt.Sprintf("visitor%d", total%8000)))
+			total++
+		}
+		operand := setEntryBytes(pb.OpAndDataType_HLL, EncodeHllOps(items))
+		if existing == nil {
+			existing, _ = MergeEntry(nil, operand)
+		} else {
+			existing.MergeWith(operand)
+		}
+	}
+
+	// 10000 adds of 8000 distinct items
+	count, err := HllCount(existing.Value)
+	if err != nil {
+		t.Fatalf("hll count: %v", err)
+	}
+	if math.Abs(float64(count)-8000) > 8000*0.03 {
+		t.Errorf("hll count %d, expected about 8000", count)
+	}
+	if existing.Value[0] != sketchDense {
+		t.Errorf("hll of 8000 items is still sparse")
+	}
+
+	small := EncodeHllOps([][]byte{[]byte("a"), []byte("b"), []byte("a")})
+	if small[0] != sketchSparse {
+		t.Errorf("hll of 2 items is not sparse")
+	}
+	if count, _ := HllCount(small); count != 2 {
+		t.Errorf("hll count %d, expected 2", count)
+	}
+	if count, _ := HllCount(small, EncodeHllOps([][]byte{[]byte("c")})); count != 3 {
+		t.Errorf("hll union count %d, expected 3", count)
+	}
+}
+
+func TestMergeBloom(t *testing.T) {
+
+	existing, _ := MergeEntry(nil, setEntryBytes(pb.OpAndDataType_BLOOM,
+		bloomOps(t, DefaultBloomBits, DefaultBloomHashes, [][]byte{[]byte("a")})))
+
+	for batch := 0; batch < 10; batch++ {
+		var items [][]byte
+		for i := batch * 100; i < batch*100+100; i++ {
+			items = append(items, []byte(fmt.Sprintf("item%d", i)))
+		}
+		existing.MergeWith(setEntryBytes(pb.OpAndDataType_BLOOM,
+			bloomOps(t, DefaultBloomBits, DefaultBloomHashes, items)))
+	}
+
+	for _, item := range []string{"a", "item0", "item999"} {
+		if found, err := BloomMightContain(existing.Value, []byte(item)); !found || err != nil {
+			t.Errorf("bloom filter misses %s: %v", item, err)
+		}
+	}
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		if found, _ := BloomMightContain(existing.Value, []byte(fmt.Sprintf("other%d", i))); found {
+			falsePositives++
+		}
+	}
+	if falsePositives > 10 {
+		t.Errorf("%d false positives in 1000", falsePositives)
+	}
+
+	// a filter of another size starts over
+	existing.MergeWith(setEntryBytes(pb.OpAndDataType_BLOOM, bloomOps(t, 1024, 3, [][]byte{[]byte("b")})))
+	if found, _ := BloomMightContain(existing.Value, []byte("a")); found {
+		t.Errorf("bloom filter of another size is merged")
+	}
+	if found, _ := BloomMightContain(existing.Value, []byte("b")); !found {
+		t.Errorf("bloom filter misses b")
+	}
+}
+
+func TestBloomTooLarge(t *testing.T) {
+
+	if _, err := EncodeBloomOps(MaxBloomBits+8, DefaultBloomHashes, nil); err != ErrorBloomTooLarge {
+		t.Errorf("encode bloom filter over the max bits: %v", err)
+	}
+
+	// a sparse filter claiming 2^31 bits
+	value := []byte{sketchSparse, 0x80, 0, 0, 0, DefaultBloomHashes}
+	if _, err := BloomMightContain(value, []byte("a")); err != ErrorBadSketch {
+		t.Errorf("decode bloom filter over the max bits: %v", err)
+	}
+}
+
+func bloomOps(t *testing.T, numBits uint32, hashes int, items [][]byte) []byte {
+	ops, err := EncodeBloomOps(numBits, hashes, items)
+	if err != nil {
+		t.Fatalf("encode bloom ops: %v", err)
+	}
+	return ops
+}
@@ -41,3 +41,32 @@ func TestPutRequestConversion(t *testing.T) {
 	}
 
 }
+
+func TestRequestedEncryptedFlag(t *testing.T) {
+
+	putRequest := &pb.PutRequest{
+		Key:           []byte("k"),
+		OpAndDataType: pb.OpAndDataType(EncryptedFlag) | pb.OpAndDataType_BYTES,
+		Value:         []byte("plain text"),
+	}
+
+	if CheckRequestedType(putRequest.OpAndDataType) == nil {
+		t.Errorf("requested encrypted flag is accepted")
+	}
+	if CheckRequestedType(pb.OpAndDataType_BYTES) != nil {
+		t.Errorf("requested bytes type is rejected")
+	}
+
+	if NewPutEntry(putRequest, 1).IsEncrypted() {
+		t.Errorf("put entry is marked as encrypted")
+	}
+	mergeRequest := &pb.MergeRequest{
+		Key:           []byte("k"),
+		OpAndDataType: putRequest.OpAndDataType,
+		Value:         []byte("plain text"),
+	}
+	if NewMergeEntry(mergeRequest, 1).IsEncrypted() {
+		t.Errorf("merge entry is marked as encrypted")
+	}
+
+}
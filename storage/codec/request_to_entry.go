@@ -1,25 +1,41 @@
 package codec
 
-import "github.com/chrislusf/vasto/pb"
+import (
+	"errors"
 
-// NewPutEntry creates an Entry from pb.PutRequest
+	"github.com/chrislusf/vasto/pb"
+)
+
+// ErrorInvalidDataType error if the requested type has bits reserved for the store
+var ErrorInvalidDataType = errors.New("invalid data type")
+
+// CheckRequestedType rejects the requested type with the EncryptedFlag, which marks plain text as encrypted.
+func CheckRequestedType(t pb.OpAndDataType) error {
+	if OpAndDataType(t)&EncryptedFlag != 0 {
+		return ErrorInvalidDataType
+	}
+	return nil
+}
+
+// NewPutEntry creates an Entry from pb.PutRequest.
+// The EncryptedFlag can only be set by Encrypt, so it is cleared from the requested type.
 func NewPutEntry(put *pb.PutRequest, updatedAtNs uint64) *Entry {
 	return &Entry{
 		PartitionHash: put.PartitionHash,
 		UpdatedAtNs:   updatedAtNs,
 		TtlSecond:     put.TtlSecond,
-		OpAndDataType: OpAndDataType(put.OpAndDataType),
+		OpAndDataType: OpAndDataType(put.OpAndDataType) &^ EncryptedFlag,
 		Value:         put.Value,
 	}
 }
 
-// NewMergeEntry creates an Entry from pb.MergeRequest
+// NewMergeEntry creates an Entry from pb.MergeRequest, with the EncryptedFlag cleared as NewPutEntry.
 func NewMergeEntry(m *pb.MergeRequest, updatedAtNs uint64) *Entry {
 	return &Entry{
 		PartitionHash: m.PartitionHash,
 		UpdatedAtNs:   updatedAtNs,
 		TtlSecond:     0,
-		OpAndDataType: OpAndDataType(m.OpAndDataType),
+		OpAndDataType: OpAndDataType(m.OpAndDataType) &^ EncryptedFlag,
 		Value:         m.Value,
 	}
 }
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/dgryski/go-jump"
+)
+
+// CompactionFilter decides which entries to purge or rewrite during compaction,
+// i.e., entries not belonging to the shard, expired entries, and expired LIST elements.
+type CompactionFilter struct {
+	shardId    int32
+	shardCount int
+	isResizing bool
+	// rewrite returns the new value for the kept entries, or nil to keep the value as is
+	rewrite func(value []byte) []byte
+}
+
+// Configure sets the shard to keep entries for.
+func (m *CompactionFilter) Configure(shardId int32, shardCount int) {
+	m.shardId = shardId
+	m.shardCount = shardCount
+}
+
+// SetRewrite changes the values kept during compaction, e.g., to re-encrypt with a new key.
+func (m *CompactionFilter) SetRewrite(rewrite func(value []byte) []byte) {
+	m.rewrite = rewrite
+}
+
+// SetResizing stops purging entries of other shards, in case the resizing fails.
+func (m *CompactionFilter) SetResizing(isResizing bool) {
+	m.isResizing = isResizing
+}
+
+// Filter returns true to remove the entry, or the new value to replace it, or nil to keep it as is.
+func (m *CompactionFilter) Filter(key, val []byte) (bool, []byte) {
+	entry := codec.FromBytes(val)
+	if entry == nil {
+		// vasto specific entries not encoded into Entry
+		glog.V(1).Infof("vasto internal %s: %s", string(key), string(val))
+		return false, nil
+	}
+	if !m.isResizing && m.shardCount > 0 {
+		// do not delete anything if during resizing, in case the resizing fails
+		jumpHash := jump.Hash(entry.PartitionHash, m.shardCount)
+		if m.shardId != jumpHash {
+			// glog.V(1).Infof("skipping shard %d, jumpHash %d, shardCount:%d, skipping %s: %s", m.shardId, jumpHash, m.shardCount, string(key), string(val))
+			return true, nil
+		}
+	}
+	if entry.IsExpired() {
+		return true, nil
+	}
+	var newValue []byte
+	if entry.TrimList(time.Now()) {
+		newValue = entry.ToBytes()
+		val = newValue
+	}
+	if m.rewrite != nil {
+		if rewritten := m.rewrite(val); rewritten != nil {
+			newValue = rewritten
+		}
+	}
+	return false, newValue
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+func TestCompactionFilterTtl(t *testing.T) {
+
+	filter := &CompactionFilter{}
+	filter.Configure(0, 1)
+
+	entry := &codec.Entry{
+		PartitionHash: util.Hash([]byte("k")),
+		UpdatedAtNs:   uint64(time.Now().UnixNano()),
+		TtlSecond:     60,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("v"),
+	}
+	if remove, _ := filter.Filter([]byte("k"), entry.ToBytes()); remove {
+		t.Errorf("unexpired entry is removed")
+	}
+
+	entry.UpdatedAtNs -= uint64(61 * time.Second)
+	if remove, _ := filter.Filter([]byte("k"), entry.ToBytes()); !remove {
+		t.Errorf("expired entry is kept")
+	}
+
+	if remove, _ := filter.Filter([]byte("k"), []byte("internal")); remove {
+		t.Errorf("internal entry is removed")
+	}
+}
+
+func TestCompactionFilterLongTtl(t *testing.T) {
+
+	filter := &CompactionFilter{}
+	filter.Configure(0, 1)
+
+	for _, ttl := range []time.Duration{time.Hour, 24 * time.Hour} {
+		entry := &codec.Entry{
+			PartitionHash: util.Hash([]byte("k")),
+			UpdatedAtNs:   uint64(time.Now().Add(-10 * time.Second).UnixNano()),
+			TtlSecond:     uint32(ttl / time.Second),
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+			Value:         []byte("v"),
+		}
+		if remove, _ := filter.Filter([]byte("k"), entry.ToBytes()); remove {
+			t.Errorf("entry with ttl %v is removed after 10 seconds", ttl)
+		}
+
+		entry.UpdatedAtNs = uint64(time.Now().Add(-ttl - time.Second).UnixNano())
+		if remove, _ := filter.Filter([]byte("k"), entry.ToBytes()); !remove {
+			t.Errorf("entry with ttl %v is kept after expiration", ttl)
+		}
+	}
+}
+
+func TestCompactionFilterSharding(t *testing.T) {
+
+	filter := &CompactionFilter{}
+	filter.Configure(0, 2)
+	filter.SetRewrite(func(value []byte) []byte {
+		return []byte("rewritten")
+	})
+
+	var kept, removed int
+	for i := 0; i < 100; i++ {
+		entry := &codec.Entry{
+			PartitionHash: uint64(i) * 7919,
+			UpdatedAtNs:   uint64(time.Now().UnixNano()),
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+			Value:         []byte("v"),
+		}
+		remove, newValue := filter.Filter(nil, entry.ToBytes())
+		if remove {
+			removed++
+			continue
+		}
+		kept++
+		if string(newValue) != "rewritten" {
+			t.Errorf("kept entry is not rewritten")
+		}
+	}
+	if kept == 0 || removed == 0 {
+		t.Errorf("kept %d, removed %d", kept, removed)
+	}
+
+	filter.SetResizing(true)
+	removed = 0
+	for i := 0; i < 100; i++ {
+		entry := &codec.Entry{PartitionHash: uint64(i) * 7919, Value: []byte("v")}
+		if remove, _ := filter.Filter(nil, entry.ToBytes()); remove {
+			removed++
+		}
+	}
+	if removed != 0 {
+		t.Errorf("removed %d entries during resizing", removed)
+	}
+}
@@ -0,0 +1,146 @@
+// Package crypt encrypts the data at rest with the keys of each keyspace.
+package crypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	keyIdSize = 4
+	nonceSize = 12
+)
+
+var (
+	// ErrNoActiveKey error if the keyspace is not encrypted
+	ErrNoActiveKey = errors.New("no active encryption key")
+	// ErrMissingKey error if the data is encrypted with an unknown key
+	ErrMissingKey = errors.New("missing encryption key")
+)
+
+// Cipher encrypts and decrypts the data of one keyspace, with AES-GCM.
+// The encrypted data is the key id, the nonce, and the sealed data.
+// A nil Cipher means the keyspace is not encrypted.
+type Cipher struct {
+	keyspace string
+	provider KeyProvider
+
+	sync.Mutex
+	aeads map[uint32]*cachedAead
+}
+
+type cachedAead struct {
+	key  []byte
+	aead cipher.AEAD
+}
+
+// NewCipher creates a cipher for the keyspace. It returns nil if there is no key provider.
+func NewCipher(provider KeyProvider, keyspace string) *Cipher {
+	if provider == nil {
+		return nil
+	}
+	return &Cipher{
+		keyspace: keyspace,
+		provider: provider,
+		aeads:    make(map[uint32]*cachedAead),
+	}
+}
+
+// Enabled checks whether new data of the keyspace should be encrypted.
+func (c *Cipher) Enabled() bool {
+	if c == nil {
+		return false
+	}
+	_, _, found := c.provider.ActiveKey(c.keyspace)
+	return found
+}
+
+// Encrypt encrypts the data with the active key of the keyspace.
+func (c *Cipher) Encrypt(plain []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoActiveKey
+	}
+	keyId, key, found := c.provider.ActiveKey(c.keyspace)
+	if !found {
+		return nil, ErrNoActiveKey
+	}
+	aead, err := c.getAead(keyId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, keyIdSize+nonceSize, keyIdSize+nonceSize+len(plain)+aead.Overhead())
+	binary.LittleEndian.PutUint32(data, keyId)
+	nonce := data[keyIdSize : keyIdSize+nonceSize]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %v", err)
+	}
+
+	return aead.Seal(data, nonce, plain, nil), nil
+}
+
+// Decrypt decrypts the data, with the key recorded in the data.
+func (c *Cipher) Decrypt(data []byte) ([]byte, error) {
+	if len(data) < keyIdSize+nonceSize {
+		return nil, fmt.Errorf("encrypted data too short: %d bytes", len(data))
+	}
+	keyId := KeyId(data)
+	if c == nil {
+		return nil, fmt.Errorf("%v %d", ErrMissingKey, keyId)
+	}
+	key, found := c.provider.Key(c.keyspace, keyId)
+	if !found {
+		return nil, fmt.Errorf("%v %d for keyspace %s", ErrMissingKey, keyId, c.keyspace)
+	}
+	aead, err := c.getAead(keyId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := aead.Open(nil, data[keyIdSize:keyIdSize+nonceSize], data[keyIdSize+nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt with key %d for keyspace %s: %v", keyId, c.keyspace, err)
+	}
+	return plain, nil
+}
+
+// IsActive checks whether the data is encrypted with the active key.
+func (c *Cipher) IsActive(data []byte) bool {
+	if c == nil || len(data) < keyIdSize {
+		return false
+	}
+	keyId, _, found := c.provider.ActiveKey(c.keyspace)
+	return found && KeyId(data) == keyId
+}
+
+// KeyId returns the id of the key used to encrypt the data
+func KeyId(data []byte) uint32 {
+	return binary.LittleEndian.Uint32(data[0:keyIdSize])
+}
+
+func (c *Cipher) getAead(keyId uint32, key []byte) (cipher.AEAD, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if cached, found := c.aeads[keyId]; found && bytes.Equal(cached.key, key) {
+		return cached.aead, nil
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("key %d for keyspace %s: %v", keyId, c.keyspace, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("key %d for keyspace %s: %v", keyId, c.keyspace, err)
+	}
+	c.aeads[keyId] = &cachedAead{key: key, aead: aead}
+
+	return aead, nil
+}
@@ -0,0 +1,107 @@
+package crypt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testKey1 = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	testKey2 = "202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"
+)
+
+func writeKeyFile(t *testing.T, file string, lines ...string) {
+	if err := ioutil.WriteFile(file, []byte(strings.Join(lines, "\n")), 0600); err != nil {
+		t.Fatalf("write key file: %v", err)
+	}
+}
+
+func TestEncryptAndRotate(t *testing.T) {
+
+	dir, _ := ioutil.TempDir("", "vasto_keys")
+	defer os.RemoveAll(dir)
+	file := dir + "/keys"
+
+	writeKeyFile(t, file, "# test keys", "ks1 1 "+testKey1)
+	provider, err := NewKeyFileProvider(file)
+	if err != nil {
+		t.Fatalf("load keys: %v", err)
+	}
+
+	c := NewCipher(provider, "ks1")
+	if !c.Enabled() {
+		t.Fatalf("expected ks1 to be encrypted")
+	}
+	if NewCipher(provider, "ks2").Enabled() {
+		t.Errorf("expected ks2 not encrypted")
+	}
+
+	plain := []byte("some private data")
+	data, err := c.Encrypt(plain)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	if bytes.Contains(data, plain) {
+		t.Errorf("plain text found in encrypted data")
+	}
+	if KeyId(data) != 1 || !c.IsActive(data) {
+		t.Errorf("expected active key 1, got %d", KeyId(data))
+	}
+
+	// rotate to a new key
+	writeKeyFile(t, file, "ks1 1 "+testKey1, "ks1 2 "+testKey2)
+	os.Chtimes(file, time.Now().Add(time.Minute), time.Now().Add(time.Minute))
+	provider.checkedAt = time.Time{}
+
+	if c.IsActive(data) {
+		t.Errorf("expected key 1 not active after rotation")
+	}
+	decrypted, err := c.Decrypt(data)
+	if err != nil || !bytes.Equal(decrypted, plain) {
+		t.Fatalf("decrypt with old key: %s %v", decrypted, err)
+	}
+	rotated, _ := c.Encrypt(decrypted)
+	if KeyId(rotated) != 2 {
+		t.Errorf("expected key 2, got %d", KeyId(rotated))
+	}
+
+	// tampered data
+	rotated[len(rotated)-1] ^= 1
+	if _, err := c.Decrypt(rotated); err == nil {
+		t.Errorf("expected error for tampered data")
+	}
+
+	// other keyspaces can not decrypt
+	if _, err := NewCipher(provider, "ks2").Decrypt(data); err == nil {
+		t.Errorf("expected error for missing key")
+	}
+
+}
+
+func TestParseKeys(t *testing.T) {
+
+	for _, bad := range []string{
+		"ks1 1",
+		"ks1 0 " + testKey1,
+		"ks1 1 xyz",
+		"ks1 1 0001",
+		"ks1 1 " + testKey1 + "\nks1 1 " + testKey2,
+	} {
+		if _, _, err := parseKeys([]byte(bad)); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+
+	keys, activeKeyIds, err := parseKeys([]byte("ks1 3 " + testKey1 + "\n\nks1 2 " + testKey2 + "\n"))
+	if err != nil {
+		t.Fatalf("parse keys: %v", err)
+	}
+	if len(keys["ks1"]) != 2 || activeKeyIds["ks1"] != 3 {
+		t.Errorf("unexpected keys %v active %v", keys, activeKeyIds)
+	}
+
+}
@@ -0,0 +1,154 @@
+package crypt
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/glog"
+)
+
+// KeyProvider provides the data keys of the keyspaces.
+type KeyProvider interface {
+	// ActiveKey returns the key to encrypt new data. found is false if the keyspace is not encrypted.
+	ActiveKey(keyspace string) (keyId uint32, key []byte, found bool)
+	// Key returns the key to decrypt existing data.
+	Key(keyspace string, keyId uint32) (key []byte, found bool)
+}
+
+// reloadCheckInterval is how often the key file is checked for changes
+const reloadCheckInterval = 5 * time.Second
+
+// KeyFileProvider reads the keys from a local file, one key per line:
+//
+//	# keyspace key_id hex_encoded_key
+//	users 1 000102...1f
+//	users 2 202122...3f
+//
+// The key with the largest key_id of a keyspace is the active key.
+// The older keys should be kept until the data is compacted and the binlogs are rotated.
+// Keyspaces without keys are not encrypted. The file is reloaded when changed.
+type KeyFileProvider struct {
+	file string
+
+	sync.Mutex
+	keys         map[string]map[uint32][]byte
+	activeKeyIds map[string]uint32
+	modTime      time.Time
+	checkedAt    time.Time
+}
+
+// NewKeyFileProvider loads the keys from the file.
+func NewKeyFileProvider(file string) (*KeyFileProvider, error) {
+	p := &KeyFileProvider{file: file}
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+	p.checkedAt = time.Now()
+	return p, nil
+}
+
+func (p *KeyFileProvider) load() error {
+	info, err := os.Stat(p.file)
+	if err != nil {
+		return fmt.Errorf("stat key file %s: %v", p.file, err)
+	}
+	data, err := ioutil.ReadFile(p.file)
+	if err != nil {
+		return fmt.Errorf("read key file %s: %v", p.file, err)
+	}
+	keys, activeKeyIds, err := parseKeys(data)
+	if err != nil {
+		return fmt.Errorf("parse key file %s: %v", p.file, err)
+	}
+	p.keys, p.activeKeyIds, p.modTime = keys, activeKeyIds, info.ModTime()
+	return nil
+}
+
+func parseKeys(data []byte) (keys map[string]map[uint32][]byte, activeKeyIds map[string]uint32, err error) {
+	keys = make(map[string]map[uint32][]byte)
+	activeKeyIds = make(map[string]uint32)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.Fields(line)
+		if len(parts) != 3 {
+			return nil, nil, fmt.Errorf("line %d: expecting <keyspace> <key_id> <hex_encoded_key>", lineNumber)
+		}
+		keyspace := parts[0]
+		keyId, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil || keyId == 0 {
+			return nil, nil, fmt.Errorf("line %d: key id %s should be a positive number", lineNumber, parts[1])
+		}
+		key, err := hex.DecodeString(parts[2])
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: decode key: %v", lineNumber, err)
+		}
+		if n := len(key); n != 16 && n != 24 && n != 32 {
+			return nil, nil, fmt.Errorf("line %d: key should be 16, 24, or 32 bytes, but %d bytes", lineNumber, n)
+		}
+		if keys[keyspace] == nil {
+			keys[keyspace] = make(map[uint32][]byte)
+		}
+		if _, found := keys[keyspace][uint32(keyId)]; found {
+			return nil, nil, fmt.Errorf("line %d: duplicated key id %d for keyspace %s", lineNumber, keyId, keyspace)
+		}
+		keys[keyspace][uint32(keyId)] = key
+		if uint32(keyId) > activeKeyIds[keyspace] {
+			activeKeyIds[keyspace] = uint32(keyId)
+		}
+	}
+
+	return keys, activeKeyIds, scanner.Err()
+}
+
+// maybeReload reloads the key file if it is changed. The caller should hold the lock.
+func (p *KeyFileProvider) maybeReload() {
+	now := time.Now()
+	if now.Sub(p.checkedAt) < reloadCheckInterval {
+		return
+	}
+	p.checkedAt = now
+	if info, err := os.Stat(p.file); err != nil || info.ModTime().Equal(p.modTime) {
+		return
+	}
+	if err := p.load(); err != nil {
+		glog.Errorf("reload keys, keep using the previous ones: %v", err)
+		return
+	}
+	glog.V(0).Infof("reloaded keys from %s", p.file)
+}
+
+// ActiveKey implements KeyProvider
+func (p *KeyFileProvider) ActiveKey(keyspace string) (keyId uint32, key []byte, found bool) {
+	p.Lock()
+	defer p.Unlock()
+	p.maybeReload()
+
+	keyId, found = p.activeKeyIds[keyspace]
+	if !found {
+		return 0, nil, false
+	}
+	return keyId, p.keys[keyspace][keyId], true
+}
+
+// Key implements KeyProvider
+func (p *KeyFileProvider) Key(keyspace string, keyId uint32) (key []byte, found bool) {
+	p.Lock()
+	defer p.Unlock()
+	p.maybeReload()
+
+	key, found = p.keys[keyspace][keyId]
+	return
+}
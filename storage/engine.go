@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+var (
+	// ErrorMergeFailed error if the merge operator fails to merge the value
+	ErrorMergeFailed = errors.New("merge failed")
+)
+
+// MergeOperator combines the merge operands with the existing value.
+// It has the same methods as gorocksdb.MergeOperator, so one implementation works for all engines.
+type MergeOperator interface {
+	// FullMerge applies the operands, front() first, to the existing value, which is nil if the key does not exist.
+	FullMerge(key, existingValue []byte, operands [][]byte) ([]byte, bool)
+	// PartialMerge combines two operands into one, or returns false if impossible.
+	PartialMerge(key, leftOperand, rightOperand []byte) ([]byte, bool)
+	Name() string
+}
+
+// Engine is the local ordered key value storage of one shard.
+type Engine interface {
+	Put(key []byte, value []byte) error
+	Merge(key []byte, value []byte) error
+	// Get returns nil data if the key is not found.
+	Get(key []byte) (data []byte, err error)
+	Delete(key []byte) error
+	// DeleteRange deletes the keys in [start, end), or all keys from start if end is empty,
+	// except the ones for which keep returns true.
+	// keep is called for every key in the range, so the cost grows with the number of keys.
+	DeleteRange(start, end []byte, keep func(key, value []byte) bool) error
+
+	// PrefixScan paginates through all entries with the prefix, starting after the lastKey if not empty.
+	// The key and value passed to fn are only valid during the call.
+	PrefixScan(prefix, lastKey []byte, limit int, fn func(key, value []byte) bool) error
+	// FullScan passes all entries to fn in batches.
+	FullScan(batchSize uint64, limit uint64, fn func([]*pb.RawKeyValue) error) error
+
+	// IngestBehind bulk loads sorted entries as if they were older than all existing entries,
+	// i.e., existing keys are not overwritten. The writerFunc adds the entries and returns the count.
+	IngestBehind(name string, writerFunc func(add func(key, value []byte) error) (int64, error)) error
+	// HasIngestedBehind checks whether IngestBehind has loaded any entries into this db.
+	HasIngestedBehind() bool
+
+	// SetCompactionForShard purges entries not belonging to the shard during the next compaction.
+	SetCompactionForShard(shardId, shardCount int)
+	// SetCompactionRewrite changes the values kept during compaction. The rewrite returns nil to keep the value as is.
+	SetCompactionRewrite(rewrite func(value []byte) []byte)
+	PrepareForClusterResize()
+	CompleteClusterResize()
+	Compact()
+
+	// LiveFilesSize returns the on-disk size of the data
+	LiveFilesSize() uint64
+
+	Reopen()
+	Close()
+	Destroy()
+	EnsureDirectory()
+}
+
+// EngineOptions are the settings to open the db of one shard
+type EngineOptions struct {
+	MergeOperator MergeOperator
+	// Profile tunes the db, nil for the engine defaults. Engines ignore the settings not applicable to them.
+	Profile *pb.StorageProfile
+	// MemoryBudget is shared by all the dbs on the store, nil for no limit.
+	MemoryBudget *MemoryBudget
+	// EnableStatistics counts the block cache hits and stalls, which costs some cpu on every read and write.
+	EnableStatistics bool
+}
+
+// EngineFactory opens an engine in the directory
+type EngineFactory func(path string, options EngineOptions) Engine
+
+var (
+	engines     = make(map[string]EngineFactory)
+	enginesLock sync.RWMutex
+)
+
+// RegisterEngine makes an engine available by name, usually called in the init() of the engine package.
+func RegisterEngine(name string, factory EngineFactory) {
+	enginesLock.Lock()
+	engines[name] = factory
+	enginesLock.Unlock()
+}
+
+// HasEngine checks whether the engine is registered, i.e., compiled in.
+func HasEngine(name string) bool {
+	enginesLock.RLock()
+	_, found := engines[name]
+	enginesLock.RUnlock()
+	return found
+}
+
+// EngineNames lists the registered engines
+func EngineNames() (names []string) {
+	enginesLock.RLock()
+	for name := range engines {
+		names = append(names, name)
+	}
+	enginesLock.RUnlock()
+	sort.Strings(names)
+	return
+}
+
+// NewEngine opens the named engine in the directory
+func NewEngine(name string, path string, options EngineOptions) (Engine, error) {
+	enginesLock.RLock()
+	factory, found := engines[name]
+	enginesLock.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("storage engine %q is not available, choose from [%s]", name, strings.Join(EngineNames(), ","))
+	}
+	return factory(path, options), nil
+}
@@ -0,0 +1,254 @@
+// Package enginetest is the conformance test suite shared by the storage engines.
+package enginetest
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+// OpenFunc opens an empty engine with the merge operator. Run closes and destroys it after each test.
+type OpenFunc func(t *testing.T, mergeOperator storage.MergeOperator) storage.Engine
+
+// Run runs the conformance tests against the engine opened by open.
+func Run(t *testing.T, open OpenFunc) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, db storage.Engine)
+	}{
+		{"PutGet", testPutGet},
+		{"Merge", testMerge},
+		{"RangeScan", testRangeScan},
+		{"DeleteRange", testDeleteRange},
+		{"IngestBehind", testIngestBehind},
+		{"MergeDuringIngestBehind", testMergeDuringIngestBehind},
+		{"SetCompactionForShard", testSetCompactionForShard},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db := open(t, BytesMergeOperator{})
+			defer func() {
+				db.Close()
+				db.Destroy()
+			}()
+			test.fn(t, db)
+		})
+	}
+}
+
+func testPutGet(t *testing.T, db storage.Engine) {
+
+	if err := db.Put([]byte("k1"), []byte("v1")); err != nil {
+		t.Errorf("put: %v", err)
+	}
+
+	returned, err := db.Get([]byte("k1"))
+	if err != nil || !bytes.Equal(returned, []byte("v1")) {
+		t.Errorf("get %s, %v", returned, err)
+	}
+
+	db.Delete([]byte("k1"))
+	returned, err = db.Get([]byte("k1"))
+	if err != nil || returned != nil {
+		t.Errorf("get deleted %s, %v", returned, err)
+	}
+}
+
+func testMerge(t *testing.T, db storage.Engine) {
+
+	db.Merge([]byte("k1"), []byte("123"))
+	db.Merge([]byte("k1"), []byte("456"))
+
+	returned, _ := db.Get([]byte("k1"))
+	if !bytes.Equal([]byte("123456"), returned) {
+		t.Errorf("data value is different. is(%s) should be(%s)", returned, "123456")
+	}
+}
+
+func testRangeScan(t *testing.T, db storage.Engine) {
+
+	for i := 0; i < 10000; i++ {
+		db.Put([]byte(fmt.Sprintf("k%4d", i)), []byte(fmt.Sprintf("v%4d", i)))
+	}
+
+	var counter1 int
+	db.PrefixScan([]byte("k123"), nil, 5, func(key, value []byte) bool {
+		counter1++
+		return true
+	})
+	if counter1 != 5 {
+		t.Errorf("scanning expecting %d rows, but actual %d rows", 5, counter1)
+	}
+
+	var counter2 int
+	db.PrefixScan([]byte("k123"), []byte("k1234"), 100, func(key, value []byte) bool {
+		counter2++
+		return true
+	})
+	if counter2 != 5 {
+		t.Errorf("scanning expecting %d rows, but actual %d rows", 5, counter2)
+	}
+
+	var counter3 int
+	db.FullScan(1000, 0, func(rows []*pb.RawKeyValue) error {
+		counter3 += len(rows)
+		return nil
+	})
+	if counter3 != 10000 {
+		t.Errorf("full scan expecting %d rows, but actual %d rows", 10000, counter3)
+	}
+}
+
+func testDeleteRange(t *testing.T, db storage.Engine) {
+
+	for i := 0; i < 10; i++ {
+		db.Put([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i)))
+	}
+
+	keepK3 := func(key, value []byte) bool {
+		return bytes.Equal(key, []byte("k3"))
+	}
+	if err := db.DeleteRange([]byte("k2"), []byte("k5"), keepK3); err != nil {
+		t.Fatalf("delete range: %v", err)
+	}
+	if err := db.DeleteRange([]byte("k7"), nil, keepK3); err != nil {
+		t.Fatalf("delete range to the end: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("k%d", i))
+		value, err := db.Get(key)
+		if err != nil {
+			t.Errorf("get %s: %v", key, err)
+		}
+		deleted := i == 2 || i == 4 || i >= 7
+		if deleted != (value == nil) {
+			t.Errorf("key %s deleted %v, value %s", key, deleted, value)
+		}
+	}
+}
+
+func testIngestBehind(t *testing.T, db storage.Engine) {
+
+	db.Put([]byte("k2"), []byte("newer"))
+	db.Put([]byte("k4"), []byte("newer"))
+
+	err := db.IngestBehind("test", func(add func(key, value []byte) error) (int64, error) {
+		// deleted during the ingestion, after the ingested entries are read from the source
+		db.Delete([]byte("k4"))
+		db.DeleteRange([]byte("k5"), []byte("k6"), func(key, value []byte) bool { return false })
+		for _, k := range []string{"k1", "k2", "k3", "k4", "k5"} {
+			if err := add([]byte(k), []byte("older")); err != nil {
+				return 0, err
+			}
+		}
+		return 5, nil
+	})
+	if err != nil {
+		t.Fatalf("ingest: %v", err)
+	}
+
+	if returned, _ := db.Get([]byte("k2")); string(returned) != "newer" {
+		t.Errorf("ingested entry overwrites newer one: %s", returned)
+	}
+	if returned, _ := db.Get([]byte("k3")); string(returned) != "older" {
+		t.Errorf("ingested entry missing: %s", returned)
+	}
+	for _, k := range []string{"k4", "k5"} {
+		if returned, _ := db.Get([]byte(k)); returned != nil {
+			t.Errorf("ingested entry overwrites newer delete of %s: %s", k, returned)
+		}
+	}
+	if !db.HasIngestedBehind() {
+		t.Errorf("ingestion is not recorded")
+	}
+}
+
+// testMergeDuringIngestBehind checks the merges during the ingestion keep the ingested base,
+// as RocksDB applies the merge operands on top of the values ingested behind.
+func testMergeDuringIngestBehind(t *testing.T, db storage.Engine) {
+
+	err := db.IngestBehind("test", func(add func(key, value []byte) error) (int64, error) {
+		db.Merge([]byte("k1"), []byte("b"))
+		db.Merge([]byte("k1"), []byte("c"))
+		// the merges after a put do not depend on the ingested value
+		db.Put([]byte("k2"), []byte("new"))
+		db.Merge([]byte("k2"), []byte("x"))
+		for _, k := range []string{"k1", "k2"} {
+			if err := add([]byte(k), []byte("a")); err != nil {
+				return 0, err
+			}
+		}
+		return 2, nil
+	})
+	if err != nil {
+		t.Fatalf("ingest: %v", err)
+	}
+
+	if returned, _ := db.Get([]byte("k1")); string(returned) != "abc" {
+		t.Errorf("merges during the ingestion lose the ingested base: %s", returned)
+	}
+	if returned, _ := db.Get([]byte("k2")); string(returned) != "newx" {
+		t.Errorf("merges after a put during the ingestion: %s", returned)
+	}
+}
+
+func testSetCompactionForShard(t *testing.T, db storage.Engine) {
+
+	total := 10000
+	shardCount := 5
+	now := uint64(time.Now().Unix())
+
+	for i := 0; i < total; i++ {
+		key := []byte(fmt.Sprintf("k%5d", i))
+		entry := &codec.Entry{
+			PartitionHash: util.Hash(key),
+			UpdatedAtNs:   now,
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+			Value:         []byte(fmt.Sprintf("v%5d", i)),
+		}
+		db.Put(key, entry.ToBytes())
+	}
+
+	db.SetCompactionForShard(0, shardCount)
+	db.Compact()
+
+	var counter int
+	db.PrefixScan(nil, nil, 0, func(key, value []byte) bool {
+		counter++
+		return true
+	})
+	expected := float64(total) / float64(shardCount)
+	if math.Abs(float64(counter)-expected) > expected*0.05 {
+		t.Errorf("scanning expecting %d rows, but actual %d rows", int(expected), counter)
+	}
+
+}
+
+// BytesMergeOperator merges by appending the operands to the existing value.
+type BytesMergeOperator struct {
+}
+
+// FullMerge appends the operands to the existing value
+func (mo BytesMergeOperator) FullMerge(key, existingValue []byte, operands [][]byte) ([]byte, bool) {
+	for _, operand := range operands {
+		existingValue = append(existingValue, operand...)
+	}
+	return existingValue, true
+}
+
+// PartialMerge appends the right operand to the left one
+func (mo BytesMergeOperator) PartialMerge(key, leftOperand, rightOperand []byte) ([]byte, bool) {
+	out := append(leftOperand, rightOperand...)
+	return out, true
+}
+
+// Name is the name of the merge operator
+func (mo BytesMergeOperator) Name() string { return "bytesMergeOperator" }
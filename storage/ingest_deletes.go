@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"bytes"
+	"sync"
+)
+
+// IngestDeletes records the writes during IngestBehind, for the engines ingesting the entries as normal writes,
+// so the ingested entries, which are older than the writes, are placed behind them as RocksDB does:
+// the deleted or put keys are not brought back, and the merges are applied on top of the ingested values.
+type IngestDeletes struct {
+	sync.Mutex
+	ingesting bool
+	keys      map[string]bool // deleted or put, the ingested values do not matter any more
+	ranges    []ingestDeletedRange
+	merges    map[string][][]byte
+}
+
+type ingestDeletedRange struct {
+	start []byte
+	end   []byte
+	keep  func(key, value []byte) bool
+}
+
+// Start records the writes from now on
+func (d *IngestDeletes) Start() {
+	d.Lock()
+	d.ingesting = true
+	d.keys = make(map[string]bool)
+	d.ranges = nil
+	d.merges = make(map[string][][]byte)
+	d.Unlock()
+}
+
+// Stop forgets the recorded writes
+func (d *IngestDeletes) Stop() {
+	d.Lock()
+	d.ingesting = false
+	d.keys = nil
+	d.ranges = nil
+	d.merges = nil
+	d.Unlock()
+}
+
+// RecordDelete records the key deleted during the ingestion
+func (d *IngestDeletes) RecordDelete(key []byte) {
+	d.Lock()
+	if d.ingesting {
+		d.keys[string(key)] = true
+		delete(d.merges, string(key))
+	}
+	d.Unlock()
+}
+
+// RecordPut records the key put during the ingestion, which replaces the ingested value
+func (d *IngestDeletes) RecordPut(key []byte) {
+	d.RecordDelete(key)
+}
+
+// RecordMerge records the merge operand applied during the ingestion, unless the key is already deleted or put.
+// The caller should hold the same lock of the key as when ingesting the key.
+func (d *IngestDeletes) RecordMerge(key, operand []byte) {
+	d.Lock()
+	if d.ingesting && !d.keys[string(key)] {
+		d.merges[string(key)] = append(d.merges[string(key)], append([]byte(nil), operand...))
+	}
+	d.Unlock()
+}
+
+// RecordDeleteRange records the range deleted during the ingestion, with the same keep function.
+func (d *IngestDeletes) RecordDeleteRange(start, end []byte, keep func(key, value []byte) bool) {
+	d.Lock()
+	if d.ingesting {
+		d.ranges = append(d.ranges, ingestDeletedRange{
+			start: append([]byte(nil), start...),
+			end:   append([]byte(nil), end...),
+			keep:  keep,
+		})
+	}
+	d.Unlock()
+}
+
+// IsDeleted checks whether the ingested entry is deleted or put during the ingestion.
+func (d *IngestDeletes) IsDeleted(key, value []byte) bool {
+	d.Lock()
+	defer d.Unlock()
+	if d.keys[string(key)] {
+		return true
+	}
+	for _, r := range d.ranges {
+		if bytes.Compare(key, r.start) >= 0 && (len(r.end) == 0 || bytes.Compare(key, r.end) < 0) && !r.keep(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeOperands returns the operands merged to the key during the ingestion, oldest first,
+// to be applied on top of the ingested value.
+func (d *IngestDeletes) MergeOperands(key []byte) [][]byte {
+	d.Lock()
+	defer d.Unlock()
+	return d.merges[string(key)]
+}
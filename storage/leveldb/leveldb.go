@@ -0,0 +1,218 @@
+package leveldb
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/util"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+func init() {
+	storage.RegisterEngine("leveldb", func(path string, options storage.EngineOptions) storage.Engine {
+		return NewDbWithProfile(path, options.MergeOperator, options.Profile)
+	})
+}
+
+// keyLockCount is the number of striped locks to serialize the writes to the same key,
+// since merges are read-modify-write here.
+const keyLockCount = 256
+
+var (
+	// ErrorShutdownInProgress error if shut down in progress
+	ErrorShutdownInProgress = errors.New("shutdown in progress")
+	// ErrorNoMergeOperator error if merging without a merge operator
+	ErrorNoMergeOperator = errors.New("no merge operator")
+)
+
+// Leveldb is a pure-Go storage engine, based on goleveldb.
+// Merges are applied on write, and the compaction filter is applied when compacting explicitly.
+type Leveldb struct {
+	path             string
+	mergeOperator    storage.MergeOperator
+	profile          *pb.StorageProfile
+	db               *leveldb.DB
+	compactionFilter *storage.CompactionFilter
+	keyLocks         [keyLockCount]sync.Mutex
+	ingestDeletes    storage.IngestDeletes
+
+	// used for locking
+	clientCounter int32
+}
+
+// NewDb creates a local leveldb instance
+func NewDb(path string, mergeOperator storage.MergeOperator) *Leveldb {
+	return NewDbWithProfile(path, mergeOperator, nil)
+}
+
+// NewDbWithProfile creates a local leveldb instance, tuned by the block cache, bloom filter,
+// write buffer and compression of the profile.
+func NewDbWithProfile(path string, mergeOperator storage.MergeOperator, profile *pb.StorageProfile) *Leveldb {
+	d := &Leveldb{
+		path:             path,
+		mergeOperator:    mergeOperator,
+		profile:          profile,
+		compactionFilter: &storage.CompactionFilter{},
+	}
+	d.Reopen()
+
+	return d
+}
+
+// Reopen opens the db again after Close()
+func (d *Leveldb) Reopen() {
+
+	atomic.StoreInt32(&d.clientCounter, 0)
+
+	var err error
+	d.db, err = leveldb.OpenFile(d.path, profileOptions(d.profile))
+	if err != nil {
+		glog.Fatalf("open db at %s : %v", d.path, err)
+	}
+}
+
+func (d *Leveldb) keyLock(key []byte) *sync.Mutex {
+	return &d.keyLocks[util.Hash(key)%keyLockCount]
+}
+
+// do runs fn if the db is not shutting down
+func (d *Leveldb) do(fn func() error) (err error) {
+	if newClientCounter := atomic.AddInt32(&d.clientCounter, 1); newClientCounter > 0 {
+		err = fn()
+	} else {
+		err = ErrorShutdownInProgress
+	}
+	atomic.AddInt32(&d.clientCounter, -1)
+	return
+}
+
+// Put puts to local leveldb
+func (d *Leveldb) Put(key []byte, value []byte) error {
+	return d.do(func() error {
+		lock := d.keyLock(key)
+		lock.Lock()
+		defer lock.Unlock()
+		d.ingestDeletes.RecordPut(key)
+		return d.db.Put(key, value, nil)
+	})
+}
+
+// Merge merges to local leveldb, by applying the merge operator to the existing value.
+func (d *Leveldb) Merge(key []byte, value []byte) error {
+	if d.mergeOperator == nil {
+		return ErrorNoMergeOperator
+	}
+	return d.do(func() error {
+		lock := d.keyLock(key)
+		lock.Lock()
+		defer lock.Unlock()
+		existing, err := d.db.Get(key, nil)
+		if err == leveldb.ErrNotFound {
+			existing, err = nil, nil
+		}
+		if err != nil {
+			return err
+		}
+		merged, ok := d.mergeOperator.FullMerge(key, existing, [][]byte{value})
+		if !ok {
+			return storage.ErrorMergeFailed
+		}
+		d.ingestDeletes.RecordMerge(key, value)
+		return d.db.Put(key, merged, nil)
+	})
+}
+
+// Get gets from local leveldb
+func (d *Leveldb) Get(key []byte) (data []byte, err error) {
+	err = d.do(func() error {
+		data, err = d.db.Get(key, nil)
+		if err == leveldb.ErrNotFound {
+			data = nil
+			return nil
+		}
+		return err
+	})
+	return
+}
+
+// Delete deletes from local leveldb
+func (d *Leveldb) Delete(key []byte) error {
+	return d.do(func() error {
+		lock := d.keyLock(key)
+		lock.Lock()
+		defer lock.Unlock()
+		d.ingestDeletes.RecordDelete(key)
+		return d.db.Delete(key, nil)
+	})
+}
+
+// Destroy removes all data for the local leveldb
+func (d *Leveldb) Destroy() {
+	os.RemoveAll(d.path)
+}
+
+func (d *Leveldb) EnsureDirectory() {
+	os.Mkdir(d.path, 0755)
+}
+
+// Close shuts down local leveldb
+func (d *Leveldb) Close() {
+	for {
+		swapped := atomic.CompareAndSwapInt32(&d.clientCounter, 0, -100)
+		if swapped {
+			break
+		}
+		glog.V(1).Infof("waiting to close db %s ...", d.path)
+		time.Sleep(300 * time.Millisecond)
+	}
+	if err := d.db.Close(); err != nil {
+		glog.Errorf("close db %s: %v", d.path, err)
+	}
+	glog.V(1).Infof("closed db %s", d.path)
+}
+
+// LiveFilesSize returns the size of the table files
+func (d *Leveldb) LiveFilesSize() (sum uint64) {
+	fileInfos, err := ioutil.ReadDir(d.path)
+	if err != nil {
+		return 0
+	}
+	for _, fileInfo := range fileInfos {
+		if strings.HasSuffix(fileInfo.Name(), ".ldb") {
+			sum += uint64(fileInfo.Size())
+		}
+	}
+	return sum
+}
+
+func (d *Leveldb) ingestedBehindMarker() string {
+	return filepath.Join(d.path, "INGESTED_BEHIND")
+}
+
+func profileOptions(profile *pb.StorageProfile) *opt.Options {
+	o := &opt.Options{}
+	if profile == nil {
+		return o
+	}
+	o.BlockCacheCapacity = int(profile.BlockCacheSizeMb) * opt.MiB
+	o.WriteBuffer = int(profile.WriteBufferSizeMb) * opt.MiB
+	if profile.BloomFilterBits > 0 {
+		o.Filter = filter.NewBloomFilter(int(profile.BloomFilterBits))
+	}
+	// goleveldb only compresses all levels or none
+	if len(profile.CompressionPerLevel) > 0 && profile.CompressionPerLevel[len(profile.CompressionPerLevel)-1] == pb.StorageProfile_NONE {
+		o.Compression = opt.NoCompression
+	}
+	return o
+}
@@ -0,0 +1,53 @@
+package leveldb
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// DeleteRange deletes the keys in [start, end), or all keys from start if end is empty,
+// except the ones for which keep returns true.
+// goleveldb has no range tombstones, so the keys are deleted one by one.
+func (d *Leveldb) DeleteRange(start, end []byte, keep func(key, value []byte) bool) error {
+	return d.do(func() error {
+
+		d.ingestDeletes.RecordDeleteRange(start, end, keep)
+
+		keyRange := &util.Range{Start: start}
+		if len(end) > 0 {
+			keyRange.Limit = end
+		}
+		iter := d.db.NewIterator(keyRange, &opt.ReadOptions{DontFillCache: true})
+		defer iter.Release()
+
+		for iter.Next() {
+			if keep(iter.Key(), iter.Value()) {
+				continue
+			}
+			if err := d.deleteUnlessKept(append([]byte(nil), iter.Key()...), keep); err != nil {
+				return err
+			}
+		}
+		return iter.Error()
+	})
+}
+
+// deleteUnlessKept checks the latest value again, in case it is changed after the iterator is created.
+func (d *Leveldb) deleteUnlessKept(key []byte, keep func(key, value []byte) bool) error {
+	lock := d.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	value, err := d.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if keep(key, value) {
+		return nil
+	}
+	return d.db.Delete(key, nil)
+}
@@ -0,0 +1,54 @@
+package leveldb
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/storage"
+)
+
+// IngestBehind writes the entries unless the keys already exist or are deleted during the ingestion,
+// so newer writes and deletes are kept. The merges during the ingestion are applied on top of the ingested values.
+func (d *Leveldb) IngestBehind(name string, writerFunc func(add func(key, value []byte) error) (int64, error)) error {
+	return d.do(func() error {
+
+		d.ingestDeletes.Start()
+		defer d.ingestDeletes.Stop()
+
+		counter, err := writerFunc(func(key, value []byte) error {
+			lock := d.keyLock(key)
+			lock.Lock()
+			defer lock.Unlock()
+			if d.ingestDeletes.IsDeleted(key, value) {
+				return nil
+			}
+			if operands := d.ingestDeletes.MergeOperands(key); len(operands) > 0 {
+				merged, ok := d.mergeOperator.FullMerge(key, append([]byte(nil), value...), operands)
+				if !ok {
+					return storage.ErrorMergeFailed
+				}
+				return d.db.Put(key, merged, nil)
+			}
+			if found, err := d.db.Has(key, nil); err != nil || found {
+				return err
+			}
+			return d.db.Put(key, value, nil)
+		})
+		if err != nil {
+			return err
+		}
+		if counter == 0 {
+			return nil
+		}
+		glog.V(1).Infof("%s: db %s ingested %d entries", name, d.path, counter)
+
+		return ioutil.WriteFile(d.ingestedBehindMarker(), nil, 0644)
+	})
+}
+
+// HasIngestedBehind checks whether IngestBehind has added any entries since the db is created.
+func (d *Leveldb) HasIngestedBehind() bool {
+	_, err := os.Stat(d.ingestedBehindMarker())
+	return err == nil
+}
@@ -0,0 +1,90 @@
+package leveldb
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// PrefixScan paginate through all entries with the prefix
+// the first scan can have empty lastKey and limit = 0
+func (d *Leveldb) PrefixScan(prefix, lastKey []byte, limit int, fn func(key, value []byte) bool) error {
+	return d.do(func() error {
+
+		iter := d.db.NewIterator(util.BytesPrefix(prefix), &opt.ReadOptions{DontFillCache: true})
+		defer iter.Release()
+
+		var hasNext bool
+		if len(lastKey) == 0 {
+			hasNext = iter.First()
+		} else {
+			hasNext = iter.Seek(lastKey)
+			if hasNext && bytes.Equal(iter.Key(), lastKey) {
+				hasNext = iter.Next()
+			}
+		}
+
+		i := 0
+		for ; hasNext; hasNext = iter.Next() {
+			if limit > 0 {
+				i++
+				if i > limit {
+					break
+				}
+			}
+			if !fn(iter.Key(), iter.Value()) {
+				break
+			}
+		}
+
+		if err := iter.Error(); err != nil {
+			return fmt.Errorf("prefix scan iterator: %v", err)
+		}
+		return nil
+	})
+}
+
+// FullScan scan through all entries
+func (d *Leveldb) FullScan(batchSize uint64, limit uint64, fn func([]*pb.RawKeyValue) error) error {
+	return d.do(func() error {
+
+		iter := d.db.NewIterator(nil, &opt.ReadOptions{DontFillCache: true})
+		defer iter.Release()
+
+		var rowCount uint64
+		rows := make([]*pb.RawKeyValue, 0, batchSize)
+		for iter.Next() {
+
+			rowCount++
+			rows = append(rows, &pb.RawKeyValue{
+				Key:   append([]byte(nil), iter.Key()...),
+				Value: append([]byte(nil), iter.Value()...),
+			})
+
+			if rowCount%batchSize == 0 {
+				if err := fn(rows); err != nil {
+					return err
+				}
+				rows = rows[:0]
+			}
+
+			if limit > 0 && rowCount >= limit {
+				break
+			}
+		}
+
+		if len(rows) > 0 {
+			if err := fn(rows); err != nil {
+				return err
+			}
+		}
+
+		if err := iter.Error(); err != nil {
+			return fmt.Errorf("full scan iterate: %v", err)
+		}
+		return nil
+	})
+}
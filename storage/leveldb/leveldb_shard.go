@@ -0,0 +1,79 @@
+package leveldb
+
+import (
+	"bytes"
+
+	"github.com/chrislusf/glog"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// SetCompactionForShard changes the compaction filter to use the shardId and shardCount.
+// All entries not belong to the shard will be physically purged during next Compact().
+func (d *Leveldb) SetCompactionForShard(shardId, shardCount int) {
+	d.compactionFilter.Configure(int32(shardId), shardCount)
+}
+
+// SetCompactionRewrite changes the values kept during compaction, e.g., to re-encrypt with a new key.
+// The rewrite function returns nil to keep the value as is.
+func (d *Leveldb) SetCompactionRewrite(rewrite func(value []byte) []byte) {
+	d.compactionFilter.SetRewrite(rewrite)
+}
+
+func (d *Leveldb) PrepareForClusterResize() {
+	d.compactionFilter.SetResizing(true)
+}
+
+func (d *Leveldb) CompleteClusterResize() {
+	d.compactionFilter.SetResizing(false)
+}
+
+// Compact applies the compaction filter to all entries, and then compacts the whole key range.
+// goleveldb has no compaction filter hook, so this is the only time entries are purged or rewritten.
+func (d *Leveldb) Compact() {
+	err := d.do(func() error {
+
+		iter := d.db.NewIterator(nil, &opt.ReadOptions{DontFillCache: true})
+		defer iter.Release()
+
+		for iter.Next() {
+			if remove, newValue := d.compactionFilter.Filter(iter.Key(), iter.Value()); remove || newValue != nil {
+				if err := d.filterKey(append([]byte(nil), iter.Key()...)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := iter.Error(); err != nil {
+			return err
+		}
+
+		return d.db.CompactRange(util.Range{})
+	})
+	if err != nil {
+		glog.Errorf("compact %s: %v", d.path, err)
+	}
+}
+
+// filterKey applies the compaction filter again to the latest value, in case it is changed after the iterator is created.
+func (d *Leveldb) filterKey(key []byte) error {
+	lock := d.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	value, err := d.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	remove, newValue := d.compactionFilter.Filter(key, value)
+	if remove {
+		return d.db.Delete(key, nil)
+	}
+	if newValue != nil && !bytes.Equal(newValue, value) {
+		return d.db.Put(key, newValue, nil)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package leveldb
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/enginetest"
+)
+
+func TestEngine(t *testing.T) {
+	enginetest.Run(t, func(t *testing.T, mergeOperator storage.MergeOperator) storage.Engine {
+		dir, err := ioutil.TempDir("", "leveldb-test-go")
+		if err != nil {
+			t.Fatalf("temp dir: %v", err)
+		}
+		return NewDb(dir, mergeOperator)
+	})
+}
@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/google/btree"
+)
+
+func init() {
+	storage.RegisterEngine("memory", func(path string, options storage.EngineOptions) storage.Engine {
+		return NewDb(path, options.MergeOperator)
+	})
+}
+
+const (
+	btreeDegree = 32
+	// compactionInterval is how often the compaction filter purges expired entries, like the background compactions of rocksdb
+	compactionInterval = time.Minute
+)
+
+var (
+	// ErrorShutdownInProgress error if shut down in progress
+	ErrorShutdownInProgress = errors.New("shutdown in progress")
+	// ErrorNoMergeOperator error if merging without a merge operator
+	ErrorNoMergeOperator = errors.New("no merge operator")
+)
+
+type item struct {
+	key   []byte
+	value []byte
+}
+
+func (a *item) Less(b btree.Item) bool {
+	return bytes.Compare(a.key, b.(*item).key) < 0
+}
+
+// Memory is an ordered in-memory storage engine, for tests and cache-only keyspaces.
+// The data is lost when the store restarts. The directory is only used by the binlogs.
+type Memory struct {
+	path             string
+	mergeOperator    storage.MergeOperator
+	tree             *btree.BTree
+	lock             sync.RWMutex
+	size             int64
+	hasIngested      bool
+	ingestDeletes    storage.IngestDeletes
+	compactionFilter *storage.CompactionFilter
+	stopCompaction   chan bool
+
+	// used for locking
+	clientCounter int32
+}
+
+// NewDb creates an in-memory db
+func NewDb(path string, mergeOperator storage.MergeOperator) *Memory {
+	d := &Memory{
+		path:             path,
+		mergeOperator:    mergeOperator,
+		tree:             btree.New(btreeDegree),
+		compactionFilter: &storage.CompactionFilter{},
+	}
+	d.Reopen()
+
+	return d
+}
+
+// Reopen starts serving again after Close(), with the data kept in memory.
+func (d *Memory) Reopen() {
+	atomic.StoreInt32(&d.clientCounter, 0)
+	d.stopCompaction = make(chan bool)
+	go d.compactPeriodically(d.stopCompaction)
+}
+
+// do runs fn if the db is not shutting down
+func (d *Memory) do(fn func() error) (err error) {
+	if newClientCounter := atomic.AddInt32(&d.clientCounter, 1); newClientCounter > 0 {
+		err = fn()
+	} else {
+		err = ErrorShutdownInProgress
+	}
+	atomic.AddInt32(&d.clientCounter, -1)
+	return
+}
+
+// set replaces the value, and should be called with the write lock.
+func (d *Memory) set(key, value []byte) {
+	t := &item{key: key, value: value}
+	if old := d.tree.ReplaceOrInsert(t); old != nil {
+		d.size -= int64(len(old.(*item).key) + len(old.(*item).value))
+	}
+	d.size += int64(len(key) + len(value))
+}
+
+// remove deletes the key, and should be called with the write lock.
+func (d *Memory) remove(key []byte) {
+	if old := d.tree.Delete(&item{key: key}); old != nil {
+		d.size -= int64(len(old.(*item).key) + len(old.(*item).value))
+	}
+}
+
+// get returns the value, and should be called with the read or write lock.
+func (d *Memory) get(key []byte) []byte {
+	if t := d.tree.Get(&item{key: key}); t != nil {
+		return t.(*item).value
+	}
+	return nil
+}
+
+// Put puts a copy of the key and value
+func (d *Memory) Put(key []byte, value []byte) error {
+	return d.do(func() error {
+		d.lock.Lock()
+		d.ingestDeletes.RecordPut(key)
+		d.set(copyBytes(key), copyBytes(value))
+		d.lock.Unlock()
+		return nil
+	})
+}
+
+// Merge applies the merge operator to the existing value.
+func (d *Memory) Merge(key []byte, value []byte) error {
+	if d.mergeOperator == nil {
+		return ErrorNoMergeOperator
+	}
+	return d.do(func() error {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		// the existing value is never changed in place, so the merge operator can append to a copy
+		merged, ok := d.mergeOperator.FullMerge(key, copyBytes(d.get(key)), [][]byte{value})
+		if !ok {
+			return storage.ErrorMergeFailed
+		}
+		d.ingestDeletes.RecordMerge(key, value)
+		d.set(copyBytes(key), copyBytes(merged))
+		return nil
+	})
+}
+
+// Get returns the value, which must not be modified.
+func (d *Memory) Get(key []byte) (data []byte, err error) {
+	err = d.do(func() error {
+		d.lock.RLock()
+		data = d.get(key)
+		d.lock.RUnlock()
+		return nil
+	})
+	return
+}
+
+// Delete deletes the key
+func (d *Memory) Delete(key []byte) error {
+	return d.do(func() error {
+		d.lock.Lock()
+		d.ingestDeletes.RecordDelete(key)
+		d.remove(key)
+		d.lock.Unlock()
+		return nil
+	})
+}
+
+// Destroy removes all data, and the directory as other engines do.
+func (d *Memory) Destroy() {
+	d.lock.Lock()
+	d.tree = btree.New(btreeDegree)
+	d.size = 0
+	d.hasIngested = false
+	d.lock.Unlock()
+	os.RemoveAll(d.path)
+}
+
+func (d *Memory) EnsureDirectory() {
+	os.Mkdir(d.path, 0755)
+}
+
+// Close waits for the ongoing requests and stops serving.
+func (d *Memory) Close() {
+	for {
+		swapped := atomic.CompareAndSwapInt32(&d.clientCounter, 0, -100)
+		if swapped {
+			break
+		}
+		glog.V(1).Infof("waiting to close db %s ...", d.path)
+		time.Sleep(300 * time.Millisecond)
+	}
+	close(d.stopCompaction)
+	glog.V(1).Infof("closed db %s", d.path)
+}
+
+// LiveFilesSize returns the bytes of the keys and values in memory
+func (d *Memory) LiveFilesSize() uint64 {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return uint64(d.size)
+}
+
+// MemoryUsage is the same as LiveFilesSize, since all data is in memory
+func (d *Memory) MemoryUsage() uint64 {
+	return d.LiveFilesSize()
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte(nil), b...)
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"bytes"
+
+	"github.com/google/btree"
+)
+
+// DeleteRange deletes the keys in [start, end), or all keys from start if end is empty,
+// except the ones for which keep returns true.
+// The write lock is held during the whole range, so the keys are checked and deleted atomically.
+func (d *Memory) DeleteRange(start, end []byte, keep func(key, value []byte) bool) error {
+	return d.do(func() error {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+
+		d.ingestDeletes.RecordDeleteRange(start, end, keep)
+
+		var keys [][]byte
+		d.tree.AscendGreaterOrEqual(&item{key: start}, func(i btree.Item) bool {
+			t := i.(*item)
+			if len(end) > 0 && bytes.Compare(t.key, end) >= 0 {
+				return false
+			}
+			if !keep(t.key, t.value) {
+				keys = append(keys, t.key)
+			}
+			return true
+		})
+		for _, key := range keys {
+			d.remove(key)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"bytes"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/google/btree"
+)
+
+// scanBatchSize is the number of entries read with the lock held,
+// so the callbacks run without blocking the writes.
+const scanBatchSize = 1024
+
+// ascend calls fn with batches of entries with keys >= start, or > start if exclusive.
+// The values are never changed in place, so they are safe to use after the lock is released.
+func (d *Memory) ascend(start []byte, exclusive bool, fn func(items []*item) bool) {
+	batch := make([]*item, 0, scanBatchSize)
+	for {
+		batch = batch[:0]
+		d.lock.RLock()
+		d.tree.AscendGreaterOrEqual(&item{key: start}, func(i btree.Item) bool {
+			t := i.(*item)
+			if exclusive && bytes.Equal(t.key, start) {
+				return true
+			}
+			batch = append(batch, t)
+			return len(batch) < scanBatchSize
+		})
+		d.lock.RUnlock()
+
+		if len(batch) == 0 || !fn(batch) || len(batch) < scanBatchSize {
+			return
+		}
+		start, exclusive = batch[len(batch)-1].key, true
+	}
+}
+
+// PrefixScan paginate through all entries with the prefix
+// the first scan can have empty lastKey and limit = 0
+func (d *Memory) PrefixScan(prefix, lastKey []byte, limit int, fn func(key, value []byte) bool) error {
+	return d.do(func() error {
+		start, exclusive := prefix, false
+		if len(lastKey) > 0 {
+			start, exclusive = lastKey, true
+		}
+		i := 0
+		d.ascend(start, exclusive, func(items []*item) bool {
+			for _, t := range items {
+				if limit > 0 {
+					i++
+					if i > limit {
+						return false
+					}
+				}
+				if !bytes.HasPrefix(t.key, prefix) {
+					return false
+				}
+				if !fn(t.key, t.value) {
+					return false
+				}
+			}
+			return true
+		})
+		return nil
+	})
+}
+
+// FullScan scan through all entries
+func (d *Memory) FullScan(batchSize uint64, limit uint64, fn func([]*pb.RawKeyValue) error) (err error) {
+	return d.do(func() error {
+		var rowCount uint64
+		rows := make([]*pb.RawKeyValue, 0, batchSize)
+		d.ascend(nil, false, func(items []*item) bool {
+			for _, t := range items {
+				rowCount++
+				rows = append(rows, &pb.RawKeyValue{
+					Key:   t.key,
+					Value: t.value,
+				})
+				if rowCount%batchSize == 0 {
+					if err = fn(rows); err != nil {
+						return false
+					}
+					rows = rows[:0]
+				}
+				if limit > 0 && rowCount >= limit {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			return fn(rows)
+		}
+		return nil
+	})
+}
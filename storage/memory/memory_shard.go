@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/chrislusf/vasto/storage"
+	"github.com/google/btree"
+)
+
+// SetCompactionForShard changes the compaction filter to use the shardId and shardCount.
+// All entries not belong to the shard will be purged during next compaction.
+func (d *Memory) SetCompactionForShard(shardId, shardCount int) {
+	d.compactionFilter.Configure(int32(shardId), shardCount)
+}
+
+// SetCompactionRewrite changes the values kept during compaction, e.g., to re-encrypt with a new key.
+// The rewrite function returns nil to keep the value as is.
+func (d *Memory) SetCompactionRewrite(rewrite func(value []byte) []byte) {
+	d.compactionFilter.SetRewrite(rewrite)
+}
+
+func (d *Memory) PrepareForClusterResize() {
+	d.compactionFilter.SetResizing(true)
+}
+
+func (d *Memory) CompleteClusterResize() {
+	d.compactionFilter.SetResizing(false)
+}
+
+// Compact applies the compaction filter to all entries.
+func (d *Memory) Compact() {
+	d.do(func() error {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+
+		var removed []*item
+		var rewritten []*item
+		d.tree.Ascend(func(i btree.Item) bool {
+			t := i.(*item)
+			remove, newValue := d.compactionFilter.Filter(t.key, t.value)
+			if remove {
+				removed = append(removed, t)
+			} else if newValue != nil {
+				rewritten = append(rewritten, &item{key: t.key, value: copyBytes(newValue)})
+			}
+			return true
+		})
+		for _, t := range removed {
+			d.remove(t.key)
+		}
+		for _, t := range rewritten {
+			d.set(t.key, t.value)
+		}
+		return nil
+	})
+}
+
+func (d *Memory) compactPeriodically(stop chan bool) {
+	ticker := time.NewTicker(compactionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.Compact()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// IngestBehind adds the entries unless the keys already exist or are deleted during the ingestion,
+// so newer writes and deletes are kept. The merges during the ingestion are applied on top of the ingested values.
+func (d *Memory) IngestBehind(name string, writerFunc func(add func(key, value []byte) error) (int64, error)) error {
+	return d.do(func() error {
+		d.ingestDeletes.Start()
+		defer d.ingestDeletes.Stop()
+
+		counter, err := writerFunc(func(key, value []byte) error {
+			d.lock.Lock()
+			defer d.lock.Unlock()
+			if d.ingestDeletes.IsDeleted(key, value) {
+				return nil
+			}
+			if operands := d.ingestDeletes.MergeOperands(key); len(operands) > 0 {
+				merged, ok := d.mergeOperator.FullMerge(key, copyBytes(value), operands)
+				if !ok {
+					return storage.ErrorMergeFailed
+				}
+				d.set(copyBytes(key), copyBytes(merged))
+				return nil
+			}
+			if d.get(key) == nil {
+				d.set(copyBytes(key), copyBytes(value))
+			}
+			return nil
+		})
+		if err == nil && counter > 0 {
+			d.lock.Lock()
+			d.hasIngested = true
+			d.lock.Unlock()
+		}
+		return err
+	})
+}
+
+// HasIngestedBehind checks whether IngestBehind has added any entries since the db is created.
+func (d *Memory) HasIngestedBehind() bool {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.hasIngested
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/storage/enginetest"
+	"github.com/chrislusf/vasto/util"
+)
+
+func TestEngine(t *testing.T) {
+	enginetest.Run(t, func(t *testing.T, mergeOperator storage.MergeOperator) storage.Engine {
+		dir, err := ioutil.TempDir("", "memory-test-go")
+		if err != nil {
+			t.Fatalf("temp dir: %v", err)
+		}
+		return NewDb(dir, mergeOperator)
+	})
+}
+
+func TestTtlCompaction(t *testing.T) {
+
+	db := setupTestDb(t)
+	defer cleanup(db)
+
+	now := uint64(time.Now().UnixNano())
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("k%5d", i))
+		entry := &codec.Entry{
+			PartitionHash: util.Hash(key),
+			UpdatedAtNs:   now,
+			TtlSecond:     uint32(1 + i%2),
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+			Value:         []byte(fmt.Sprintf("v%5d", i)),
+		}
+		db.Put(key, entry.ToBytes())
+	}
+	sizeBefore := db.LiveFilesSize()
+
+	time.Sleep(1200 * time.Millisecond)
+	db.SetCompactionForShard(0, 1)
+	db.Compact()
+
+	var counter int
+	db.PrefixScan(nil, nil, 0, func(key, value []byte) bool {
+		counter++
+		return true
+	})
+	if counter != 50 {
+		t.Errorf("expecting %d unexpired rows, but actual %d rows", 50, counter)
+	}
+	if db.LiveFilesSize()*2 != sizeBefore {
+		t.Errorf("size %d after compaction, %d before", db.LiveFilesSize(), sizeBefore)
+	}
+
+}
+
+func setupTestDb(t *testing.T) *Memory {
+	dir, err := ioutil.TempDir("", "memory-test-go")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	return NewDb(dir, enginetest.BytesMergeOperator{})
+}
+
+func cleanup(db *Memory) {
+	db.Close()
+	db.Destroy()
+}
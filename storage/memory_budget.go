@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"sync"
+)
+
+// MemoryBudget caps the memory used by all the dbs on a store.
+// Instead of allocating them for each db, the dbs of an engine share one block cache
+// and one write buffer limit carved out of the budget.
+type MemoryBudget struct {
+	BlockCacheBytes  uint64
+	WriteBufferBytes uint64
+
+	sharedLock sync.Mutex
+	shared     map[string]SharedMemory
+}
+
+// SharedMemory is the memory shared by all the dbs of one engine
+type SharedMemory interface {
+	// BlockCacheUsage returns the bytes used in the shared block cache
+	BlockCacheUsage() uint64
+}
+
+// MemoryReporter is implemented by the engines that can tell the memory used by the db,
+// e.g., the memtables and the index and filter blocks, not counting the shared block cache.
+type MemoryReporter interface {
+	MemoryUsage() uint64
+}
+
+// NewMemoryBudget splits the budget, a quarter for the write buffers and the rest for the block cache.
+func NewMemoryBudget(budgetMb int) *MemoryBudget {
+	budget := uint64(budgetMb) * 1024 * 1024
+	return &MemoryBudget{
+		BlockCacheBytes:  budget - budget/4,
+		WriteBufferBytes: budget / 4,
+		shared:           make(map[string]SharedMemory),
+	}
+}
+
+// Shared returns the memory shared by the dbs of the engine, which is created on the first call.
+func (b *MemoryBudget) Shared(engine string, create func() SharedMemory) SharedMemory {
+	b.sharedLock.Lock()
+	defer b.sharedLock.Unlock()
+	if shared, found := b.shared[engine]; found {
+		return shared
+	}
+	shared := create()
+	b.shared[engine] = shared
+	return shared
+}
+
+// BlockCacheUsage sums up the usage of the block caches shared by the engines
+func (b *MemoryBudget) BlockCacheUsage() (usage uint64) {
+	b.sharedLock.Lock()
+	defer b.sharedLock.Unlock()
+	for _, shared := range b.shared {
+		usage += shared.BlockCacheUsage()
+	}
+	return
+}
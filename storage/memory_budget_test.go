@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"testing"
+)
+
+type fakeSharedMemory struct {
+	usage uint64
+}
+
+func (f *fakeSharedMemory) BlockCacheUsage() uint64 {
+	return f.usage
+}
+
+func TestMemoryBudget(t *testing.T) {
+
+	b := NewMemoryBudget(1024)
+	if b.BlockCacheBytes+b.WriteBufferBytes != 1024*1024*1024 || b.WriteBufferBytes != 256*1024*1024 {
+		t.Errorf("unexpected split: cache %d, write buffer %d", b.BlockCacheBytes, b.WriteBufferBytes)
+	}
+
+	created := 0
+	create := func() SharedMemory {
+		created++
+		return &fakeSharedMemory{usage: 100}
+	}
+	first := b.Shared("rocksdb", create)
+	second := b.Shared("rocksdb", create)
+	if first != second || created != 1 {
+		t.Errorf("shared memory is created %d times", created)
+	}
+	b.Shared("other", create)
+
+	if usage := b.BlockCacheUsage(); usage != 200 {
+		t.Errorf("block cache usage %d, expected 200", usage)
+	}
+}
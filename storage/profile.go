@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	none = pb.StorageProfile_NONE
+	lz4  = pb.StorageProfile_LZ4
+	zstd = pb.StorageProfile_ZSTD
+
+	// profilePresets are tuned for 7 levels, with the bottommost level holding most of the data
+	profilePresets = map[string]*pb.StorageProfile{
+		// random gets of single keys: bloom filters skip most files, and the hot levels are not compressed
+		"point-lookup": {
+			BlockCacheSizeMb:    256,
+			BloomFilterBits:     10,
+			WriteBufferSizeMb:   64,
+			CompressionPerLevel: []pb.StorageProfile_Compression{none, none, lz4, lz4, lz4, lz4, zstd},
+		},
+		// prefix scans: a large block cache, and no bloom filters since scans can not use them
+		"scan-heavy": {
+			BlockCacheSizeMb:    512,
+			WriteBufferSizeMb:   64,
+			CompressionPerLevel: []pb.StorageProfile_Compression{lz4, lz4, lz4, lz4, lz4, lz4, zstd},
+		},
+		// high ingestion rate: large write buffers, and cheap compression for the frequently compacted levels
+		"write-heavy": {
+			BlockCacheSizeMb:    64,
+			BloomFilterBits:     10,
+			WriteBufferSizeMb:   256,
+			CompressionPerLevel: []pb.StorageProfile_Compression{none, none, none, lz4, lz4, lz4, zstd},
+		},
+	}
+)
+
+// ProfilePresets lists the names of the storage profile presets
+func ProfilePresets() (names []string) {
+	for name := range profilePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
+
+// ResolveProfile fills the storage profile from its preset, and the non-zero fields override the preset.
+func ResolveProfile(profile *pb.StorageProfile) (*pb.StorageProfile, error) {
+	if profile == nil {
+		return nil, nil
+	}
+	if profile.Preset == "" {
+		return profile, nil
+	}
+
+	preset, found := profilePresets[profile.Preset]
+	if !found {
+		return nil, fmt.Errorf("unknown storage profile preset %q, choose from [%s]", profile.Preset, strings.Join(ProfilePresets(), ","))
+	}
+
+	resolved := proto.Clone(preset).(*pb.StorageProfile)
+	resolved.Preset = profile.Preset
+	if profile.BlockCacheSizeMb > 0 {
+		resolved.BlockCacheSizeMb = profile.BlockCacheSizeMb
+	}
+	if profile.BloomFilterBits > 0 {
+		resolved.BloomFilterBits = profile.BloomFilterBits
+	}
+	if len(profile.CompressionPerLevel) > 0 {
+		resolved.CompressionPerLevel = profile.CompressionPerLevel
+	}
+	if profile.WriteBufferSizeMb > 0 {
+		resolved.WriteBufferSizeMb = profile.WriteBufferSizeMb
+	}
+	resolved.UseDirectIo = profile.UseDirectIo
+	resolved.DisableWal = profile.DisableWal
+
+	return resolved, nil
+}
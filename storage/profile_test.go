@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestResolveProfile(t *testing.T) {
+
+	if p, err := ResolveProfile(nil); p != nil || err != nil {
+		t.Errorf("resolve nil profile: %v, %v", p, err)
+	}
+
+	p, err := ResolveProfile(&pb.StorageProfile{
+		Preset:            "write-heavy",
+		WriteBufferSizeMb: 512,
+		DisableWal:        true,
+	})
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if p.WriteBufferSizeMb != 512 || p.BloomFilterBits != 10 || !p.DisableWal || len(p.CompressionPerLevel) != 7 {
+		t.Errorf("unexpected resolved profile: %v", p)
+	}
+	if profilePresets["write-heavy"].WriteBufferSizeMb != 256 {
+		t.Errorf("preset is changed")
+	}
+
+	if _, err = ResolveProfile(&pb.StorageProfile{Preset: "unknown"}); err == nil {
+		t.Errorf("unknown preset is resolved")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
 	"sync/atomic"
 	"time"
 )
@@ -14,16 +16,27 @@ import (
 type Rocks struct {
 	path             string
 	mergeOperator    gorocksdb.MergeOperator
+	profile          *pb.StorageProfile
 	db               *gorocksdb.DB
 	dbOptions        *gorocksdb.Options
+	tableOptions     *gorocksdb.BlockBasedTableOptions
+	blockCache       *gorocksdb.Cache
+	sharedMemory     *sharedMemory
 	wo               *gorocksdb.WriteOptions
 	ro               *gorocksdb.ReadOptions
 	compactionFilter *shardingCompactionFilter
+	enableStatistics bool
 
 	// used for locking
 	clientCounter int32
 }
 
+func init() {
+	storage.RegisterEngine("rocksdb", func(path string, options storage.EngineOptions) storage.Engine {
+		return NewDbWithOptions(path, options)
+	})
+}
+
 var (
 	// ErrorShutdownInProgress error if shut down in progress
 	ErrorShutdownInProgress = errors.New("shutdown in progress")
@@ -31,8 +44,27 @@ var (
 
 // NewDb creates a local rocksdb instance
 func NewDb(path string, mergeOperator gorocksdb.MergeOperator) *Rocks {
+	return openDb(path, mergeOperator, storage.EngineOptions{})
+}
+
+// NewDbWithOptions creates a local rocksdb instance tuned by the storage profile,
+// and sharing the block cache and write buffer limit of the memory budget if any.
+func NewDbWithOptions(path string, options storage.EngineOptions) *Rocks {
+	var mergeOperator gorocksdb.MergeOperator
+	if options.MergeOperator != nil {
+		mergeOperator = options.MergeOperator
+	}
+	return openDb(path, mergeOperator, options)
+}
+
+func openDb(path string, mergeOperator gorocksdb.MergeOperator, options storage.EngineOptions) *Rocks {
 	r := &Rocks{
 		compactionFilter: &shardingCompactionFilter{},
+		profile:          options.Profile,
+		enableStatistics: options.EnableStatistics,
+	}
+	if options.MemoryBudget != nil {
+		r.sharedMemory = getSharedMemory(options.MemoryBudget)
 	}
 	r.setup(path, mergeOperator)
 	r.Reopen()
@@ -52,6 +84,9 @@ func (d *Rocks) Reopen() {
 	d.dbOptions = gorocksdb.NewDefaultOptions()
 	d.dbOptions.SetCreateIfMissing(true)
 	d.dbOptions.SetAllowIngestBehind(true)
+	if d.enableStatistics {
+		d.dbOptions.EnableStatistics()
+	}
 	// d.dbOptions.SetUseDirectReads(true)
 	d.dbOptions.SetCompactionFilter(d.compactionFilter)
 	if d.mergeOperator != nil {
@@ -63,11 +98,16 @@ func (d *Rocks) Reopen() {
 	//d.wo.DisableWAL(true)
 	d.ro = gorocksdb.NewDefaultReadOptions()
 
+	d.applyProfile()
+
 	var err error
 	d.db, err = gorocksdb.OpenDb(d.dbOptions, d.path)
 	if err != nil {
 		glog.Fatalf("open db at %s : %v", d.path, err)
 	}
+	if d.sharedMemory != nil {
+		d.sharedMemory.writeBuffers.add(d)
+	}
 }
 
 // Put puts to local rocksdb
@@ -136,10 +176,21 @@ func (d *Rocks) Close() {
 		glog.V(1).Infof("waiting to close db %s ...", d.path)
 		time.Sleep(300 * time.Millisecond)
 	}
+	if d.sharedMemory != nil {
+		d.sharedMemory.writeBuffers.remove(d)
+	}
 	d.wo.Destroy()
 	d.ro.Destroy()
-	d.dbOptions.Destroy()
 	d.db.Close()
+	d.dbOptions.Destroy()
+	if d.tableOptions != nil {
+		d.tableOptions.Destroy()
+		d.tableOptions = nil
+	}
+	if d.blockCache != nil {
+		d.blockCache.Destroy()
+		d.blockCache = nil
+	}
 	glog.V(1).Infof("closed db %s", d.path)
 }
 
@@ -0,0 +1,72 @@
+package rocks
+
+import (
+	"bytes"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/chrislusf/gorocksdb"
+)
+
+// DeleteRange deletes the keys in [start, end), or all keys from start if end is empty,
+// except the ones for which keep returns true.
+// The deleted keys are covered by range tombstones, split around the kept keys, and written in one batch.
+func (d *Rocks) DeleteRange(start, end []byte, keep func(key, value []byte) bool) error {
+	if newClientCounter := atomic.AddInt32(&d.clientCounter, 1); newClientCounter <= 0 {
+		atomic.AddInt32(&d.clientCounter, -1)
+		return ErrorShutdownInProgress
+	}
+	defer atomic.AddInt32(&d.clientCounter, -1)
+
+	opts := gorocksdb.NewDefaultReadOptions()
+	defer opts.Destroy()
+	opts.SetFillCache(false)
+	if len(end) > 0 {
+		opts.SetIterateUpperBound(end)
+	}
+	iter := d.db.NewIterator(opts)
+	defer iter.Close()
+
+	batch := gorocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	spanStart := start
+	var lastKey []byte
+	for iter.Seek(start); iter.Valid(); iter.Next() {
+		k, v := iter.Key(), iter.Value()
+		key, kept := k.Data(), keep(k.Data(), v.Data())
+		if kept {
+			if bytes.Compare(spanStart, key) < 0 {
+				batch.DeleteRange(spanStart, key)
+			}
+			spanStart = keySuccessor(key)
+		} else if len(end) == 0 {
+			lastKey = append(lastKey[:0], key...)
+		}
+		k.Free()
+		v.Free()
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("delete range iterator: %v", err)
+	}
+
+	if len(end) > 0 {
+		if bytes.Compare(spanStart, end) < 0 {
+			batch.DeleteRange(spanStart, end)
+		}
+	} else if lastKey != nil {
+		if spanEnd := keySuccessor(lastKey); bytes.Compare(spanStart, spanEnd) < 0 {
+			batch.DeleteRange(spanStart, spanEnd)
+		}
+	}
+
+	if batch.Count() == 0 {
+		return nil
+	}
+	return d.db.Write(d.wo, batch)
+}
+
+// keySuccessor returns the smallest key after the key
+func keySuccessor(key []byte) []byte {
+	return append(append([]byte(nil), key...), 0)
+}
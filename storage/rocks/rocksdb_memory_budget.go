@@ -0,0 +1,150 @@
+package rocks
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/vasto/storage"
+)
+
+// sharedMemory is one block cache and one write buffer limit for all the rocksdb dbs on a store
+type sharedMemory struct {
+	blockCache   *gorocksdb.Cache
+	writeBuffers *writeBufferManager
+}
+
+func getSharedMemory(budget *storage.MemoryBudget) *sharedMemory {
+	return budget.Shared("rocksdb", func() storage.SharedMemory {
+		glog.V(0).Infof("rocksdb block cache %d MB, write buffers %d MB",
+			budget.BlockCacheBytes/1024/1024, budget.WriteBufferBytes/1024/1024)
+		return &sharedMemory{
+			blockCache:   gorocksdb.NewLRUCache(budget.BlockCacheBytes),
+			writeBuffers: newWriteBufferManager(budget.WriteBufferBytes),
+		}
+	}).(*sharedMemory)
+}
+
+func (m *sharedMemory) BlockCacheUsage() uint64 {
+	return m.blockCache.GetUsage()
+}
+
+// writeBufferManager keeps the memtables of all the dbs under the limit.
+// RocksDB has a WriteBufferManager for this, but the C api does not expose it,
+// so each db opens with a share of the limit, and the dbs are checked every second
+// while any is open, flushing the one with the largest memtables if the total is over the limit.
+type writeBufferManager struct {
+	limit uint64
+	dbs   map[*Rocks]bool
+	stop  chan struct{} // closed when the last db is removed
+	sync.Mutex
+}
+
+// minWriteBufferShare keeps the memtables of each db from flushing too often
+const minWriteBufferShare = 4 * 1024 * 1024
+
+func newWriteBufferManager(limit uint64) *writeBufferManager {
+	return &writeBufferManager{
+		limit: limit,
+		dbs:   make(map[*Rocks]bool),
+	}
+}
+
+// share is the write buffer size for one more db
+func (m *writeBufferManager) share() uint64 {
+	m.Lock()
+	defer m.Unlock()
+	share := m.limit / uint64(len(m.dbs)+1)
+	if share < minWriteBufferShare {
+		share = minWriteBufferShare
+	}
+	return share
+}
+
+func (m *writeBufferManager) add(d *Rocks) {
+	m.Lock()
+	defer m.Unlock()
+	if len(m.dbs) == 0 {
+		m.stop = make(chan struct{})
+		go m.enforcePeriodically(m.stop)
+	}
+	m.dbs[d] = true
+}
+
+func (m *writeBufferManager) remove(d *Rocks) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.dbs, d)
+	if len(m.dbs) == 0 && m.stop != nil {
+		close(m.stop)
+		m.stop = nil
+	}
+}
+
+func (m *writeBufferManager) enforcePeriodically(stop chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			m.enforce()
+		}
+	}
+}
+
+func (m *writeBufferManager) enforce() {
+	largest, total := m.largest()
+	if total <= m.limit || largest == nil {
+		return
+	}
+
+	// flush outside the lock, so the dbs can still be opened and closed
+	glog.V(1).Infof("write buffers %d bytes over limit %d, flush %s", total, m.limit, largest.path)
+	if err := largest.flushMemtables(); err != nil {
+		glog.Errorf("flush %s: %v", largest.path, err)
+	}
+}
+
+// largest returns the db with the largest memtables, and the total size of the memtables
+func (m *writeBufferManager) largest() (largest *Rocks, total uint64) {
+	m.Lock()
+	defer m.Unlock()
+
+	var largestSize uint64
+	for d := range m.dbs {
+		size := d.intProperty("rocksdb.cur-size-all-mem-tables")
+		total += size
+		if size > largestSize {
+			largest, largestSize = d, size
+		}
+	}
+	return largest, total
+}
+
+// flushMemtables writes the memtables to files, unless the db is closing
+func (d *Rocks) flushMemtables() error {
+	if newClientCounter := atomic.AddInt32(&d.clientCounter, 1); newClientCounter <= 0 {
+		atomic.AddInt32(&d.clientCounter, -1)
+		return ErrorShutdownInProgress
+	}
+	defer atomic.AddInt32(&d.clientCounter, -1)
+
+	flushOptions := gorocksdb.NewDefaultFlushOptions()
+	defer flushOptions.Destroy()
+	return d.db.Flush(flushOptions)
+}
+
+// MemoryUsage returns the memory used by the memtables and the table readers, not counting the block cache
+func (d *Rocks) MemoryUsage() uint64 {
+	return d.intProperty("rocksdb.cur-size-all-mem-tables") + d.intProperty("rocksdb.estimate-table-readers-mem")
+}
+
+func (d *Rocks) intProperty(name string) uint64 {
+	value, _ := strconv.ParseUint(d.db.GetProperty(name), 10, 64)
+	return value
+}
@@ -0,0 +1,67 @@
+package rocks
+
+import (
+	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/vasto/pb"
+)
+
+var compressionTypes = map[pb.StorageProfile_Compression]gorocksdb.CompressionType{
+	pb.StorageProfile_DEFAULT: gorocksdb.CompressionType(gorocksdb.SnappyCompression),
+	pb.StorageProfile_NONE:    gorocksdb.CompressionType(gorocksdb.NoCompression),
+	pb.StorageProfile_SNAPPY:  gorocksdb.CompressionType(gorocksdb.SnappyCompression),
+	pb.StorageProfile_ZLIB:    gorocksdb.CompressionType(gorocksdb.ZLibCompression),
+	pb.StorageProfile_BZ2:     gorocksdb.CompressionType(gorocksdb.Bz2Compression),
+	pb.StorageProfile_LZ4:     gorocksdb.CompressionType(gorocksdb.LZ4Compression),
+	pb.StorageProfile_LZ4HC:   gorocksdb.CompressionType(gorocksdb.LZ4HCCompression),
+	pb.StorageProfile_ZSTD:    gorocksdb.CompressionType(gorocksdb.ZSTDCompression),
+}
+
+// applyProfile changes the db options and write options by the storage profile
+// and the shared memory, before the db is opened.
+func (d *Rocks) applyProfile() {
+	p := d.profile
+	if p == nil {
+		p = &pb.StorageProfile{}
+	}
+
+	if d.sharedMemory != nil || p.BlockCacheSizeMb > 0 || p.BloomFilterBits > 0 {
+		d.tableOptions = gorocksdb.NewDefaultBlockBasedTableOptions()
+		if d.sharedMemory != nil {
+			// the shared block cache caps the memory of all dbs on the store, so the profile can not add its own
+			d.tableOptions.SetBlockCache(d.sharedMemory.blockCache)
+		} else if p.BlockCacheSizeMb > 0 {
+			d.blockCache = gorocksdb.NewLRUCache(uint64(p.BlockCacheSizeMb) * 1024 * 1024)
+			d.tableOptions.SetBlockCache(d.blockCache)
+		}
+		if p.BloomFilterBits > 0 {
+			d.tableOptions.SetFilterPolicy(gorocksdb.NewBloomFilter(int(p.BloomFilterBits)))
+		}
+		d.dbOptions.SetBlockBasedTableFactory(d.tableOptions)
+	}
+
+	if d.sharedMemory != nil {
+		d.dbOptions.SetDbWriteBufferSize(int(d.sharedMemory.writeBuffers.share()))
+	}
+
+	// the last compression also applies to the levels beyond the list
+	if len(p.CompressionPerLevel) > 0 {
+		var levels []gorocksdb.CompressionType
+		for _, c := range p.CompressionPerLevel {
+			levels = append(levels, compressionTypes[c])
+		}
+		d.dbOptions.SetCompressionPerLevel(levels)
+	}
+
+	if p.WriteBufferSizeMb > 0 {
+		d.dbOptions.SetWriteBufferSize(int(p.WriteBufferSizeMb) * 1024 * 1024)
+	}
+
+	if p.UseDirectIo {
+		d.dbOptions.SetUseDirectReads(true)
+		d.dbOptions.SetUseDirectIOForFlushAndCompaction(true)
+	}
+
+	if p.DisableWal {
+		d.wo.DisableWAL(true)
+	}
+}
@@ -1,62 +1,38 @@
 package rocks
 
 import (
-	"github.com/chrislusf/glog"
 	"github.com/chrislusf/gorocksdb"
-	"github.com/chrislusf/vasto/storage/codec"
-	"github.com/dgryski/go-jump"
-	"time"
+	"github.com/chrislusf/vasto/storage"
 )
 
+// shardingCompactionFilter adapts the storage.CompactionFilter to gorocksdb.CompactionFilter
 type shardingCompactionFilter struct {
-	shardId    int32
-	shardCount int
-	isResizing bool
-}
-
-func (m *shardingCompactionFilter) configure(shardId int32, shardCount int) {
-	m.shardId = shardId
-	m.shardCount = shardCount
+	storage.CompactionFilter
 }
 
 func (m *shardingCompactionFilter) Name() string { return "vasto.sharding" }
 func (m *shardingCompactionFilter) Filter(level int, key, val []byte) (bool, []byte) {
-	entry := codec.FromBytes(val)
-	if entry == nil {
-		// vasto specific entries not encoded into Entry
-		glog.V(1).Infof("vasto internal %s: %s", string(key), string(val))
-		return false, nil
-	}
-	if !m.isResizing {
-		// do not delete anything if during resizing, in case the resizing fails
-		jumpHash := jump.Hash(entry.PartitionHash, m.shardCount)
-		if m.shardId != jumpHash {
-			// glog.V(1).Infof("skipping shard %d, jumpHash %d, shardCount:%d, skipping %s: %s", m.shardId, jumpHash, m.shardCount, string(key), string(val))
-			return true, nil
-		}
-	}
-	if entry.TtlSecond == 0 {
-		return false, nil
-	}
-	if entry.UpdatedAtNs/uint64(1000000)+uint64(entry.TtlSecond) < uint64(time.Now().Unix()) {
-		// glog.V(1).Infof("skipping updatedAt:%d, ttl:%d", entry.UpdatedAtNs/uint64(1000000), entry.TtlSecond, string(key), string(val))
-		return true, nil
-	}
-	return false, nil
+	return m.CompactionFilter.Filter(key, val)
 }
 
 // SetCompactionForShard changes the compaction filter to use the shardId and shardCount.
 // All entries not belong to the shard will be physically purged during next compaction.
 func (d *Rocks) SetCompactionForShard(shardId, shardCount int) {
-	d.compactionFilter.configure(int32(shardId), shardCount)
+	d.compactionFilter.Configure(int32(shardId), shardCount)
+}
+
+// SetCompactionRewrite changes the values kept during compaction, e.g., to re-encrypt with a new key.
+// The rewrite function returns nil to keep the value as is.
+func (d *Rocks) SetCompactionRewrite(rewrite func(value []byte) []byte) {
+	d.compactionFilter.SetRewrite(rewrite)
 }
 
 func (d *Rocks) PrepareForClusterResize() {
-	d.compactionFilter.isResizing = true
+	d.compactionFilter.SetResizing(true)
 }
 
 func (d *Rocks) CompleteClusterResize() {
-	d.compactionFilter.isResizing = false
+	d.compactionFilter.SetResizing(false)
 }
 
 func (d *Rocks) Compact() {
@@ -29,6 +29,29 @@ func (d *Rocks) addSst(name string, next func() (bool, []byte, []byte)) error {
 
 }
 
+// IngestBehind adds the entries to an SST file, and ingests it behind all existing entries.
+func (d *Rocks) IngestBehind(name string, writerFunc func(add func(key, value []byte) error) (int64, error)) error {
+	return d.AddSstByWriter(name, func(w *gorocksdb.SSTFileWriter) (int64, error) {
+		return writerFunc(func(key, value []byte) error {
+			if err := w.Add(key, value); err != nil {
+				return fmt.Errorf("add to sst: %v", err)
+			}
+			return nil
+		})
+	})
+}
+
+// HasIngestedBehind checks whether any SST file has been ingested to the bottommost level.
+func (d *Rocks) HasIngestedBehind() bool {
+	for fileId, meta := range d.db.GetLiveFilesMetaData() {
+		glog.V(1).Infof("%s %d name:%s, level:%d size:%d SmallestKey:%s LargestKey:%s", d.path, fileId, meta.Name, meta.Level, meta.Size, string(meta.SmallestKey), string(meta.LargestKey))
+		if meta.Level >= 6 {
+			return true
+		}
+	}
+	return false
+}
+
 // AddSstByWriter add SST by ingesting behind
 func (d *Rocks) AddSstByWriter(name string, writerFunc func(*gorocksdb.SSTFileWriter) (int64, error)) error {
 	envOpts := gorocksdb.NewDefaultEnvOptions()
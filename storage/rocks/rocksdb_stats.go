@@ -0,0 +1,50 @@
+package rocks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+const numLevels = 7
+
+// DbStats reads the rocksdb properties, and the statistics counted since the db is opened if they are enabled
+func (d *Rocks) DbStats() *pb.DbStats {
+	stats := &pb.DbStats{
+		EstimatedKeys:          d.intProperty("rocksdb.estimate-num-keys"),
+		LiveSstSize:            d.intProperty("rocksdb.live-sst-files-size"),
+		TotalSstSize:           d.intProperty("rocksdb.total-sst-files-size"),
+		MemtableSize:           d.intProperty("rocksdb.cur-size-all-mem-tables"),
+		PendingCompactionBytes: d.intProperty("rocksdb.estimate-pending-compaction-bytes"),
+	}
+	for level := 0; level < numLevels; level++ {
+		stats.LevelFileCounts = append(stats.LevelFileCounts, d.intProperty(fmt.Sprintf("rocksdb.num-files-at-level%d", level)))
+	}
+
+	if d.enableStatistics {
+		tickers := parseStatistics(d.dbOptions.GetStatisticsString())
+		stats.BlockCacheHits = tickers["rocksdb.block.cache.hit"]
+		stats.BlockCacheMisses = tickers["rocksdb.block.cache.miss"]
+		stats.StallMicros = tickers["rocksdb.stall.micros"]
+	}
+
+	return stats
+}
+
+// parseStatistics reads the tickers, in lines of "rocksdb.block.cache.hit COUNT : 123".
+// The histograms are skipped.
+func parseStatistics(text string) map[string]uint64 {
+	tickers := make(map[string]uint64)
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 || fields[1] != "COUNT" || fields[2] != ":" {
+			continue
+		}
+		if count, err := strconv.ParseUint(fields[3], 10, 64); err == nil {
+			tickers[fields[0]] = count
+		}
+	}
+	return tickers
+}
@@ -0,0 +1,21 @@
+package rocks
+
+import (
+	"testing"
+)
+
+func TestParseStatistics(t *testing.T) {
+
+	tickers := parseStatistics(`rocksdb.block.cache.miss COUNT : 12
+rocksdb.block.cache.hit COUNT : 345
+rocksdb.stall.micros COUNT : 6789
+rocksdb.db.get.micros P50 : 1.500000 P95 : 3.000000 P99 : 5.000000 P100 : 9.000000 COUNT : 10 SUM : 20
+`)
+
+	if tickers["rocksdb.block.cache.miss"] != 12 || tickers["rocksdb.block.cache.hit"] != 345 || tickers["rocksdb.stall.micros"] != 6789 {
+		t.Errorf("unexpected tickers: %v", tickers)
+	}
+	if _, found := tickers["rocksdb.db.get.micros"]; found {
+		t.Errorf("histogram is parsed as a ticker")
+	}
+}
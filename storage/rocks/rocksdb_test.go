@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage"
+	"github.com/chrislusf/vasto/storage/enginetest"
+	"io/ioutil"
 	"math/rand"
 	"testing"
 	"time"
@@ -152,6 +155,16 @@ func TestRangeScan(t *testing.T) {
 
 }
 
+func TestEngine(t *testing.T) {
+	enginetest.Run(t, func(t *testing.T, mergeOperator storage.MergeOperator) storage.Engine {
+		dir, err := ioutil.TempDir("", "rocks-test-go")
+		if err != nil {
+			t.Fatalf("temp dir: %v", err)
+		}
+		return NewDb(dir, mergeOperator)
+	})
+}
+
 func TestFullScan(t *testing.T) {
 
 	db := setupTestDb()
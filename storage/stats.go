@@ -0,0 +1,11 @@
+package storage
+
+import (
+	"github.com/chrislusf/vasto/pb"
+)
+
+// StatsReporter is implemented by the engines that keep the statistics of the db,
+// e.g., the key estimates, compaction backlog, and block cache hits.
+type StatsReporter interface {
+	DbStats() *pb.DbStats
+}
@@ -7,9 +7,13 @@ import (
 
 	"bytes"
 	"context"
+	"errors"
 	m "github.com/chrislusf/vasto/cmd/master"
 	s "github.com/chrislusf/vasto/cmd/store"
 	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/security"
+	"github.com/chrislusf/vasto/storage/codec"
 	"log"
 	"os"
 	"time"
@@ -97,15 +101,135 @@ func TestOpen(t *testing.T) {
 		}
 	})
 
+	t.Run("incr", func(t *testing.T) {
+		k := vs.Key([]byte("counter1"))
+		for i := int64(1); i <= 3; i++ {
+			x, err := ks.IncrBy(k, 1<<40)
+			if err != nil || x != i<<40 {
+				t.Errorf("incr: %d, %v, expecting: %d", x, err, i<<40)
+			}
+		}
+		ks.PutMaxInt64(vs.Key([]byte("max2")), 7)
+		ks.PutMaxInt64(vs.Key([]byte("max2")), 3)
+		if x, _ := ks.GetInt64(vs.Key([]byte("max2"))); x != 7 {
+			t.Errorf("get max int64: %d, expecting: %v", x, 7)
+		}
+	})
+
+	t.Run("set", func(t *testing.T) {
+		k := vs.Key([]byte("set1"))
+		ks.SAdd(k, []byte("a"), []byte("b"), []byte("c"))
+		ks.SRem(k, []byte("b"))
+		members, err := ks.SMembers(k)
+		if err != nil || len(members) != 2 || string(members[0]) != "a" || string(members[1]) != "c" {
+			t.Errorf("set members: %q, %v, expecting: [a c]", members, err)
+		}
+
+		z := vs.Key([]byte("zset1"))
+		ks.ZAdd(z, codec.ScoredMember{Member: []byte("a"), Score: 3}, codec.ScoredMember{Member: []byte("b"), Score: 1})
+		ks.ZAdd(z, codec.ScoredMember{Member: []byte("a"), Score: 0.5})
+		scored, err := ks.ZRangeByScore(z, 0, 2)
+		if err != nil || len(scored) != 2 || string(scored[0].Member) != "a" || string(scored[1].Member) != "b" {
+			t.Errorf("sorted set range: %+v, %v, expecting: [a b]", scored, err)
+		}
+	})
+
+	t.Run("sketch", func(t *testing.T) {
+		h1, h2, h3 := vs.Key([]byte("hll1")), vs.Key([]byte("hll2")), vs.Key([]byte("hll3"))
+		ks.PFAdd(h1, []byte("a"), []byte("b"))
+		ks.PFAdd(h1, []byte("b"), []byte("c"))
+		ks.PFAdd(h2, []byte("c"), []byte("d"))
+		if count, err := ks.PFCount(h1); err != nil || count != 3 {
+			t.Errorf("pfcount: %d, %v, expecting: 3", count, err)
+		}
+		if err := ks.PFMerge(h3, h1, h2); err != nil {
+			t.Errorf("pfmerge: %v", err)
+		}
+		if count, err := ks.PFCount(h3); err != nil || count != 4 {
+			t.Errorf("pfcount merged: %d, %v, expecting: 4", count, err)
+		}
+
+		b := vs.Key([]byte("bloom1"))
+		ks.BloomAdd(b, []byte("a"))
+		ks.BloomAdd(b, []byte("b"))
+		if found, err := ks.BloomMightContain(b, []byte("b")); err != nil || !found {
+			t.Errorf("bloom filter misses b: %v", err)
+		}
+		if found, _ := ks.BloomMightContain(vs.Key([]byte("bloom2")), []byte("b")); found {
+			t.Errorf("missing bloom filter contains b")
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		k := vs.Key([]byte("feed1"))
+		ks.LPushCapped(k, 3, time.Hour, []byte("a"), []byte("b"))
+		ks.LPush(k, []byte("c"), []byte("d"))
+		elements, err := ks.LRange(k, 0, -1)
+		if err != nil || fmt.Sprintf("%s", elements) != "[d c b]" {
+			t.Errorf("list range: %s, %v, expecting: [d c b]", elements, err)
+		}
+		ks.LTrim(k, 0, 1)
+		elements, err = ks.LRange(k, 1, -1)
+		if err != nil || fmt.Sprintf("%s", elements) != "[c]" {
+			t.Errorf("list range after trim: %s, %v, expecting: [c]", elements, err)
+		}
+	})
+
+	t.Run("deletePrefix", func(t *testing.T) {
+		if err := ks.DeletePrefix([]byte("x")); err != nil {
+			t.Errorf("delete prefix: %v", err)
+		}
+		if _, _, err := ks.Get(vs.Key([]byte("x2"))); err != vs.ErrorNotFound {
+			t.Errorf("get deleted x2: %v", err)
+		}
+		if x, _ := ks.GetFloat64(vs.Key([]byte("y1"))); x != 3 {
+			t.Errorf("get float64 outside of the prefix: %f, expecting: %v", x, 3)
+		}
+	})
+
+	t.Run("throttled", func(t *testing.T) {
+		if err := c.SetKeyspaceQuota(&pb.KeyspaceQuota{Keyspace: "ks1", OpsPerSecond: 1}); err != nil {
+			t.Fatalf("set quota: %v", err)
+		}
+		defer c.SetKeyspaceQuota(&pb.KeyspaceQuota{Keyspace: "ks1"})
+
+		// the master has pushed the quota to the store when SetKeyspaceQuota returns.
+		// The bucket starts empty and refills one token per second, so the first put can only
+		// borrow the fraction of a token refilled meanwhile, and the next ones are throttled.
+		var err error
+		for i := 0; i < 3; i++ {
+			err = ks.Put(vs.Key([]byte("t1")), []byte("v"))
+		}
+		if !errors.Is(err, vs.ErrorThrottled) {
+			t.Fatalf("put over quota: %v, expecting: %v", err, vs.ErrorThrottled)
+		}
+		if err := ks.Delete(vs.Key([]byte("t1"))); err != vs.ErrorThrottled {
+			t.Errorf("delete over quota: %v, expecting: %v", err, vs.ErrorThrottled)
+		}
+		if _, err := ks.IncrBy(vs.Key([]byte("t2")), 1); err != vs.ErrorThrottled {
+			t.Errorf("incr over quota: %v, expecting: %v", err, vs.ErrorThrottled)
+		}
+	})
+
 	os.RemoveAll("./ks1")
 }
 
 func startMasterAndStore() int {
 
+	staleReplicaLag := time.Minute
+	slowOpThreshold, slowOpLogSize := 100*time.Millisecond, 1024
+
 	masterPort := getPort()
+	noTls := &security.TlsOption{CertFile: getString(""), KeyFile: getString(""), CaFile: getString(""), VerifyClient: getBool(false)}
 
 	go m.RunMaster(&m.MasterOption{
-		Address: getString(fmt.Sprintf(":%d", masterPort)),
+		Address:         getString(fmt.Sprintf(":%d", masterPort)),
+		HttpAddress:     getString(""),
+		StaleReplicaLag: &staleReplicaLag,
+		TraceExporter:   getString(""),
+		AclFile:         getString(""),
+		Tls:             noTls,
+		AuditLog:        getString(""),
 	})
 
 	storeOption := &s.StoreOption{
@@ -120,6 +244,16 @@ func startMasterAndStore() int {
 		DiskSizeGb:        getInt(10),
 		Tags:              getString(""),
 		DisableBinLog:     getBool(false),
+		HttpAddress:       getString(""),
+		TraceExporter:     getString(""),
+		SlowOpThreshold:   &slowOpThreshold,
+		SlowOpLogSize:     &slowOpLogSize,
+		Token:             getString(""),
+		Tls:               noTls,
+		EncryptionKeyFile: getString(""),
+		Engine:            getString("memory"),
+		MemoryBudgetMb:    getInt(0),
+		DbStatistics:      getBool(false),
 	}
 
 	go s.RunStore(storeOption)
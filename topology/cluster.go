@@ -147,6 +147,11 @@ func (cluster *Cluster) RemoveStore(store *pb.StoreResource) (removedShards []*p
 	return
 }
 
+// IsStoreInUse checks whether any shard of the cluster, or of the next cluster during resizing, is on the store
+func (cluster *Cluster) IsStoreInUse(store *pb.StoreResource) bool {
+	return cluster.isStoreInUse(store) || cluster.GetNextCluster().isStoreInUse(store)
+}
+
 func (cluster *Cluster) isStoreInUse(store *pb.StoreResource) bool {
 	if cluster == nil {
 		return false
@@ -9,8 +9,9 @@ import (
 	"google.golang.org/grpc"
 )
 
-// WithConnection dials a connection to a server in the cluster by serverId
-func (cluster *Cluster) WithConnection(name string, serverId int, fn func(*pb.ClusterNode, *grpc.ClientConn) error) error {
+// WithConnection dials a connection to a server in the cluster by serverId.
+// The dial options, e.g., the transport and per rpc credentials, are added after grpc.WithInsecure() and can override it.
+func (cluster *Cluster) WithConnection(name string, serverId int, fn func(*pb.ClusterNode, *grpc.ClientConn) error, options ...grpc.DialOption) error {
 
 	node, ok := cluster.GetNode(serverId, 0)
 
@@ -19,14 +20,14 @@ func (cluster *Cluster) WithConnection(name string, serverId int, fn func(*pb.Cl
 		return fmt.Errorf("server %d not found", serverId)
 	}
 
-	return doWithConnect(name, node, serverId, fn)
+	return doWithConnect(name, node, serverId, fn, options)
 }
 
 // VastoNodes are the servers in a cluster
 type VastoNodes []*pb.ClusterNode
 
 // WithConnection dials a connection to a server of the cluster nodes by serverId
-func (nodes VastoNodes) WithConnection(name string, serverId int, fn func(*pb.ClusterNode, *grpc.ClientConn) error) error {
+func (nodes VastoNodes) WithConnection(name string, serverId int, fn func(*pb.ClusterNode, *grpc.ClientConn) error, options ...grpc.DialOption) error {
 
 	if serverId < 0 || serverId >= len(nodes) {
 		return fmt.Errorf("server %d not found in %d servers: %+v", serverId, len(nodes), nodes)
@@ -34,11 +35,11 @@ func (nodes VastoNodes) WithConnection(name string, serverId int, fn func(*pb.Cl
 
 	node := nodes[serverId]
 
-	return doWithConnect(name, node, serverId, fn)
+	return doWithConnect(name, node, serverId, fn, options)
 
 }
 
-func doWithConnect(name string, node *pb.ClusterNode, serverId int, fn func(*pb.ClusterNode, *grpc.ClientConn) error) error {
+func doWithConnect(name string, node *pb.ClusterNode, serverId int, fn func(*pb.ClusterNode, *grpc.ClientConn) error, options []grpc.DialOption) error {
 
 	if node == nil {
 		return fmt.Errorf("%s: server %d is missing", name, serverId)
@@ -46,7 +47,7 @@ func doWithConnect(name string, node *pb.ClusterNode, serverId int, fn func(*pb.
 
 	// glog.V(2).Infof("connecting to server %d at %s", serverId, node.GetAdminAddress())
 
-	grpcConnection, err := grpc.DialContext(context.Background(), node.StoreResource.AdminAddress, grpc.WithInsecure())
+	grpcConnection, err := grpc.DialContext(context.Background(), node.StoreResource.AdminAddress, append([]grpc.DialOption{grpc.WithInsecure()}, options...)...)
 	if err != nil {
 		return fmt.Errorf("%s: fail to dial %s: %v", name, node.StoreResource.AdminAddress, err)
 	}
@@ -10,7 +10,7 @@ import (
 )
 
 func (clusterListener *ClusterListener) registerClientAtMasterServer(master string, msgChan chan *pb.ClientMessage) error {
-	grpcConnection, err := grpc.Dial(master, grpc.WithInsecure())
+	grpcConnection, err := grpc.Dial(master, clusterListener.DialOptions()...)
 	if err != nil {
 		return fmt.Errorf("%s fail to dial %s: %v", clusterListener.clientName, master, err)
 	}
@@ -6,9 +6,10 @@ import (
 	"context"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/security"
 	"github.com/chrislusf/vasto/topology"
 	"github.com/chrislusf/vasto/util"
-	"gopkg.in/fatih/pool.v2"
+	"google.golang.org/grpc"
 	"sync"
 )
 
@@ -19,16 +20,21 @@ type keyspaceFollowMessage struct {
 	isUnfollow bool
 }
 
-// ClusterListener listens to cluster topology changes, and maintains a connection pool to the servers.
+// ClusterListener listens to cluster topology changes, and maintains multiplexed connections to the servers.
 type ClusterListener struct {
 	sync.RWMutex
 	clusters                  map[keyspaceName]*topology.Cluster
 	keyspaceFollowMessageChan chan keyspaceFollowMessage
 	shardEventProcessors      []ShardEventProcessor
 	clientName                string
-	connPools                 map[string]pool.Pool
+	connPools                 map[string]*multiplexedConnPool
 	connPoolLock              sync.Mutex
 	disableUnixSocket         bool
+	storeHealths              map[string]*storeHealth
+	circuitBreakerConfig      CircuitBreakerConfig
+	healthLock                sync.Mutex
+	dialOptions               []grpc.DialOption
+	tls                       *security.Tls
 }
 
 // NewClusterListener creates a cluster listener in a data center.
@@ -38,7 +44,9 @@ func NewClusterListener(clientName string) *ClusterListener {
 		clusters:                  make(map[keyspaceName]*topology.Cluster),
 		keyspaceFollowMessageChan: make(chan keyspaceFollowMessage, 1),
 		clientName:                clientName,
-		connPools:                 make(map[string]pool.Pool),
+		connPools:                 make(map[string]*multiplexedConnPool),
+		storeHealths:              make(map[string]*storeHealth),
+		circuitBreakerConfig:      DefaultCircuitBreakerConfig,
 	}
 }
 
@@ -124,6 +132,29 @@ func (clusterListener *ClusterListener) SetUnixSocket(useUnixSocket bool) {
 	clusterListener.disableUnixSocket = !useUnixSocket
 }
 
+// SetDialOptions sets the extra options, e.g., the credentials, to dial the master and the store admin ports.
+// This should be called before StartListener.
+func (clusterListener *ClusterListener) SetDialOptions(options ...grpc.DialOption) {
+	clusterListener.dialOptions = options
+}
+
+// SetTls sets the certificates to connect to the master, the store admin ports and the store data ports.
+// A nil Tls means plaintext. This should be called before StartListener.
+func (clusterListener *ClusterListener) SetTls(tls *security.Tls) {
+	clusterListener.tls = tls
+}
+
+// Tls returns the certificates to connect to the servers, nil if TLS is disabled.
+func (clusterListener *ClusterListener) Tls() *security.Tls {
+	return clusterListener.tls
+}
+
+// DialOptions returns the options to dial the master and the store admin ports,
+// i.e., the transport credentials followed by the extra options.
+func (clusterListener *ClusterListener) DialOptions() []grpc.DialOption {
+	return append([]grpc.DialOption{clusterListener.tls.GrpcDialOption()}, clusterListener.dialOptions...)
+}
+
 // HasConnectedKeyspace checks whether the listener has the information of the keyspace or not.
 func (clusterListener *ClusterListener) HasConnectedKeyspace(keyspace string) bool {
 	cluster, found := clusterListener.GetCluster(keyspace)
@@ -16,4 +16,11 @@ func (clusterListener *ClusterListener) Debug(prefix string) {
 		cluster.Debug(prefix + "    ")
 	}
 	clusterListener.RUnlock()
+
+	fmt.Printf("%sstore health:\n", prefix)
+	clusterListener.healthLock.Lock()
+	for address, health := range clusterListener.storeHealths {
+		fmt.Printf("%s  store %s %v\n", prefix, address, health)
+	}
+	clusterListener.healthLock.Unlock()
 }
@@ -1,16 +1,25 @@
 package clusterlistener
 
 import (
+	"context"
 	"fmt"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/util"
-	"gopkg.in/fatih/pool.v2"
 	"net"
 	"time"
 )
 
-// GetConnectionByShardId returns the connection to the shard
-func (clusterListener *ClusterListener) GetConnectionByShardId(keyspace string, shardId int, replica int) (net.Conn, error) {
+// connectionsPerStore is the number of multiplexed connections kept to each store
+const connectionsPerStore = 2
+
+// GetConnectionByShardId returns a multiplexed connection to the shard.
+// The connection is shared by concurrent callers, and should not be closed after use.
+func (clusterListener *ClusterListener) GetConnectionByShardId(keyspace string, shardId int, replica int) (*MultiplexedConn, error) {
+	return clusterListener.GetConnectionByShardIdCtx(context.Background(), keyspace, shardId, replica)
+}
+
+// GetConnectionByShardIdCtx is GetConnectionByShardId, but dialing a new connection is bounded by the context.
+func (clusterListener *ClusterListener) GetConnectionByShardIdCtx(ctx context.Context, keyspace string, shardId int, replica int) (*MultiplexedConn, error) {
 
 	r, found := clusterListener.GetCluster(keyspace)
 	if !found {
@@ -23,17 +32,23 @@ func (clusterListener *ClusterListener) GetConnectionByShardId(keyspace string,
 		return nil, fmt.Errorf("shardId %d not found", shardId)
 	}
 
+	health := clusterListener.getStoreHealth(n.StoreResource.Address)
+	if !health.allow() {
+		return nil, &CircuitOpenError{Address: n.StoreResource.Address}
+	}
+
 	clusterListener.connPoolLock.Lock()
 	connPool, foundPool := clusterListener.connPools[n.StoreResource.Address]
 	if !foundPool {
-		connPool, _ = pool.NewChannelPool(0, 100,
-			func() (net.Conn, error) {
+		connPool = newMultiplexedConnPool(connectionsPerStore, health,
+			func(ctx context.Context) (net.Conn, error) {
 				network, address := n.StoreResource.Network, n.StoreResource.Address
 				if unixSocket, ok := util.GetUnixSocketFile(address); ok {
 					network, address = "unix", unixSocket
 				}
 
-				conn, err := net.Dial(network, address)
+				var dialer net.Dialer
+				conn, err := dialer.DialContext(ctx, network, address)
 				if err != nil {
 					return nil, fmt.Errorf("Failed to dial %s on %s : %v", network, address, err)
 				}
@@ -42,14 +57,15 @@ func (clusterListener *ClusterListener) GetConnectionByShardId(keyspace string,
 					c.SetKeepAlive(true)
 					c.SetNoDelay(true)
 				}
-				return conn, err
+				return clusterListener.tls.ClientConn(conn, address), nil
 			})
 		clusterListener.connPools[n.StoreResource.Address] = connPool
 	}
 	clusterListener.connPoolLock.Unlock()
 
-	conn, err := connPool.Get()
+	conn, err := connPool.Get(ctx)
 	if err != nil {
+		health.record(err)
 		return nil, fmt.Errorf("GetConnection shard %d %s %+v", shardId, n.StoreResource.Address, err)
 	}
 
@@ -0,0 +1,277 @@
+package clusterlistener
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+	"github.com/golang/protobuf/proto"
+)
+
+var errConnectionClosed = errors.New("connection closed")
+
+// writeTimeout discards the connection if one message can not be written in time.
+// It is not related to the request deadlines, since the connection is shared by all the callers.
+var writeTimeout = 10 * time.Second
+
+// NotSentError is returned when the requests have not reached the server, so they can be safely sent again.
+// Other errors may happen after the server has received and processed the requests.
+type NotSentError struct {
+	Err error
+}
+
+func (e *NotSentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *NotSentError) Unwrap() error {
+	return e.Err
+}
+
+// MultiplexedConn pipelines many in-flight pb.Requests over one connection.
+// The responses are matched back to the callers by request id, in whatever order the server completes them.
+type MultiplexedConn struct {
+	conn          net.Conn
+	writeSlot     chan struct{} // taken while writing one message
+	pendingLock   sync.Mutex
+	pending       map[uint64]chan *pb.Responses
+	lastRequestId uint64
+	err           error
+	health        *storeHealth
+}
+
+func newMultiplexedConn(conn net.Conn, health *storeHealth) *MultiplexedConn {
+	mc := &MultiplexedConn{
+		conn:      conn,
+		writeSlot: make(chan struct{}, 1),
+		health:    health,
+		pending:   make(map[uint64]chan *pb.Responses),
+	}
+	go mc.readLoop()
+	return mc
+}
+
+// SendRequests sends the requests and waits for the responses with the same request id.
+// It is safe to be called concurrently.
+func (mc *MultiplexedConn) SendRequests(requests *pb.Requests) (*pb.Responses, error) {
+	return mc.SendRequestsCtx(context.Background(), requests)
+}
+
+// SendRequestsCtx is SendRequests with a context. The remaining time before the context deadline is sent along,
+// so that the server can skip the work if the caller has already given up.
+// When the context is done, the caller stops waiting to write or to read the responses, and the late responses are dropped.
+// The connection is only discarded if a message can not be written within writeTimeout.
+func (mc *MultiplexedConn) SendRequestsCtx(ctx context.Context, requests *pb.Requests) (responses *pb.Responses, err error) {
+
+	deadline, hasDeadline := ctx.Deadline()
+	if hasDeadline {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			return nil, context.DeadlineExceeded
+		}
+		requests.TimeoutNs = uint64(timeout)
+	}
+
+	if mc.health != nil {
+		defer func() {
+			mc.health.record(err)
+		}()
+	}
+
+	return mc.sendRequests(ctx, requests)
+}
+
+func (mc *MultiplexedConn) sendRequests(ctx context.Context, requests *pb.Requests) (*pb.Responses, error) {
+
+	requestId := atomic.AddUint64(&mc.lastRequestId, 1)
+	responsesChan := make(chan *pb.Responses, 1)
+
+	mc.pendingLock.Lock()
+	if mc.err != nil {
+		mc.pendingLock.Unlock()
+		return nil, &NotSentError{Err: mc.err}
+	}
+	mc.pending[requestId] = responsesChan
+	mc.pendingLock.Unlock()
+
+	requests.RequestId = requestId
+	input, err := proto.Marshal(requests)
+	if err != nil {
+		mc.removePending(requestId)
+		return nil, &NotSentError{Err: fmt.Errorf("marshal requests: %v", err)}
+	}
+
+	select {
+	case mc.writeSlot <- struct{}{}:
+	case <-ctx.Done():
+		mc.removePending(requestId)
+		return nil, ctx.Err()
+	}
+	mc.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	err = util.WriteMessage(mc.conn, input)
+	mc.conn.SetWriteDeadline(time.Time{})
+	<-mc.writeSlot
+	if err != nil {
+		// a partially written message corrupts the stream, and can not be processed by the server
+		err = fmt.Errorf("write requests: %v", err)
+		mc.fail(err)
+		return nil, &NotSentError{Err: err}
+	}
+
+	select {
+	case responses, ok := <-responsesChan:
+		if !ok {
+			return nil, mc.getError()
+		}
+		return responses, nil
+	case <-ctx.Done():
+		mc.removePending(requestId)
+		return nil, ctx.Err()
+	}
+}
+
+// IsBroken returns true if the underlying connection can not be used any more.
+func (mc *MultiplexedConn) IsBroken() bool {
+	return mc.getError() != nil
+}
+
+// Close closes the underlying connection. Pending requests fail with an error.
+func (mc *MultiplexedConn) Close() {
+	mc.fail(errConnectionClosed)
+}
+
+func (mc *MultiplexedConn) readLoop() {
+
+	reader := bufio.NewReader(mc.conn)
+
+	for {
+		output, err := util.ReadMessage(reader)
+		if err != nil {
+			mc.fail(fmt.Errorf("read responses: %v", err))
+			return
+		}
+
+		responses := &pb.Responses{}
+		if err = proto.Unmarshal(output, responses); err != nil {
+			mc.fail(fmt.Errorf("unmarshal responses: %v", err))
+			return
+		}
+
+		responsesChan, found := mc.removePending(responses.RequestId)
+		if !found {
+			glog.V(1).Infof("%s unexpected response request id %d", mc.conn.RemoteAddr(), responses.RequestId)
+			continue
+		}
+		responsesChan <- responses
+	}
+
+}
+
+func (mc *MultiplexedConn) removePending(requestId uint64) (responsesChan chan *pb.Responses, found bool) {
+	mc.pendingLock.Lock()
+	responsesChan, found = mc.pending[requestId]
+	delete(mc.pending, requestId)
+	mc.pendingLock.Unlock()
+	return
+}
+
+func (mc *MultiplexedConn) getError() error {
+	mc.pendingLock.Lock()
+	defer mc.pendingLock.Unlock()
+	return mc.err
+}
+
+// fail marks the connection as broken, and releases all pending callers.
+func (mc *MultiplexedConn) fail(err error) {
+	mc.pendingLock.Lock()
+	if mc.err == nil {
+		mc.err = err
+	}
+	for requestId, responsesChan := range mc.pending {
+		close(responsesChan)
+		delete(mc.pending, requestId)
+	}
+	mc.pendingLock.Unlock()
+	mc.conn.Close()
+}
+
+// multiplexedConnPool keeps a few multiplexed connections to one store, and replaces broken ones.
+type multiplexedConnPool struct {
+	sync.Mutex
+	dial     func(ctx context.Context) (net.Conn, error)
+	health   *storeHealth
+	conns    []*MultiplexedConn
+	next     int
+	isClosed bool
+}
+
+func newMultiplexedConnPool(size int, health *storeHealth, dial func(ctx context.Context) (net.Conn, error)) *multiplexedConnPool {
+	return &multiplexedConnPool{
+		dial:   dial,
+		health: health,
+		conns:  make([]*MultiplexedConn, size),
+	}
+}
+
+// Get picks the connections in a round robin way.
+// A missing or broken connection is dialed without holding the pool lock, so a slow dial does not block the other slots.
+func (p *multiplexedConnPool) Get(ctx context.Context) (*MultiplexedConn, error) {
+	p.Lock()
+	if p.isClosed {
+		p.Unlock()
+		return nil, errConnectionClosed
+	}
+
+	slot := p.next % len(p.conns)
+	p.next++
+
+	if mc := p.conns[slot]; mc != nil && !mc.IsBroken() {
+		p.Unlock()
+		return mc, nil
+	}
+	p.Unlock()
+
+	conn, err := p.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mc := newMultiplexedConn(conn, p.health)
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.isClosed {
+		mc.Close()
+		return nil, errConnectionClosed
+	}
+	// another caller may have filled the slot during the dial
+	if existing := p.conns[slot]; existing != nil && !existing.IsBroken() {
+		mc.Close()
+		return existing, nil
+	}
+	p.conns[slot] = mc
+
+	return mc, nil
+}
+
+// Close closes all the connections.
+func (p *multiplexedConnPool) Close() {
+	p.Lock()
+	defer p.Unlock()
+
+	p.isClosed = true
+	for i, mc := range p.conns {
+		if mc != nil {
+			mc.Close()
+			p.conns[i] = nil
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package clusterlistener
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestMultiplexedConnOutOfOrder(t *testing.T) {
+
+	client, server := net.Pipe()
+
+	// the server replies to the two requests in reversed order
+	go func() {
+		reader := bufio.NewReader(server)
+		var received []*pb.Requests
+		for len(received) < 2 {
+			input, err := util.ReadMessage(reader)
+			if err != nil {
+				return
+			}
+			requests := &pb.Requests{}
+			proto.Unmarshal(input, requests)
+			received = append(received, requests)
+		}
+		for i := len(received) - 1; i >= 0; i-- {
+			output, _ := proto.Marshal(&pb.Responses{
+				RequestId: received[i].RequestId,
+				Responses: []*pb.Response{{Write: &pb.WriteResponse{Status: received[i].Keyspace}}},
+			})
+			util.WriteMessage(server, output)
+		}
+	}()
+
+	mc := newMultiplexedConn(client, nil)
+	defer mc.Close()
+
+	var wg sync.WaitGroup
+	for _, keyspace := range []string{"ks1", "ks2"} {
+		wg.Add(1)
+		go func(keyspace string) {
+			defer wg.Done()
+			responses, err := mc.SendRequests(&pb.Requests{Keyspace: keyspace})
+			if err != nil {
+				t.Errorf("send requests: %v", err)
+				return
+			}
+			if status := responses.Responses[0].Write.Status; status != keyspace {
+				t.Errorf("expecting response for %s, but got %s", keyspace, status)
+			}
+		}(keyspace)
+	}
+	wg.Wait()
+
+	server.Close()
+	if _, err := mc.SendRequests(&pb.Requests{Keyspace: "ks3"}); err == nil {
+		t.Errorf("expecting error after the server closed the connection")
+	}
+
+}
+
+func TestMultiplexedConnDeadline(t *testing.T) {
+
+	client, server := net.Pipe()
+	defer server.Close()
+
+	// the server reads the requests, but never replies
+	timeouts := make(chan uint64, 1)
+	go func() {
+		reader := bufio.NewReader(server)
+		for {
+			input, err := util.ReadMessage(reader)
+			if err != nil {
+				return
+			}
+			requests := &pb.Requests{}
+			proto.Unmarshal(input, requests)
+			timeouts <- requests.TimeoutNs
+		}
+	}()
+
+	mc := newMultiplexedConn(client, nil)
+	defer mc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := mc.SendRequestsCtx(ctx, &pb.Requests{Keyspace: "ks1"})
+	if err != context.DeadlineExceeded {
+		t.Errorf("expecting deadline exceeded, but got %v", err)
+	}
+
+	if timeout := <-timeouts; timeout == 0 || timeout > uint64(50*time.Millisecond) {
+		t.Errorf("unexpected timeout sent: %d", timeout)
+	}
+
+	if mc.IsBroken() {
+		t.Errorf("connection should still be usable after a cancelled request")
+	}
+
+}
+
+func TestMultiplexedConnDeadlineWhileWriting(t *testing.T) {
+
+	client, server := net.Pipe()
+	defer server.Close()
+
+	mc := newMultiplexedConn(client, nil)
+	defer mc.Close()
+
+	// the first request is stuck in writing, since the server is not reading yet
+	written := make(chan error, 1)
+	go func() {
+		_, err := mc.SendRequestsCtx(context.Background(), &pb.Requests{Keyspace: "ks1"})
+		written <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// the second request gives up at its own deadline, without failing the first one
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := mc.SendRequestsCtx(ctx, &pb.Requests{Keyspace: "ks2"})
+	if err != context.DeadlineExceeded {
+		t.Errorf("expecting deadline exceeded, but got %v", err)
+	}
+
+	reader := bufio.NewReader(server)
+	input, err := util.ReadMessage(reader)
+	if err != nil {
+		t.Fatalf("read the first request: %v", err)
+	}
+	requests := &pb.Requests{}
+	proto.Unmarshal(input, requests)
+	output, _ := proto.Marshal(&pb.Responses{RequestId: requests.RequestId})
+	util.WriteMessage(server, output)
+
+	if err := <-written; err != nil {
+		t.Errorf("first request: %v", err)
+	}
+	if mc.IsBroken() {
+		t.Errorf("connection should still be usable after a request gave up waiting to write")
+	}
+}
+
+func TestMultiplexedConnPoolDialsOutsideLock(t *testing.T) {
+
+	releaseFirstDial := make(chan struct{})
+	var dialCount int32
+	var servers []net.Conn
+	var serversLock sync.Mutex
+	defer func() {
+		for _, server := range servers {
+			server.Close()
+		}
+	}()
+
+	pool := newMultiplexedConnPool(2, nil, func(ctx context.Context) (net.Conn, error) {
+		if atomic.AddInt32(&dialCount, 1) == 1 {
+			<-releaseFirstDial
+		}
+		client, server := net.Pipe()
+		serversLock.Lock()
+		servers = append(servers, server)
+		serversLock.Unlock()
+		return client, nil
+	})
+	defer pool.Close()
+
+	first := make(chan error, 1)
+	go func() {
+		_, err := pool.Get(context.Background())
+		first <- err
+	}()
+	for atomic.LoadInt32(&dialCount) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// the other slot is dialed while the first dial is still in progress
+	second := make(chan error, 1)
+	go func() {
+		_, err := pool.Get(context.Background())
+		second <- err
+	}()
+	select {
+	case err := <-second:
+		if err != nil {
+			t.Errorf("second get: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("get is blocked by the dial of another slot")
+	}
+
+	close(releaseFirstDial)
+	if err := <-first; err != nil {
+		t.Errorf("first get: %v", err)
+	}
+}
@@ -27,7 +27,8 @@ func (clusterListener *ClusterListener) removeNode(cluster *topology.Cluster, n
 	}
 
 	isStoreDeleted := cluster.RemoveShard(n.StoreResource, n.ShardInfo)
-	if isStoreDeleted {
+	// the connections and the health are shared by all keyspaces on the store
+	if isStoreDeleted && !clusterListener.isStoreInUse(n.StoreResource) {
 		clusterListener.connPoolLock.Lock()
 		connPool, foundPool := clusterListener.connPools[n.StoreResource.Address]
 		if foundPool {
@@ -35,6 +36,7 @@ func (clusterListener *ClusterListener) removeNode(cluster *topology.Cluster, n
 			connPool.Close()
 		}
 		clusterListener.connPoolLock.Unlock()
+		clusterListener.removeStoreHealth(n.StoreResource.Address)
 	}
 }
 
@@ -56,3 +58,15 @@ func promoteNode(cluster *topology.Cluster, n *pb.ClusterNode) {
 		}
 	}
 }
+
+// isStoreInUse checks whether any cluster still has shards on the store
+func (clusterListener *ClusterListener) isStoreInUse(store *pb.StoreResource) bool {
+	clusterListener.RLock()
+	defer clusterListener.RUnlock()
+	for _, cluster := range clusterListener.clusters {
+		if cluster.IsStoreInUse(store) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package clusterlistener
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// CircuitBreakerConfig controls when requests to a store are stopped, and when to probe it again.
+type CircuitBreakerConfig struct {
+	ConsecutiveFailures int           // open the circuit after this many consecutive failures
+	ErrorRate           float64       // open the circuit if the error rate within the window reaches this
+	MinRequests         int           // minimum requests within the window before the error rate is considered
+	Window              time.Duration // the window to count the error rate
+	OpenDuration        time.Duration // how long to wait before letting one probe request through
+}
+
+// DefaultCircuitBreakerConfig is used by new cluster listeners
+var DefaultCircuitBreakerConfig = CircuitBreakerConfig{
+	ConsecutiveFailures: 5,
+	ErrorRate:           0.5,
+	MinRequests:         20,
+	Window:              10 * time.Second,
+	OpenDuration:        5 * time.Second,
+}
+
+// CircuitOpenError is returned when requests to the store are stopped by the circuit breaker
+type CircuitOpenError struct {
+	Address string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for store %s", e.Address)
+}
+
+// storeHealth tracks the recent request results to one store
+type storeHealth struct {
+	sync.Mutex
+	config              CircuitBreakerConfig
+	state               circuitState
+	consecutiveFailures int
+	windowStart         time.Time
+	windowRequests      int
+	windowFailures      int
+	openedAt            time.Time
+	probeInFlight       bool
+	lastError           error
+}
+
+func newStoreHealth(config CircuitBreakerConfig) *storeHealth {
+	return &storeHealth{
+		config:      config,
+		windowStart: time.Now(),
+	}
+}
+
+// allow checks whether a request can be sent. When the circuit has been open long enough, one probe is let through.
+func (h *storeHealth) allow() bool {
+	h.Lock()
+	defer h.Unlock()
+
+	switch h.state {
+	case circuitOpen:
+		if time.Since(h.openedAt) < h.config.OpenDuration {
+			return false
+		}
+		h.state = circuitHalfOpen
+		h.probeInFlight = true
+		return true
+	case circuitHalfOpen:
+		if h.probeInFlight {
+			return false
+		}
+		h.probeInFlight = true
+		return true
+	}
+	return true
+}
+
+// isAvailable is like allow, but does not take the probe.
+func (h *storeHealth) isAvailable() bool {
+	h.Lock()
+	defer h.Unlock()
+
+	switch h.state {
+	case circuitOpen:
+		return time.Since(h.openedAt) >= h.config.OpenDuration
+	case circuitHalfOpen:
+		return !h.probeInFlight
+	}
+	return true
+}
+
+// record counts the result of one request. Cancelled requests do not count.
+func (h *storeHealth) record(err error) {
+	h.Lock()
+	defer h.Unlock()
+
+	if err == context.Canceled {
+		// let another probe through
+		h.probeInFlight = false
+		return
+	}
+
+	if now := time.Now(); now.Sub(h.windowStart) > h.config.Window {
+		h.windowStart = now
+		h.windowRequests, h.windowFailures = 0, 0
+	}
+	h.windowRequests++
+
+	if err == nil {
+		h.consecutiveFailures = 0
+		if h.state == circuitHalfOpen {
+			h.state = circuitClosed
+			h.probeInFlight = false
+		}
+		return
+	}
+
+	h.lastError = err
+	h.consecutiveFailures++
+	h.windowFailures++
+
+	if h.state == circuitHalfOpen {
+		h.trip()
+		return
+	}
+	if h.consecutiveFailures >= h.config.ConsecutiveFailures {
+		h.trip()
+		return
+	}
+	if h.windowRequests >= h.config.MinRequests &&
+		float64(h.windowFailures)/float64(h.windowRequests) >= h.config.ErrorRate {
+		h.trip()
+	}
+}
+
+func (h *storeHealth) trip() {
+	h.state = circuitOpen
+	h.openedAt = time.Now()
+	h.probeInFlight = false
+}
+
+func (h *storeHealth) String() string {
+	h.Lock()
+	defer h.Unlock()
+	return fmt.Sprintf("circuit:%v consecutiveFailures:%d window:%d/%d lastError:%v",
+		h.state, h.consecutiveFailures, h.windowFailures, h.windowRequests, h.lastError)
+}
+
+// SetCircuitBreaker changes the circuit breaker thresholds for all stores.
+func (clusterListener *ClusterListener) SetCircuitBreaker(config CircuitBreakerConfig) {
+	clusterListener.healthLock.Lock()
+	clusterListener.circuitBreakerConfig = config
+	for _, h := range clusterListener.storeHealths {
+		h.Lock()
+		h.config = config
+		h.Unlock()
+	}
+	clusterListener.healthLock.Unlock()
+}
+
+// IsStoreAvailable checks whether requests to the store are not stopped by the circuit breaker.
+func (clusterListener *ClusterListener) IsStoreAvailable(address string) bool {
+	return clusterListener.getStoreHealth(address).isAvailable()
+}
+
+func (clusterListener *ClusterListener) removeStoreHealth(address string) {
+	clusterListener.healthLock.Lock()
+	delete(clusterListener.storeHealths, address)
+	clusterListener.healthLock.Unlock()
+}
+
+func (clusterListener *ClusterListener) getStoreHealth(address string) *storeHealth {
+	clusterListener.healthLock.Lock()
+	defer clusterListener.healthLock.Unlock()
+
+	h, found := clusterListener.storeHealths[address]
+	if !found {
+		h = newStoreHealth(clusterListener.circuitBreakerConfig)
+		clusterListener.storeHealths[address] = h
+	}
+	return h
+}
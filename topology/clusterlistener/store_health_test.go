@@ -0,0 +1,65 @@
+package clusterlistener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestStoreHealthCircuitBreaker(t *testing.T) {
+
+	config := DefaultCircuitBreakerConfig
+	config.OpenDuration = 20 * time.Millisecond
+	h := newStoreHealth(config)
+
+	for i := 0; i < config.ConsecutiveFailures; i++ {
+		if !h.allow() {
+			t.Fatalf("circuit opened too early after %d failures", i)
+		}
+		h.record(errConnectionClosed)
+	}
+	if h.allow() {
+		t.Errorf("circuit should be open after %d consecutive failures", config.ConsecutiveFailures)
+	}
+
+	time.Sleep(config.OpenDuration)
+
+	if !h.allow() {
+		t.Errorf("one probe should be allowed when half open")
+	}
+	if h.allow() {
+		t.Errorf("only one probe should be allowed when half open")
+	}
+	h.record(nil)
+	if !h.allow() || !h.allow() {
+		t.Errorf("circuit should be closed after a successful probe")
+	}
+
+}
+
+func TestStoreHealthSharedByKeyspaces(t *testing.T) {
+
+	clusterListener := NewClusterListener("test")
+	node := func(keyspace string) *pb.ClusterNode {
+		return &pb.ClusterNode{
+			StoreResource: &pb.StoreResource{Network: "tcp", Address: "127.0.0.1:8279"},
+			ShardInfo:     &pb.ShardInfo{KeyspaceName: keyspace, ClusterSize: 1, ReplicationFactor: 1},
+		}
+	}
+	ks1 := clusterListener.GetOrSetCluster("ks1", 1, 1)
+	ks2 := clusterListener.GetOrSetCluster("ks2", 1, 1)
+	addNode(ks1, node("ks1"))
+	addNode(ks2, node("ks2"))
+
+	h := clusterListener.getStoreHealth("127.0.0.1:8279")
+	clusterListener.removeNode(ks1, node("ks1"))
+	if clusterListener.getStoreHealth("127.0.0.1:8279") != h {
+		t.Errorf("store health is removed while keyspace ks2 is still on the store")
+	}
+
+	clusterListener.removeNode(ks2, node("ks2"))
+	if clusterListener.getStoreHealth("127.0.0.1:8279") == h {
+		t.Errorf("store health is kept after all keyspaces left the store")
+	}
+}
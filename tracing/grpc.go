@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataCarrier adapts the grpc metadata to carry the trace context
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() (keys []string) {
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return
+}
+
+// OutgoingGrpcContext adds the trace context to the metadata of the grpc calls made with the returned ctx.
+func OutgoingGrpcContext(ctx context.Context) context.Context {
+	traceContext := Inject(ctx)
+	if traceContext == nil {
+		return ctx
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	for key, value := range traceContext {
+		md.Set(key, value)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// IncomingGrpcContext returns a ctx with the trace context received in the metadata of a grpc call.
+func IncomingGrpcContext(ctx context.Context) context.Context {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return ctx
+	}
+	return propagator.Extract(ctx, metadataCarrier(md))
+}
@@ -0,0 +1,113 @@
+// Package tracing sets up OpenTelemetry tracing, and carries the trace context along with the requests.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/chrislusf/glog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/chrislusf/vasto"
+
+var propagator = propagation.TraceContext{}
+
+// Init sets up the global tracer provider for the process.
+// The exporter is "otlp:<host:port>" to send to an OTLP/HTTP collector, or "file:<path>" to write json lines.
+// It does nothing if the exporter is empty. The returned shutdown flushes the pending spans.
+func Init(serviceName string, exporter string) (shutdown func(), err error) {
+	if exporter == "" {
+		return func() {}, nil
+	}
+
+	var spanExporter sdktrace.SpanExporter
+	var file *os.File
+	switch {
+	case strings.HasPrefix(exporter, "otlp:"):
+		spanExporter, err = otlptracehttp.New(context.Background(),
+			otlptracehttp.WithEndpoint(strings.TrimPrefix(exporter, "otlp:")),
+			otlptracehttp.WithInsecure(),
+		)
+	case strings.HasPrefix(exporter, "file:"):
+		file, err = os.OpenFile(strings.TrimPrefix(exporter, "file:"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err == nil {
+			spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+		}
+	default:
+		err = fmt.Errorf("unknown trace exporter %s, expecting otlp:<host:port> or file:<path>", exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("trace exporter %s: %v", exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+
+	glog.V(0).Infof("%s traces to %s", serviceName, exporter)
+
+	return func() {
+		if err := provider.Shutdown(context.Background()); err != nil {
+			glog.Errorf("shutdown tracing: %v", err)
+		}
+		if file != nil {
+			file.Close()
+		}
+	}, nil
+}
+
+// Start creates a span as a child of the span in the ctx, if any.
+func Start(ctx context.Context, spanName string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName, trace.WithAttributes(attributes...))
+}
+
+// End marks the span failed if err is not nil, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// StatusError converts the error string in the responses to an error, nil if empty.
+func StatusError(status string) error {
+	if status == "" {
+		return nil
+	}
+	return errors.New(status)
+}
+
+// Inject returns the trace context of the ctx, to be sent along with the requests.
+// It returns nil if there is no span in the ctx.
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract returns a ctx with the trace context received along with the requests.
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier(traceContext))
+}
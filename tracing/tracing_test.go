@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestInjectExtract(t *testing.T) {
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	if traceContext := Inject(context.Background()); traceContext != nil {
+		t.Errorf("unexpected trace context without span: %v", traceContext)
+	}
+
+	ctx, span := Start(context.Background(), "test")
+	defer span.End()
+
+	traceContext := Inject(ctx)
+	if traceContext["traceparent"] == "" {
+		t.Fatalf("missing traceparent: %v", traceContext)
+	}
+
+	received := trace.SpanContextFromContext(Extract(context.Background(), traceContext))
+	if received.TraceID() != span.SpanContext().TraceID() || received.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("extracted %v, expected %v", received, span.SpanContext())
+	}
+
+	outgoing, _ := metadata.FromOutgoingContext(OutgoingGrpcContext(ctx))
+	incoming := IncomingGrpcContext(metadata.NewIncomingContext(context.Background(), outgoing))
+	if trace.SpanContextFromContext(incoming).TraceID() != span.SpanContext().TraceID() {
+		t.Errorf("grpc metadata lost the trace: %v", outgoing)
+	}
+
+}
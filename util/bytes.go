@@ -77,3 +77,15 @@ func Float64ToBytes(float float64) []byte {
 	binary.LittleEndian.PutUint64(bytes, bits)
 	return bytes
 }
+
+// BytesToInt64 converts from little endian 8 bytes to an int64
+func BytesToInt64(bytes []byte) int64 {
+	return int64(binary.LittleEndian.Uint64(bytes))
+}
+
+// Int64ToBytes converts from an int64 to little endian 8 bytes
+func Int64ToBytes(value int64) []byte {
+	bytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bytes, uint64(value))
+	return bytes
+}
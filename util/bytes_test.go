@@ -1,6 +1,7 @@
 package util
 
 import (
+	"math"
 	"testing"
 )
 
@@ -51,3 +52,13 @@ func TestConversionFloat64(t *testing.T) {
 	}
 
 }
+
+func TestConversionInt64(t *testing.T) {
+
+	for _, x := range []int64{0, -1, 12345, math.MaxInt64, math.MinInt64} {
+		if BytesToInt64(Int64ToBytes(x)) != x {
+			t.Errorf("unexpected: %d, expecting %d", BytesToInt64(Int64ToBytes(x)), x)
+		}
+	}
+
+}
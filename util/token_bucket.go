@@ -0,0 +1,84 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket is a rate limiter refilled continuously at a fixed rate per second,
+// holding at most one second worth of tokens.
+// A nil TokenBucket or a rate of 0 does not limit anything.
+type TokenBucket struct {
+	sync.Mutex
+	rate     float64
+	tokens   float64
+	lastTime time.Time
+	now      func() time.Time
+}
+
+// NewTokenBucket creates a full bucket allowing ratePerSecond tokens per second.
+func NewTokenBucket(ratePerSecond uint64) *TokenBucket {
+	b := &TokenBucket{
+		rate:   float64(ratePerSecond),
+		tokens: float64(ratePerSecond),
+		now:    time.Now,
+	}
+	b.lastTime = b.now()
+	return b
+}
+
+// SetRate changes the rate, keeping the currently available tokens within the new limit.
+func (b *TokenBucket) SetRate(ratePerSecond uint64) {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.refill()
+	b.rate = float64(ratePerSecond)
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+// TryTake takes n tokens if there are any left, and returns false if the bucket is exhausted.
+// The bucket can go into debt by one request, so a request larger than the rate is still possible.
+func (b *TokenBucket) TryTake(n uint64) bool {
+	if b == nil {
+		return true
+	}
+	b.Lock()
+	defer b.Unlock()
+	if b.rate <= 0 {
+		return true
+	}
+	b.refill()
+	if b.tokens <= 0 {
+		return false
+	}
+	b.tokens -= float64(n)
+	return true
+}
+
+// Take takes n tokens unconditionally, for costs only known after the request is processed.
+func (b *TokenBucket) Take(n uint64) {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	if b.rate <= 0 {
+		return
+	}
+	b.refill()
+	b.tokens -= float64(n)
+}
+
+func (b *TokenBucket) refill() {
+	t := b.now()
+	b.tokens += t.Sub(b.lastTime).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.lastTime = t
+}
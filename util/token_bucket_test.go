@@ -0,0 +1,59 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+
+	now := time.Now()
+	b := NewTokenBucket(10)
+	b.now = func() time.Time { return now }
+	b.lastTime = now
+
+	for i := 0; i < 10; i++ {
+		if !b.TryTake(1) {
+			t.Fatalf("take %d should be allowed", i)
+		}
+	}
+	if b.TryTake(1) {
+		t.Errorf("exhausted bucket should refuse")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	for i := 0; i < 5; i++ {
+		if !b.TryTake(1) {
+			t.Fatalf("take %d after refill should be allowed", i)
+		}
+	}
+	if b.TryTake(1) {
+		t.Errorf("bucket should be exhausted after the refilled tokens")
+	}
+
+	// refill never exceeds one second of tokens
+	now = now.Add(time.Hour)
+	b.Take(25)
+	if b.TryTake(1) {
+		t.Errorf("bucket in debt should refuse")
+	}
+	now = now.Add(2 * time.Second)
+	if !b.TryTake(1) {
+		t.Errorf("bucket should recover from debt")
+	}
+
+	b.SetRate(0)
+	for i := 0; i < 100; i++ {
+		if !b.TryTake(1000) {
+			t.Fatalf("rate 0 should not limit")
+		}
+	}
+
+	var nilBucket *TokenBucket
+	if !nilBucket.TryTake(1) {
+		t.Errorf("nil bucket should not limit")
+	}
+	nilBucket.Take(1)
+	nilBucket.SetRate(1)
+
+}
@@ -26,6 +26,7 @@ import (
 	m "github.com/chrislusf/vasto/cmd/master"
 	sh "github.com/chrislusf/vasto/cmd/shell"
 	s "github.com/chrislusf/vasto/cmd/store"
+	"github.com/chrislusf/vasto/security"
 	"github.com/chrislusf/vasto/util"
 	"github.com/chrislusf/vasto/util/interrupt"
 	"gopkg.in/alecthomas/kingpin.v2"
@@ -38,7 +39,13 @@ var (
 
 	master       = app.Command("master", "Start a master process")
 	masterOption = &m.MasterOption{
-		Address: master.Flag("address", "listening address host:port").Default(":8278").String(),
+		Address:         master.Flag("address", "listening address host:port").Default(":8278").String(),
+		HttpAddress:     master.Flag("httpAddress", "http address host:port for /metrics, disabled if empty").Default("").String(),
+		StaleReplicaLag: master.Flag("staleReplicaLag", "replicas lagging more than this are marked stale for reads, disabled if 0").Default("1m").Duration(),
+		TraceExporter:   master.Flag("traceExporter", "trace exporter, otlp:<host:port> for an OTLP/HTTP collector or file:<path>, disabled if empty").Default("").String(),
+		AclFile:         master.Flag("aclFile", "access control list of users, tokens and keyspace grants in protobuf text format, disabled if empty. The tokens are sent to the stores in plaintext unless TLS is enabled").Default("").String(),
+		Tls:             tlsOption(master, true),
+		AuditLog:        master.Flag("auditLog", "append-only file of the admin operations, only kept in memory if empty").Default("").String(),
 	}
 
 	store       = app.Command("store", "Start a vasto store")
@@ -54,12 +61,29 @@ var (
 		DiskSizeGb:        store.Flag("diskSizeGb", "disk size in GB").Default("10").Int(),
 		Tags:              store.Flag("tags", "comma separated tags").Default("").String(),
 		DisableBinLog:     store.Flag("disableBinLog", "disable binary log").Default("false").Bool(),
+		HttpAddress:       store.Flag("httpAddress", "http address host:port for /metrics, disabled if empty").Default("").String(),
+		TraceExporter:     store.Flag("traceExporter", "trace exporter, otlp:<host:port> for an OTLP/HTTP collector or file:<path>, disabled if empty").Default("").String(),
+		SlowOpThreshold:   store.Flag("slowOpThreshold", "requests slower than this are kept in the slow op log").Default("100ms").Duration(),
+		SlowOpLogSize:     store.Flag("slowOpLogSize", "how many slow requests to keep, 0 to disable").Default("1024").Int(),
+		Token:             store.Flag("token", "token to access the master and peer stores, needs admin on all keyspaces when access control is enabled").Default("").String(),
+		Tls:               tlsOption(store, true),
+		EncryptionKeyFile: store.Flag("encryptionKeyFile", "file of \"<keyspace> <key_id> <hex_key>\" lines, to encrypt the data and binlogs of the listed keyspaces").Default("").String(),
+		Engine:            store.Flag("engine", "storage engine, rocksdb or the pure-Go leveldb, not to be changed once the store has data").Default("rocksdb").String(),
+		MemoryBudgetMb:    store.Flag("memoryBudgetMb", "memory shared by the block cache and write buffers of all rocksdb shards, 0 for unlimited").Default("0").Int(),
+		DbStatistics:      store.Flag("dbStatistics", "count the rocksdb block cache hits and stalls for the stats command, at some cpu cost").Default("false").Bool(),
 	}
 	storeProfile = store.Flag("cpuprofile", "cpu profile output file").Default("").String()
 
 	server             = app.Command("server", "Start a vasto master and a vasto store")
+	serverTlsOption    = tlsOption(server, true)
 	serverMasterOption = &m.MasterOption{
-		Address: server.Flag("master.address", "listening address host:port").Default(":8278").String(),
+		Address:         server.Flag("master.address", "listening address host:port").Default(":8278").String(),
+		HttpAddress:     server.Flag("master.httpAddress", "http address host:port for /metrics, disabled if empty").Default("").String(),
+		StaleReplicaLag: server.Flag("master.staleReplicaLag", "replicas lagging more than this are marked stale for reads, disabled if 0").Default("1m").Duration(),
+		TraceExporter:   server.Flag("master.traceExporter", "trace exporter, otlp:<host:port> for an OTLP/HTTP collector or file:<path>, disabled if empty").Default("").String(),
+		AclFile:         server.Flag("master.aclFile", "access control list of users, tokens and keyspace grants in protobuf text format, disabled if empty. The tokens are sent to the stores in plaintext unless TLS is enabled").Default("").String(),
+		Tls:             serverTlsOption,
+		AuditLog:        server.Flag("master.auditLog", "append-only file of the admin operations, only kept in memory if empty").Default("").String(),
 	}
 	serverStoreOption = &s.StoreOption{
 		Dir:               server.Flag("store.dir", "folder to server data").Default(os.TempDir()).String(),
@@ -72,15 +96,28 @@ var (
 		LogFileCount:      server.Flag("store.logFileCount", "log file count limit").Default("3").Int(),
 		DiskSizeGb:        server.Flag("store.diskSizeGb", "disk size in GB").Default("10").Int(),
 		Tags:              server.Flag("store.tags", "comma separated tags").Default("").String(),
+		HttpAddress:       server.Flag("store.httpAddress", "http address host:port for /metrics, disabled if empty").Default("").String(),
+		TraceExporter:     server.Flag("store.traceExporter", "trace exporter, otlp:<host:port> for an OTLP/HTTP collector or file:<path>, disabled if empty").Default("").String(),
+		SlowOpThreshold:   server.Flag("store.slowOpThreshold", "requests slower than this are kept in the slow op log").Default("100ms").Duration(),
+		SlowOpLogSize:     server.Flag("store.slowOpLogSize", "how many slow requests to keep, 0 to disable").Default("1024").Int(),
+		Token:             server.Flag("store.token", "token to access the master and peer stores, needs admin on all keyspaces when access control is enabled").Default("").String(),
+		Tls:               serverTlsOption,
+		EncryptionKeyFile: server.Flag("store.encryptionKeyFile", "file of \"<keyspace> <key_id> <hex_key>\" lines, to encrypt the data and binlogs of the listed keyspaces").Default("").String(),
+		Engine:            server.Flag("store.engine", "storage engine, rocksdb or the pure-Go leveldb, not to be changed once the store has data").Default("rocksdb").String(),
+		MemoryBudgetMb:    server.Flag("store.memoryBudgetMb", "memory shared by the block cache and write buffers of all rocksdb shards, 0 for unlimited").Default("0").Int(),
+		DbStatistics:      server.Flag("store.dbStatistics", "count the rocksdb block cache hits and stalls for the stats command, at some cpu cost").Default("false").Bool(),
 	}
 	serverProfile = server.Flag("cpuprofile", "cpu profile output file").Default("").String()
 
 	gateway       = app.Command("gateway", "Start a vasto gateway")
 	gatewayOption = &g.GatewayOption{
-		TcpAddress: gateway.Flag("address", "gateway tcp host address").Default(":8281").String(),
-		UnixSocket: gateway.Flag("unixSocket", "gateway listening unix socket").Default("").Short('s').String(),
-		Master:     gateway.Flag("master", "master address").Default("localhost:8278").String(),
-		Keyspace:   gateway.Flag("cluster", "cluster name").Default("").String(),
+		TcpAddress:    gateway.Flag("address", "gateway tcp host address").Default(":8281").String(),
+		UnixSocket:    gateway.Flag("unixSocket", "gateway listening unix socket").Default("").Short('s').String(),
+		Master:        gateway.Flag("master", "master address").Default("localhost:8278").String(),
+		Keyspace:      gateway.Flag("cluster", "cluster name").Default("").String(),
+		HttpAddress:   gateway.Flag("httpAddress", "http address host:port for /metrics, disabled if empty").Default("").String(),
+		TraceExporter: gateway.Flag("traceExporter", "trace exporter, otlp:<host:port> for an OTLP/HTTP collector or file:<path>, disabled if empty").Default("").String(),
+		Tls:           tlsOption(gateway, true),
 	}
 	gatewayProfile = gateway.Flag("cpuprofile", "cpu profile output file").Default("").String()
 
@@ -94,6 +131,8 @@ var (
 		Keyspace:          bench.Flag("cluster", "cluster name").Default("benchmark").String(),
 		Tests:             bench.Flag("tests", "[put|get]").Default("put,get").Short('t').String(),
 		DisableUnixSocket: bench.Flag("disableUnixSocket", "avoid unix socket and only use tcp network").Default("false").Bool(),
+		Token:             bench.Flag("token", "token to access the master and the stores").Default("").String(),
+		Tls:               tlsOption(bench, false),
 	}
 	benchProfile = bench.Flag("cpuprofile", "cpu profile output file").Default("").String()
 
@@ -101,11 +140,15 @@ var (
 	shellOption = &sh.ShellOption{
 		Master:   shell.Flag("master", "master address").Default("localhost:8278").String(),
 		Keyspace: shell.Flag("cluster", "cluster name").Default("").String(),
+		Token:    shell.Flag("token", "token to access the master and the stores, see also the login command").Default("").String(),
+		Tls:      tlsOption(shell, false),
 	}
 
 	admin       = app.Command("admin", "Manage FixedCluster Size")
 	adminOption = &a.AdminOption{
 		Master: admin.Flag("master", "master address").Default("localhost:8278").String(),
+		Token:  admin.Flag("token", "token to access the master").Default("").String(),
+		Tls:    tlsOption(admin, false),
 	}
 )
 
@@ -163,6 +206,21 @@ func main() {
 	}
 }
 
+// tlsOption adds the certificate flags to the command.
+// The cert and key are also used as the client certificate when connecting to other servers.
+func tlsOption(cmd *kingpin.CmdClause, isServer bool) *security.TlsOption {
+	option := &security.TlsOption{
+		CertFile:     cmd.Flag("tls.cert", "PEM certificate file, TLS is enabled if this or tls.ca is set").Default("").String(),
+		KeyFile:      cmd.Flag("tls.key", "PEM private key file of the certificate").Default("").String(),
+		CaFile:       cmd.Flag("tls.ca", "PEM CA file to verify the peers, system roots if empty").Default("").String(),
+		VerifyClient: new(bool),
+	}
+	if isServer {
+		option.VerifyClient = cmd.Flag("tls.verifyClient", "require client certificates signed by tls.ca, i.e., mutual TLS").Default("false").Bool()
+	}
+	return option
+}
+
 func fixHomeDir(dir string) string {
 	if strings.HasPrefix(dir, "~") {
 		usr, err := user.Current()